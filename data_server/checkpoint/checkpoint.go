@@ -0,0 +1,108 @@
+// Package checkpoint defines the statement a ledger signs about a bucket root
+// and the key set it publishes, so the ledger (signer), data_server (store)
+// and offline verifiers agree on the exact bytes.
+package checkpoint
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// header versions the signed message layout.
+const header = "trustflow.checkpoint.v1"
+
+// WellKnownPath is where a ledger serves its KeySet.
+const WellKnownPath = "/.well-known/trustflow-keys.json"
+
+// Checkpoint is a ledger's claim that a bucket had RootHash over LeafCount leaves.
+type Checkpoint struct {
+	EntityKind string
+	EntityKey  string
+	BucketKey  string
+	TreeAlg    uint32
+	LeafCount  uint32
+	RootHash   []byte
+}
+
+// Message is the exact byte string that gets signed: one field per line,
+// root as lowercase hex.
+func (c Checkpoint) Message() ([]byte, error) {
+	for _, f := range []string{c.EntityKind, c.EntityKey, c.BucketKey} {
+		if f == "" || strings.ContainsRune(f, '\n') {
+			return nil, errors.New("checkpoint: scope fields must be non-empty single lines")
+		}
+	}
+	if len(c.RootHash) == 0 {
+		return nil, errors.New("checkpoint: empty root")
+	}
+	var b strings.Builder
+	for _, line := range []string{
+		header,
+		c.EntityKind,
+		c.EntityKey,
+		c.BucketKey,
+		strconv.FormatUint(uint64(c.TreeAlg), 10),
+		strconv.FormatUint(uint64(c.LeafCount), 10),
+		hex.EncodeToString(c.RootHash),
+	} {
+		b.WriteString(line)
+		b.WriteByte('\n')
+	}
+	return []byte(b.String()), nil
+}
+
+// Sign returns the Ed25519 signature over c.Message().
+func Sign(priv ed25519.PrivateKey, c Checkpoint) ([]byte, error) {
+	msg, err := c.Message()
+	if err != nil {
+		return nil, err
+	}
+	return ed25519.Sign(priv, msg), nil
+}
+
+// Verify checks sig over c.Message() with pub.
+func Verify(pub ed25519.PublicKey, c Checkpoint, sig []byte) bool {
+	msg, err := c.Message()
+	if err != nil || len(pub) != ed25519.PublicKeySize {
+		return false
+	}
+	return ed25519.Verify(pub, msg, sig)
+}
+
+// KeyID names a public key: hex of the first 8 bytes of its SHA-256.
+func KeyID(pub ed25519.PublicKey) string {
+	sum := sha256.Sum256(pub)
+	return hex.EncodeToString(sum[:8])
+}
+
+const (
+	StatusActive  = "active"  // currently signing
+	StatusRetired = "retired" // no longer signs; kept so old checkpoints verify
+)
+
+// Key is one published verification key.
+type Key struct {
+	KeyID     string `json:"kid"`
+	Alg       string `json:"alg"`        // always "Ed25519"
+	PublicKey []byte `json:"public_key"` // raw 32 bytes, base64 in JSON
+	Status    string `json:"status"`
+}
+
+// KeySet is the well-known document.
+type KeySet struct {
+	Keys []Key `json:"keys"`
+}
+
+// Find returns the key with the given id.
+func (s KeySet) Find(kid string) (Key, bool) {
+	for _, k := range s.Keys {
+		if k.KeyID == kid {
+			return k, true
+		}
+	}
+	return Key{}, false
+}
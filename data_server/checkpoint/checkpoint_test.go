@@ -0,0 +1,29 @@
+package checkpoint
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+)
+
+func TestSignVerify(t *testing.T) {
+	pub, priv, _ := ed25519.GenerateKey(rand.Reader)
+	c := Checkpoint{EntityKind: "issue", EntityKey: "gh#1", BucketKey: "2025-08-22", TreeAlg: 2, LeafCount: 3, RootHash: []byte{1, 2, 3}}
+
+	sig, err := Sign(priv, c)
+	if err != nil {
+		t.Fatalf("Sign() error = %v", err)
+	}
+	if !Verify(pub, c, sig) {
+		t.Fatal("Verify() rejected a valid signature")
+	}
+	c.LeafCount++
+	if Verify(pub, c, sig) {
+		t.Fatal("Verify() accepted a signature for a different leaf count")
+	}
+
+	c.EntityKey = "gh#1\n2"
+	if _, err := Sign(priv, c); err == nil {
+		t.Fatal("Sign() accepted a multi-line scope field")
+	}
+}
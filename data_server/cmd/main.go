@@ -17,10 +17,13 @@ import (
 	projectv1 "github.com/gusplusbus/trustflow/data_server/gen/projectv1"
 	walletv1 "github.com/gusplusbus/trustflow/data_server/gen/walletv1"
 
+	"github.com/gusplusbus/trustflow/data_server/internal/blobstore"
 	"github.com/gusplusbus/trustflow/data_server/internal/grpcserver"
 	"github.com/gusplusbus/trustflow/data_server/internal/repo/postgres"
 	"github.com/gusplusbus/trustflow/data_server/internal/service"
+	"github.com/gusplusbus/trustflow/data_server/internal/service/bucketing"
 	"github.com/gusplusbus/trustflow/data_server/internal/service/dbwrap"
+	"github.com/gusplusbus/trustflow/data_server/rfc3161"
 )
 
 func mustEnv(key string) string {
@@ -91,11 +94,61 @@ func main() {
 	ownershipSvc := service.NewOwnershipService(ownershipRepo)
 	issueSvc := service.NewIssueService(projectRepo, ownershipRepo, issueRepo, dbwrap.PoolExec{Pool: pool})
 
+	// Default bucketing policy (hourly|daily|weekly|size:N); scopes and
+	// projects can override it via SetBucketPolicy.
+	bucketPolicy := bucketing.Default
+	if v := os.Getenv("BUCKET_POLICY"); v != "" {
+		if bucketPolicy, err = bucketing.Parse(v); err != nil {
+			log.Fatalf("BUCKET_POLICY: %v", err)
+		}
+	}
+
 	// IMPORTANT: use the bucket-aware constructor
-	issuesTimelineSvc := service.NewIssuesTimelineServiceWithBuckets(issuesTimelineRepo, bucketRepo, pool)
-	bucketSvc := service.NewBucketService(bucketRepo)
+	issuesTimelineSvc := service.NewIssuesTimelineServiceWithBuckets(issuesTimelineRepo, bucketRepo, pool, bucketPolicy)
+	blobDir := os.Getenv("BLOB_DIR")
+	if blobDir == "" {
+		blobDir = "./blobs"
+	}
+	blobs, err := blobstore.NewDir(blobDir)
+	if err != nil {
+		log.Fatalf("blob dir init: %v", err)
+	}
+	// CA of the TSA, for auditing tsa anchors (unset => system roots).
+	tsaRoots, err := rfc3161.LoadRoots(os.Getenv("TSA_CA_CERT"))
+	if err != nil {
+		log.Fatalf("TSA_CA_CERT: %v", err)
+	}
+	bucketSvc := service.NewBucketService(bucketRepo, blobs).WithTSARoots(tsaRoots)
   walletSvc := service.NewWalletService(walletRepo)
 
+	// Background integrity auditor; AUDIT_INTERVAL=0 disables it.
+	auditEvery := time.Hour
+	if v := os.Getenv("AUDIT_INTERVAL"); v != "" {
+		if auditEvery, err = time.ParseDuration(v); err != nil {
+			log.Fatalf("AUDIT_INTERVAL: %v", err)
+		}
+	}
+	if auditEvery > 0 {
+		go bucketSvc.RunAuditor(context.Background(), auditEvery, 100)
+	}
+
+	// Background sealer for buckets whose window ended with no later item to
+	// close them; SEAL_INTERVAL=0 disables it.
+	sealEvery, sealGrace := 5*time.Minute, 10*time.Minute
+	if v := os.Getenv("SEAL_INTERVAL"); v != "" {
+		if sealEvery, err = time.ParseDuration(v); err != nil {
+			log.Fatalf("SEAL_INTERVAL: %v", err)
+		}
+	}
+	if v := os.Getenv("SEAL_GRACE"); v != "" {
+		if sealGrace, err = time.ParseDuration(v); err != nil || sealGrace < 0 {
+			log.Fatalf("SEAL_GRACE: want a non-negative duration, got %q", v)
+		}
+	}
+	if sealEvery > 0 {
+		go bucketSvc.RunSealer(context.Background(), sealEvery, sealGrace)
+	}
+
 	// gRPC
 	lis, err := net.Listen("tcp", addr)
 	if err != nil {
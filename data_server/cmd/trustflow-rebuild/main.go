@@ -0,0 +1,74 @@
+// Command trustflow-rebuild regenerates every bucket's leaves and Merkle tree
+// from timeline_items, the source they were derived from, and reports each
+// bucket whose stored root, leaves or nodes differ. With -apply it rewrites
+// the leaf, node and root rows of buckets whose items still give the stored
+// root; a bucket whose root differs is only reported. It connects to
+// DATABASE_URL.
+//
+// Usage:
+//
+//	trustflow-rebuild [-apply] [-kind issue -key gh#123]
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/gusplusbus/trustflow/data_server/internal/repo/postgres"
+	"github.com/gusplusbus/trustflow/data_server/internal/service"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+func main() {
+	apply := flag.Bool("apply", false, "rewrite stale leaf/node/root rows (default: report only)")
+	kind := flag.String("kind", "", "only this scope's entity_kind (with -key)")
+	key := flag.String("key", "", "only this scope's entity_key")
+	flag.Parse()
+	if (*kind == "") != (*key == "") {
+		flag.Usage()
+		os.Exit(2)
+	}
+	dsn := os.Getenv("DATABASE_URL")
+	if dsn == "" {
+		fmt.Fprintln(os.Stderr, "DATABASE_URL not set")
+		os.Exit(2)
+	}
+
+	ctx := context.Background()
+	pool, err := pgxpool.New(ctx, dsn)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "connect: %v\n", err)
+		os.Exit(2)
+	}
+	defer pool.Close()
+	svc := service.NewBucketService(postgres.NewBucketRepo(pool, postgres.LoadEmbeddedQueries()), nil)
+
+	checked, diffs, err := svc.Rebuild(ctx, *kind, *key, *apply)
+	bad := false
+	for _, r := range diffs {
+		b := r.Bucket
+		fmt.Printf("%s/%s/%s (%s): ", b.EntityKind, b.EntityKey, b.BucketKey, b.Status)
+		switch {
+		case r.Problem != "":
+			bad = true
+			fmt.Printf("NOT REBUILT, %s\n", r.Problem)
+		case r.RootDiffers:
+			bad = true
+			fmt.Printf("ROOT DIFFERS, stored %x (%d leaves), items give %x\n", b.RootHash, b.LeafCount, r.Root)
+		case r.Rewritten:
+			fmt.Println("stale leaf/node rows rewritten")
+		default:
+			fmt.Println("stale leaf/node rows (rerun with -apply)")
+		}
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "rebuild: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("%d buckets checked, %d differ\n", checked, len(diffs))
+	if bad {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,69 @@
+// Command trustflow-seqrepair renumbers timeline items whose per-scope
+// sequence (seq_in_entity) has gaps or duplicates, as the old MAX+1
+// numbering could leave behind under concurrent appends. Each such scope
+// becomes 1..n in its current order. Scopes where that would change a
+// sequence log a sealed bucket already committed to are reported and left as
+// is. Without -apply it only reports.
+//
+// Run it with -apply when migration 025 refuses to add the uniqueness
+// constraint. It connects to DATABASE_URL.
+//
+// Usage:
+//
+//	trustflow-seqrepair [-apply]
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/gusplusbus/trustflow/data_server/internal/repo/postgres"
+	"github.com/gusplusbus/trustflow/data_server/internal/service"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+func main() {
+	apply := flag.Bool("apply", false, "renumber (default: report only)")
+	flag.Parse()
+	dsn := os.Getenv("DATABASE_URL")
+	if dsn == "" {
+		fmt.Fprintln(os.Stderr, "DATABASE_URL not set")
+		os.Exit(2)
+	}
+
+	ctx := context.Background()
+	pool, err := pgxpool.New(ctx, dsn)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "connect: %v\n", err)
+		os.Exit(2)
+	}
+	defer pool.Close()
+	svc := service.NewBucketService(postgres.NewBucketRepo(pool, postgres.LoadEmbeddedQueries()), nil)
+
+	repairs, err := svc.RepairSequences(ctx, *apply)
+	skipped := false
+	for _, r := range repairs {
+		fmt.Printf("%s/%s: %d items, %d distinct seqs, max %d: ", r.EntityKind, r.EntityKey, r.Items, r.Distinct, r.MaxSeq)
+		switch {
+		case r.Skipped != "":
+			skipped = true
+			fmt.Printf("SKIPPED, %s\n", r.Skipped)
+		case *apply:
+			fmt.Printf("renumbered %d\n", r.Renumbered)
+		default:
+			fmt.Printf("would renumber %d\n", r.Renumbered)
+		}
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "repair: %v\n", err)
+		os.Exit(1)
+	}
+	if len(repairs) == 0 {
+		fmt.Println("no gaps or duplicates")
+	}
+	if skipped {
+		os.Exit(1)
+	}
+}
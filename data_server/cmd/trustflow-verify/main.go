@@ -0,0 +1,116 @@
+// Command trustflow-verify checks an exported bucket bundle offline: it
+// rehashes every item, rebuilds the Merkle root, checks each inclusion proof
+// and the anchor record, including the epoch proof of buckets anchored
+// through an epoch and the RFC 3161 token of tsa anchors (-tsa-ca names the
+// TSA's CA when it is not in the system roots). Given several bundles of one scope it also checks they
+// are consecutive links of the scope's bucket chain, so a dropped or
+// reordered bucket shows. With -completeness it checks a completeness proof
+// against the bundle of the bucket that commits it. It needs no trustflow
+// service or database.
+//
+// Usage:
+//
+//	trustflow-verify bundle.json [bundle.json ...]
+//	trustflow-verify -tsa-ca tsa-ca.pem bundle.json
+//	trustflow-verify - < bundle.json
+//	trustflow-verify -completeness proof.json bundle.json
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/gusplusbus/trustflow/data_server/internal/evidence"
+	"github.com/gusplusbus/trustflow/data_server/rfc3161"
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: %s <bundle.json | -> [bundle.json ...]\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	completeness := flag.String("completeness", "", "completeness proof to check against the given bundles")
+	tsaCA := flag.String("tsa-ca", "", "PEM file of the CA tsa anchor tokens chain to (default system roots)")
+	flag.Parse()
+	if flag.NArg() < 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	tsaRoots, err := rfc3161.LoadRoots(*tsaCA)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "tsa ca: %v\n", err)
+		os.Exit(2)
+	}
+
+	ok := true
+	var bundles []*evidence.Bundle
+	for _, name := range flag.Args() {
+		raw, err := readInput(name)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "read bundle: %v\n", err)
+			os.Exit(2)
+		}
+		var b evidence.Bundle
+		if err := json.Unmarshal(raw, &b); err != nil {
+			fmt.Fprintf(os.Stderr, "parse bundle %s: %v\n", name, err)
+			os.Exit(2)
+		}
+		bundles = append(bundles, &b)
+
+		fmt.Printf("bucket %s/%s/%s\n", b.EntityKind, b.EntityKey, b.BucketKey)
+		results := evidence.Verify(&b, tsaRoots)
+		printResults(results)
+		ok = ok && evidence.OK(results)
+	}
+	if len(bundles) > 1 {
+		fmt.Printf("chain %s/%s\n", bundles[0].EntityKind, bundles[0].EntityKey)
+		r := evidence.VerifyChain(bundles)
+		printResults([]evidence.Result{r})
+		ok = ok && r.Err == nil
+	}
+	if *completeness != "" {
+		raw, err := os.ReadFile(*completeness)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "read completeness proof: %v\n", err)
+			os.Exit(2)
+		}
+		var c evidence.Completeness
+		if err := json.Unmarshal(raw, &c); err != nil {
+			fmt.Fprintf(os.Stderr, "parse completeness proof: %v\n", err)
+			os.Exit(2)
+		}
+		var by *evidence.Bundle
+		for _, b := range bundles {
+			if b.EntityKind == c.EntityKind && b.EntityKey == c.EntityKey && b.BucketKey == c.CommittedBy {
+				by = b
+			}
+		}
+		fmt.Printf("completeness %s/%s seq %d..%d\n", c.EntityKind, c.EntityKey, c.FromSeq, c.ToSeq)
+		results := evidence.VerifyCompleteness(&c, by)
+		printResults(results)
+		ok = ok && evidence.OK(results)
+	}
+	if !ok {
+		os.Exit(1)
+	}
+}
+
+func printResults(results []evidence.Result) {
+	for _, r := range results {
+		if r.Err != nil {
+			fmt.Printf("  FAIL %-7s %v\n", r.Check, r.Err)
+		} else {
+			fmt.Printf("  ok   %-7s %s\n", r.Check, r.Note)
+		}
+	}
+}
+
+func readInput(name string) ([]byte, error) {
+	if name == "-" {
+		return io.ReadAll(os.Stdin)
+	}
+	return os.ReadFile(name)
+}
@@ -0,0 +1,833 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v3.20.3
+// source: anchor.proto
+
+package anchorv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Served by the ledger, which reads anchors back from the chain. Also at
+// GET /anchors/verify?entity_kind=&entity_key=&bucket_key= on its HTTP port.
+type VerifyAnchorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EntityKind string `protobuf:"bytes,1,opt,name=entity_kind,json=entityKind,proto3" json:"entity_kind,omitempty"`
+	EntityKey  string `protobuf:"bytes,2,opt,name=entity_key,json=entityKey,proto3" json:"entity_key,omitempty"`
+	BucketKey  string `protobuf:"bytes,3,opt,name=bucket_key,json=bucketKey,proto3" json:"bucket_key,omitempty"`
+}
+
+func (x *VerifyAnchorRequest) Reset() {
+	*x = VerifyAnchorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anchor_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyAnchorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyAnchorRequest) ProtoMessage() {}
+
+func (x *VerifyAnchorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_anchor_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyAnchorRequest.ProtoReflect.Descriptor instead.
+func (*VerifyAnchorRequest) Descriptor() ([]byte, []int) {
+	return file_anchor_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *VerifyAnchorRequest) GetEntityKind() string {
+	if x != nil {
+		return x.EntityKind
+	}
+	return ""
+}
+
+func (x *VerifyAnchorRequest) GetEntityKey() string {
+	if x != nil {
+		return x.EntityKey
+	}
+	return ""
+}
+
+func (x *VerifyAnchorRequest) GetBucketKey() string {
+	if x != nil {
+		return x.BucketKey
+	}
+	return ""
+}
+
+// result is
+//
+//	verified: the bucket's anchor tx is mined, succeeded and committed the
+//	          expected root under the expected on-chain bucket id;
+//	mismatch: the tx committed something else (or is not an anchor call);
+//	missing:  the bucket has no anchor tx, or the chain has no successful one.
+//
+// For tsa anchors (anchored_tx "tsa:<serial>") the stored RFC 3161 token is
+// verified instead; it commits a root but no bucket id.
+type VerifyAnchorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result        string `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Detail        string `protobuf:"bytes,2,opt,name=detail,proto3" json:"detail,omitempty"` // why, when not verified
+	AnchoredTx    string `protobuf:"bytes,3,opt,name=anchored_tx,json=anchoredTx,proto3" json:"anchored_tx,omitempty"`
+	BlockNumber   uint64 `protobuf:"varint,4,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	BlockHash     string `protobuf:"bytes,5,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	ExpectedRoot  []byte `protobuf:"bytes,6,opt,name=expected_root,json=expectedRoot,proto3" json:"expected_root,omitempty"`    // bucket root, or the root of the epoch it was rolled into
+	CommittedRoot []byte `protobuf:"bytes,7,opt,name=committed_root,json=committedRoot,proto3" json:"committed_root,omitempty"` // root the tx committed; empty when missing
+	EpochId       uint64 `protobuf:"varint,8,opt,name=epoch_id,json=epochId,proto3" json:"epoch_id,omitempty"`                  // set when the bucket was anchored through an epoch
+	Timestamp     string `protobuf:"bytes,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`                              // RFC3339 time in the token; tsa anchors only
+}
+
+func (x *VerifyAnchorResponse) Reset() {
+	*x = VerifyAnchorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anchor_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyAnchorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyAnchorResponse) ProtoMessage() {}
+
+func (x *VerifyAnchorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_anchor_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyAnchorResponse.ProtoReflect.Descriptor instead.
+func (*VerifyAnchorResponse) Descriptor() ([]byte, []int) {
+	return file_anchor_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *VerifyAnchorResponse) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *VerifyAnchorResponse) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *VerifyAnchorResponse) GetAnchoredTx() string {
+	if x != nil {
+		return x.AnchoredTx
+	}
+	return ""
+}
+
+func (x *VerifyAnchorResponse) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *VerifyAnchorResponse) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+func (x *VerifyAnchorResponse) GetExpectedRoot() []byte {
+	if x != nil {
+		return x.ExpectedRoot
+	}
+	return nil
+}
+
+func (x *VerifyAnchorResponse) GetCommittedRoot() []byte {
+	if x != nil {
+		return x.CommittedRoot
+	}
+	return nil
+}
+
+func (x *VerifyAnchorResponse) GetEpochId() uint64 {
+	if x != nil {
+		return x.EpochId
+	}
+	return 0
+}
+
+func (x *VerifyAnchorResponse) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+// Served by the ledger, which pays for anchoring. Each bucket anchor's gas is
+// charged to the project of the bucket's scope (BucketService.GetScopeProject);
+// an epoch's tx (epoch or batch mode) is split evenly between its members. A
+// project may be capped per UTC day or calendar month, in wei (fee paid) or
+// gas. Once its spend in the period reaches the cap, its buckets wait in
+// needs_anchoring for the next period, whatever the anchor unit.
+type AnchorBudget struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Period string `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"` // day | month
+	Unit   string `protobuf:"bytes,2,opt,name=unit,proto3" json:"unit,omitempty"`     // wei | gas
+	Cap    string `protobuf:"bytes,3,opt,name=cap,proto3" json:"cap,omitempty"`       // decimal integer
+}
+
+func (x *AnchorBudget) Reset() {
+	*x = AnchorBudget{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anchor_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnchorBudget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnchorBudget) ProtoMessage() {}
+
+func (x *AnchorBudget) ProtoReflect() protoreflect.Message {
+	mi := &file_anchor_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnchorBudget.ProtoReflect.Descriptor instead.
+func (*AnchorBudget) Descriptor() ([]byte, []int) {
+	return file_anchor_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AnchorBudget) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *AnchorBudget) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *AnchorBudget) GetCap() string {
+	if x != nil {
+		return x.Cap
+	}
+	return ""
+}
+
+type AnchorSpend struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectId  string        `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Budget     *AnchorBudget `protobuf:"bytes,2,opt,name=budget,proto3" json:"budget,omitempty"` // unset when uncapped
+	Since      string        `protobuf:"bytes,3,opt,name=since,proto3" json:"since,omitempty"`   // RFC3339 start of the budget period (of the month, when uncapped)
+	GasUsed    uint64        `protobuf:"varint,4,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	FeeWei     string        `protobuf:"bytes,5,opt,name=fee_wei,json=feeWei,proto3" json:"fee_wei,omitempty"` // decimal integer
+	Anchors    uint32        `protobuf:"varint,6,opt,name=anchors,proto3" json:"anchors,omitempty"`            // buckets charged since `since`
+	OverBudget bool          `protobuf:"varint,7,opt,name=over_budget,json=overBudget,proto3" json:"over_budget,omitempty"`
+}
+
+func (x *AnchorSpend) Reset() {
+	*x = AnchorSpend{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anchor_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnchorSpend) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnchorSpend) ProtoMessage() {}
+
+func (x *AnchorSpend) ProtoReflect() protoreflect.Message {
+	mi := &file_anchor_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnchorSpend.ProtoReflect.Descriptor instead.
+func (*AnchorSpend) Descriptor() ([]byte, []int) {
+	return file_anchor_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AnchorSpend) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *AnchorSpend) GetBudget() *AnchorBudget {
+	if x != nil {
+		return x.Budget
+	}
+	return nil
+}
+
+func (x *AnchorSpend) GetSince() string {
+	if x != nil {
+		return x.Since
+	}
+	return ""
+}
+
+func (x *AnchorSpend) GetGasUsed() uint64 {
+	if x != nil {
+		return x.GasUsed
+	}
+	return 0
+}
+
+func (x *AnchorSpend) GetFeeWei() string {
+	if x != nil {
+		return x.FeeWei
+	}
+	return ""
+}
+
+func (x *AnchorSpend) GetAnchors() uint32 {
+	if x != nil {
+		return x.Anchors
+	}
+	return 0
+}
+
+func (x *AnchorSpend) GetOverBudget() bool {
+	if x != nil {
+		return x.OverBudget
+	}
+	return false
+}
+
+// An unset budget removes the project's cap.
+type SetAnchorBudgetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectId string        `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Budget    *AnchorBudget `protobuf:"bytes,2,opt,name=budget,proto3" json:"budget,omitempty"`
+}
+
+func (x *SetAnchorBudgetRequest) Reset() {
+	*x = SetAnchorBudgetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anchor_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetAnchorBudgetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAnchorBudgetRequest) ProtoMessage() {}
+
+func (x *SetAnchorBudgetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_anchor_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAnchorBudgetRequest.ProtoReflect.Descriptor instead.
+func (*SetAnchorBudgetRequest) Descriptor() ([]byte, []int) {
+	return file_anchor_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SetAnchorBudgetRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *SetAnchorBudgetRequest) GetBudget() *AnchorBudget {
+	if x != nil {
+		return x.Budget
+	}
+	return nil
+}
+
+type SetAnchorBudgetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Spend *AnchorSpend `protobuf:"bytes,1,opt,name=spend,proto3" json:"spend,omitempty"`
+}
+
+func (x *SetAnchorBudgetResponse) Reset() {
+	*x = SetAnchorBudgetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anchor_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetAnchorBudgetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAnchorBudgetResponse) ProtoMessage() {}
+
+func (x *SetAnchorBudgetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_anchor_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAnchorBudgetResponse.ProtoReflect.Descriptor instead.
+func (*SetAnchorBudgetResponse) Descriptor() ([]byte, []int) {
+	return file_anchor_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SetAnchorBudgetResponse) GetSpend() *AnchorSpend {
+	if x != nil {
+		return x.Spend
+	}
+	return nil
+}
+
+type GetAnchorSpendRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+}
+
+func (x *GetAnchorSpendRequest) Reset() {
+	*x = GetAnchorSpendRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anchor_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAnchorSpendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAnchorSpendRequest) ProtoMessage() {}
+
+func (x *GetAnchorSpendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_anchor_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAnchorSpendRequest.ProtoReflect.Descriptor instead.
+func (*GetAnchorSpendRequest) Descriptor() ([]byte, []int) {
+	return file_anchor_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetAnchorSpendRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+type GetAnchorSpendResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Spend *AnchorSpend `protobuf:"bytes,1,opt,name=spend,proto3" json:"spend,omitempty"`
+}
+
+func (x *GetAnchorSpendResponse) Reset() {
+	*x = GetAnchorSpendResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anchor_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAnchorSpendResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAnchorSpendResponse) ProtoMessage() {}
+
+func (x *GetAnchorSpendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_anchor_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAnchorSpendResponse.ProtoReflect.Descriptor instead.
+func (*GetAnchorSpendResponse) Descriptor() ([]byte, []int) {
+	return file_anchor_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetAnchorSpendResponse) GetSpend() *AnchorSpend {
+	if x != nil {
+		return x.Spend
+	}
+	return nil
+}
+
+var File_anchor_proto protoreflect.FileDescriptor
+
+var file_anchor_proto_rawDesc = []byte{
+	0x0a, 0x0c, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13,
+	0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72,
+	0x2e, 0x76, 0x31, 0x22, 0x74, 0x0a, 0x13, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x41, 0x6e, 0x63,
+	0x68, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x22, 0xae, 0x02, 0x0a, 0x14, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x79, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x65, 0x64, 0x5f, 0x74,
+	0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x65,
+	0x64, 0x54, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x52, 0x6f, 0x6f,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x4c, 0x0a, 0x0c, 0x41, 0x6e,
+	0x63, 0x68, 0x6f, 0x72, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x61, 0x70, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x61, 0x70, 0x22, 0xec, 0x01, 0x0a, 0x0b, 0x41, 0x6e, 0x63,
+	0x68, 0x6f, 0x72, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x06, 0x62, 0x75, 0x64, 0x67, 0x65,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66,
+	0x6c, 0x6f, 0x77, 0x2e, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e,
+	0x63, 0x68, 0x6f, 0x72, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x06, 0x62, 0x75, 0x64, 0x67,
+	0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x5f,
+	0x75, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x61, 0x73, 0x55,
+	0x73, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x65, 0x65, 0x5f, 0x77, 0x65, 0x69, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x65, 0x65, 0x57, 0x65, 0x69, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x61,
+	0x6e, 0x63, 0x68, 0x6f, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x62,
+	0x75, 0x64, 0x67, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6f, 0x76, 0x65,
+	0x72, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x22, 0x72, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x41, 0x6e,
+	0x63, 0x68, 0x6f, 0x72, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64,
+	0x12, 0x39, 0x0a, 0x06, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x61, 0x6e, 0x63,
+	0x68, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x42, 0x75, 0x64,
+	0x67, 0x65, 0x74, 0x52, 0x06, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x22, 0x51, 0x0a, 0x17, 0x53,
+	0x65, 0x74, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f,
+	0x77, 0x2e, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x63, 0x68,
+	0x6f, 0x72, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x52, 0x05, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x22, 0x36,
+	0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x53, 0x70, 0x65, 0x6e, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x22, 0x50, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x63,
+	0x68, 0x6f, 0x72, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x36, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x61, 0x6e, 0x63, 0x68,
+	0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x53, 0x70, 0x65, 0x6e,
+	0x64, 0x52, 0x05, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x32, 0x74, 0x0a, 0x0d, 0x41, 0x6e, 0x63, 0x68,
+	0x6f, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x63, 0x0a, 0x0c, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x79, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x12, 0x28, 0x2e, 0x74, 0x72, 0x75, 0x73,
+	0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e,
+	0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
+	0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xee,
+	0x01, 0x0a, 0x13, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x6c, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x41, 0x6e, 0x63,
+	0x68, 0x6f, 0x72, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x12, 0x2b, 0x2e, 0x74, 0x72, 0x75, 0x73,
+	0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x74, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c,
+	0x6f, 0x77, 0x2e, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74,
+	0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x63, 0x68, 0x6f,
+	0x72, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x2a, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c,
+	0x6f, 0x77, 0x2e, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x61,
+	0x6e, 0x63, 0x68, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x63, 0x68,
+	0x6f, 0x72, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
+	0x43, 0x5a, 0x41, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x75,
+	0x73, 0x70, 0x6c, 0x75, 0x73, 0x62, 0x75, 0x73, 0x2f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c,
+	0x6f, 0x77, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x67,
+	0x65, 0x6e, 0x2f, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x76, 0x31, 0x3b, 0x61, 0x6e, 0x63, 0x68,
+	0x6f, 0x72, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_anchor_proto_rawDescOnce sync.Once
+	file_anchor_proto_rawDescData = file_anchor_proto_rawDesc
+)
+
+func file_anchor_proto_rawDescGZIP() []byte {
+	file_anchor_proto_rawDescOnce.Do(func() {
+		file_anchor_proto_rawDescData = protoimpl.X.CompressGZIP(file_anchor_proto_rawDescData)
+	})
+	return file_anchor_proto_rawDescData
+}
+
+var file_anchor_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_anchor_proto_goTypes = []any{
+	(*VerifyAnchorRequest)(nil),     // 0: trustflow.anchor.v1.VerifyAnchorRequest
+	(*VerifyAnchorResponse)(nil),    // 1: trustflow.anchor.v1.VerifyAnchorResponse
+	(*AnchorBudget)(nil),            // 2: trustflow.anchor.v1.AnchorBudget
+	(*AnchorSpend)(nil),             // 3: trustflow.anchor.v1.AnchorSpend
+	(*SetAnchorBudgetRequest)(nil),  // 4: trustflow.anchor.v1.SetAnchorBudgetRequest
+	(*SetAnchorBudgetResponse)(nil), // 5: trustflow.anchor.v1.SetAnchorBudgetResponse
+	(*GetAnchorSpendRequest)(nil),   // 6: trustflow.anchor.v1.GetAnchorSpendRequest
+	(*GetAnchorSpendResponse)(nil),  // 7: trustflow.anchor.v1.GetAnchorSpendResponse
+}
+var file_anchor_proto_depIdxs = []int32{
+	2, // 0: trustflow.anchor.v1.AnchorSpend.budget:type_name -> trustflow.anchor.v1.AnchorBudget
+	2, // 1: trustflow.anchor.v1.SetAnchorBudgetRequest.budget:type_name -> trustflow.anchor.v1.AnchorBudget
+	3, // 2: trustflow.anchor.v1.SetAnchorBudgetResponse.spend:type_name -> trustflow.anchor.v1.AnchorSpend
+	3, // 3: trustflow.anchor.v1.GetAnchorSpendResponse.spend:type_name -> trustflow.anchor.v1.AnchorSpend
+	0, // 4: trustflow.anchor.v1.AnchorService.VerifyAnchor:input_type -> trustflow.anchor.v1.VerifyAnchorRequest
+	4, // 5: trustflow.anchor.v1.AnchorBudgetService.SetAnchorBudget:input_type -> trustflow.anchor.v1.SetAnchorBudgetRequest
+	6, // 6: trustflow.anchor.v1.AnchorBudgetService.GetAnchorSpend:input_type -> trustflow.anchor.v1.GetAnchorSpendRequest
+	1, // 7: trustflow.anchor.v1.AnchorService.VerifyAnchor:output_type -> trustflow.anchor.v1.VerifyAnchorResponse
+	5, // 8: trustflow.anchor.v1.AnchorBudgetService.SetAnchorBudget:output_type -> trustflow.anchor.v1.SetAnchorBudgetResponse
+	7, // 9: trustflow.anchor.v1.AnchorBudgetService.GetAnchorSpend:output_type -> trustflow.anchor.v1.GetAnchorSpendResponse
+	7, // [7:10] is the sub-list for method output_type
+	4, // [4:7] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_anchor_proto_init() }
+func file_anchor_proto_init() {
+	if File_anchor_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_anchor_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*VerifyAnchorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anchor_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*VerifyAnchorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anchor_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*AnchorBudget); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anchor_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*AnchorSpend); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anchor_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*SetAnchorBudgetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anchor_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*SetAnchorBudgetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anchor_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAnchorSpendRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anchor_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAnchorSpendResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_anchor_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   2,
+		},
+		GoTypes:           file_anchor_proto_goTypes,
+		DependencyIndexes: file_anchor_proto_depIdxs,
+		MessageInfos:      file_anchor_proto_msgTypes,
+	}.Build()
+	File_anchor_proto = out.File
+	file_anchor_proto_rawDesc = nil
+	file_anchor_proto_goTypes = nil
+	file_anchor_proto_depIdxs = nil
+}
@@ -131,25 +131,125 @@ func (x *BucketRef) GetBucketKey() string {
 	return ""
 }
 
+// Ledger-signed statement about a bucket root (Ed25519 over the message in
+// data_server/checkpoint; keys at the ledger's /.well-known/trustflow-keys.json).
+type Checkpoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeafCount uint32 `protobuf:"varint,1,opt,name=leaf_count,json=leafCount,proto3" json:"leaf_count,omitempty"`
+	RootHash  []byte `protobuf:"bytes,2,opt,name=root_hash,json=rootHash,proto3" json:"root_hash,omitempty"`
+	TreeAlg   uint32 `protobuf:"varint,3,opt,name=tree_alg,json=treeAlg,proto3" json:"tree_alg,omitempty"`
+	KeyId     string `protobuf:"bytes,4,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	Signature []byte `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	SignedAt  string `protobuf:"bytes,6,opt,name=signed_at,json=signedAt,proto3" json:"signed_at,omitempty"` // RFC3339
+}
+
+func (x *Checkpoint) Reset() {
+	*x = Checkpoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bucket_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Checkpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Checkpoint) ProtoMessage() {}
+
+func (x *Checkpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_bucket_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Checkpoint.ProtoReflect.Descriptor instead.
+func (*Checkpoint) Descriptor() ([]byte, []int) {
+	return file_bucket_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Checkpoint) GetLeafCount() uint32 {
+	if x != nil {
+		return x.LeafCount
+	}
+	return 0
+}
+
+func (x *Checkpoint) GetRootHash() []byte {
+	if x != nil {
+		return x.RootHash
+	}
+	return nil
+}
+
+func (x *Checkpoint) GetTreeAlg() uint32 {
+	if x != nil {
+		return x.TreeAlg
+	}
+	return 0
+}
+
+func (x *Checkpoint) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *Checkpoint) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *Checkpoint) GetSignedAt() string {
+	if x != nil {
+		return x.SignedAt
+	}
+	return ""
+}
+
 type BucketInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ref        *BucketRef `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
-	RootHash   []byte     `protobuf:"bytes,2,opt,name=root_hash,json=rootHash,proto3" json:"root_hash,omitempty"`
-	LeafCount  uint32     `protobuf:"varint,3,opt,name=leaf_count,json=leafCount,proto3" json:"leaf_count,omitempty"`
-	Status     string     `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`                           // open|closed|needs_anchoring|anchored
-	Cid        string     `protobuf:"bytes,5,opt,name=cid,proto3" json:"cid,omitempty"`                                 // set by runner
-	AnchoredTx string     `protobuf:"bytes,6,opt,name=anchored_tx,json=anchoredTx,proto3" json:"anchored_tx,omitempty"` // set by runner
-	AnchoredAt string     `protobuf:"bytes,7,opt,name=anchored_at,json=anchoredAt,proto3" json:"anchored_at,omitempty"` // RFC3339
-	ClosedAt   string     `protobuf:"bytes,8,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`       // RFC3339
+	Ref               *BucketRef  `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
+	RootHash          []byte      `protobuf:"bytes,2,opt,name=root_hash,json=rootHash,proto3" json:"root_hash,omitempty"`
+	LeafCount         uint32      `protobuf:"varint,3,opt,name=leaf_count,json=leafCount,proto3" json:"leaf_count,omitempty"`
+	Status            string      `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`                           // open|needs_anchoring|anchoring|anchoring_pending|anchored|failed|anchor_failed
+	Cid               string      `protobuf:"bytes,5,opt,name=cid,proto3" json:"cid,omitempty"`                                 // set by runner
+	AnchoredTx        string      `protobuf:"bytes,6,opt,name=anchored_tx,json=anchoredTx,proto3" json:"anchored_tx,omitempty"` // set by runner
+	AnchoredAt        string      `protobuf:"bytes,7,opt,name=anchored_at,json=anchoredAt,proto3" json:"anchored_at,omitempty"` // RFC3339
+	ClosedAt          string      `protobuf:"bytes,8,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`       // RFC3339
+	TreeAlg           uint32      `protobuf:"varint,9,opt,name=tree_alg,json=treeAlg,proto3" json:"tree_alg,omitempty"`         // 1 = legacy (dup last odd node), 2 = RFC 6962
+	Checkpoint        *Checkpoint `protobuf:"bytes,10,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`                  // latest signed checkpoint (GetBucket/ListBuckets)
+	Policy            string      `protobuf:"bytes,11,opt,name=policy,proto3" json:"policy,omitempty"`                          // bucketing policy that keyed it: hourly|daily|weekly|size:N
+	Amends            string      `protobuf:"bytes,12,opt,name=amends,proto3" json:"amends,omitempty"`                          // for amendment buckets ("<key>+amend-<n>"): the sealed bucket_key
+	PrevRoot          []byte      `protobuf:"bytes,13,opt,name=prev_root,json=prevRoot,proto3" json:"prev_root,omitempty"`      // final root of the scope's previously sealed bucket (empty for the first)
+	ChainSeq          uint32      `protobuf:"varint,14,opt,name=chain_seq,json=chainSeq,proto3" json:"chain_seq,omitempty"`     // position in the scope's bucket chain; 0 = open or sealed before chaining
+	LogSize           uint32      `protobuf:"varint,15,opt,name=log_size,json=logSize,proto3" json:"log_size,omitempty"`        // scope sequence log committed by the chain link (0 = none)
+	LogRoot           []byte      `protobuf:"bytes,16,opt,name=log_root,json=logRoot,proto3" json:"log_root,omitempty"`
+	AnchoredBlock     uint64      `protobuf:"varint,17,opt,name=anchored_block,json=anchoredBlock,proto3" json:"anchored_block,omitempty"` // block that included anchored_tx, once confirmed
+	AnchoredBlockHash string      `protobuf:"bytes,18,opt,name=anchored_block_hash,json=anchoredBlockHash,proto3" json:"anchored_block_hash,omitempty"`
+	AnchorProof       []byte      `protobuf:"bytes,19,opt,name=anchor_proof,json=anchorProof,proto3" json:"anchor_proof,omitempty"` // self-contained anchor, e.g. an RFC 3161 token (tsa)
 }
 
 func (x *BucketInfo) Reset() {
 	*x = BucketInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bucket_proto_msgTypes[2]
+		mi := &file_bucket_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -162,7 +262,7 @@ func (x *BucketInfo) String() string {
 func (*BucketInfo) ProtoMessage() {}
 
 func (x *BucketInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_bucket_proto_msgTypes[2]
+	mi := &file_bucket_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -175,7 +275,7 @@ func (x *BucketInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BucketInfo.ProtoReflect.Descriptor instead.
 func (*BucketInfo) Descriptor() ([]byte, []int) {
-	return file_bucket_proto_rawDescGZIP(), []int{2}
+	return file_bucket_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *BucketInfo) GetRef() *BucketRef {
@@ -234,6 +334,83 @@ func (x *BucketInfo) GetClosedAt() string {
 	return ""
 }
 
+func (x *BucketInfo) GetTreeAlg() uint32 {
+	if x != nil {
+		return x.TreeAlg
+	}
+	return 0
+}
+
+func (x *BucketInfo) GetCheckpoint() *Checkpoint {
+	if x != nil {
+		return x.Checkpoint
+	}
+	return nil
+}
+
+func (x *BucketInfo) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+func (x *BucketInfo) GetAmends() string {
+	if x != nil {
+		return x.Amends
+	}
+	return ""
+}
+
+func (x *BucketInfo) GetPrevRoot() []byte {
+	if x != nil {
+		return x.PrevRoot
+	}
+	return nil
+}
+
+func (x *BucketInfo) GetChainSeq() uint32 {
+	if x != nil {
+		return x.ChainSeq
+	}
+	return 0
+}
+
+func (x *BucketInfo) GetLogSize() uint32 {
+	if x != nil {
+		return x.LogSize
+	}
+	return 0
+}
+
+func (x *BucketInfo) GetLogRoot() []byte {
+	if x != nil {
+		return x.LogRoot
+	}
+	return nil
+}
+
+func (x *BucketInfo) GetAnchoredBlock() uint64 {
+	if x != nil {
+		return x.AnchoredBlock
+	}
+	return 0
+}
+
+func (x *BucketInfo) GetAnchoredBlockHash() string {
+	if x != nil {
+		return x.AnchoredBlockHash
+	}
+	return ""
+}
+
+func (x *BucketInfo) GetAnchorProof() []byte {
+	if x != nil {
+		return x.AnchorProof
+	}
+	return nil
+}
+
 type ListBucketsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -247,7 +424,7 @@ type ListBucketsRequest struct {
 func (x *ListBucketsRequest) Reset() {
 	*x = ListBucketsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bucket_proto_msgTypes[3]
+		mi := &file_bucket_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -260,7 +437,7 @@ func (x *ListBucketsRequest) String() string {
 func (*ListBucketsRequest) ProtoMessage() {}
 
 func (x *ListBucketsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bucket_proto_msgTypes[3]
+	mi := &file_bucket_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -273,7 +450,7 @@ func (x *ListBucketsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBucketsRequest.ProtoReflect.Descriptor instead.
 func (*ListBucketsRequest) Descriptor() ([]byte, []int) {
-	return file_bucket_proto_rawDescGZIP(), []int{3}
+	return file_bucket_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ListBucketsRequest) GetScope() *Scope {
@@ -309,7 +486,7 @@ type ListBucketsResponse struct {
 func (x *ListBucketsResponse) Reset() {
 	*x = ListBucketsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bucket_proto_msgTypes[4]
+		mi := &file_bucket_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -322,7 +499,7 @@ func (x *ListBucketsResponse) String() string {
 func (*ListBucketsResponse) ProtoMessage() {}
 
 func (x *ListBucketsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_bucket_proto_msgTypes[4]
+	mi := &file_bucket_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -335,7 +512,7 @@ func (x *ListBucketsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBucketsResponse.ProtoReflect.Descriptor instead.
 func (*ListBucketsResponse) Descriptor() ([]byte, []int) {
-	return file_bucket_proto_rawDescGZIP(), []int{4}
+	return file_bucket_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ListBucketsResponse) GetBuckets() []*BucketInfo {
@@ -363,7 +540,7 @@ type GetBucketRequest struct {
 func (x *GetBucketRequest) Reset() {
 	*x = GetBucketRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bucket_proto_msgTypes[5]
+		mi := &file_bucket_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -376,7 +553,7 @@ func (x *GetBucketRequest) String() string {
 func (*GetBucketRequest) ProtoMessage() {}
 
 func (x *GetBucketRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bucket_proto_msgTypes[5]
+	mi := &file_bucket_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -389,7 +566,7 @@ func (x *GetBucketRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBucketRequest.ProtoReflect.Descriptor instead.
 func (*GetBucketRequest) Descriptor() ([]byte, []int) {
-	return file_bucket_proto_rawDescGZIP(), []int{5}
+	return file_bucket_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetBucketRequest) GetRef() *BucketRef {
@@ -405,12 +582,13 @@ type GetBucketResponse struct {
 	unknownFields protoimpl.UnknownFields
 
 	Bucket *BucketInfo `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Epoch  *EpochProof `protobuf:"bytes,2,opt,name=epoch,proto3" json:"epoch,omitempty"` // set once the bucket's current root is rolled into an epoch
 }
 
 func (x *GetBucketResponse) Reset() {
 	*x = GetBucketResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bucket_proto_msgTypes[6]
+		mi := &file_bucket_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -423,7 +601,7 @@ func (x *GetBucketResponse) String() string {
 func (*GetBucketResponse) ProtoMessage() {}
 
 func (x *GetBucketResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_bucket_proto_msgTypes[6]
+	mi := &file_bucket_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -436,7 +614,7 @@ func (x *GetBucketResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBucketResponse.ProtoReflect.Descriptor instead.
 func (*GetBucketResponse) Descriptor() ([]byte, []int) {
-	return file_bucket_proto_rawDescGZIP(), []int{6}
+	return file_bucket_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetBucketResponse) GetBucket() *BucketInfo {
@@ -446,6 +624,13 @@ func (x *GetBucketResponse) GetBucket() *BucketInfo {
 	return nil
 }
 
+func (x *GetBucketResponse) GetEpoch() *EpochProof {
+	if x != nil {
+		return x.Epoch
+	}
+	return nil
+}
+
 type InclusionProofRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -458,7 +643,7 @@ type InclusionProofRequest struct {
 func (x *InclusionProofRequest) Reset() {
 	*x = InclusionProofRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bucket_proto_msgTypes[7]
+		mi := &file_bucket_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -471,7 +656,7 @@ func (x *InclusionProofRequest) String() string {
 func (*InclusionProofRequest) ProtoMessage() {}
 
 func (x *InclusionProofRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bucket_proto_msgTypes[7]
+	mi := &file_bucket_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -484,7 +669,7 @@ func (x *InclusionProofRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InclusionProofRequest.ProtoReflect.Descriptor instead.
 func (*InclusionProofRequest) Descriptor() ([]byte, []int) {
-	return file_bucket_proto_rawDescGZIP(), []int{7}
+	return file_bucket_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *InclusionProofRequest) GetRef() *BucketRef {
@@ -506,15 +691,18 @@ type InclusionProofResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LeafHash []byte                         `protobuf:"bytes,1,opt,name=leaf_hash,json=leafHash,proto3" json:"leaf_hash,omitempty"`
+	LeafHash []byte                         `protobuf:"bytes,1,opt,name=leaf_hash,json=leafHash,proto3" json:"leaf_hash,omitempty"` // item hash; tree_alg 2 hashes it as 0x00||leaf_hash first
 	Path     []*InclusionProofResponse_Step `protobuf:"bytes,2,rep,name=path,proto3" json:"path,omitempty"`
 	RootHash []byte                         `protobuf:"bytes,3,opt,name=root_hash,json=rootHash,proto3" json:"root_hash,omitempty"`
+	TreeAlg  uint32                         `protobuf:"varint,4,opt,name=tree_alg,json=treeAlg,proto3" json:"tree_alg,omitempty"` // same values as BucketInfo.tree_alg
+	Epoch    *EpochProof                    `protobuf:"bytes,5,opt,name=epoch,proto3" json:"epoch,omitempty"`                     // set once the bucket's root is rolled into an epoch
+	Policy   string                         `protobuf:"bytes,6,opt,name=policy,proto3" json:"policy,omitempty"`                   // same values as BucketInfo.policy
 }
 
 func (x *InclusionProofResponse) Reset() {
 	*x = InclusionProofResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bucket_proto_msgTypes[8]
+		mi := &file_bucket_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -527,7 +715,7 @@ func (x *InclusionProofResponse) String() string {
 func (*InclusionProofResponse) ProtoMessage() {}
 
 func (x *InclusionProofResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_bucket_proto_msgTypes[8]
+	mi := &file_bucket_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -540,7 +728,7 @@ func (x *InclusionProofResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InclusionProofResponse.ProtoReflect.Descriptor instead.
 func (*InclusionProofResponse) Descriptor() ([]byte, []int) {
-	return file_bucket_proto_rawDescGZIP(), []int{8}
+	return file_bucket_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *InclusionProofResponse) GetLeafHash() []byte {
@@ -564,64 +752,43 @@ func (x *InclusionProofResponse) GetRootHash() []byte {
 	return nil
 }
 
-// Runner-facing (ledger will call these)
-type MarkBucketClosedRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Ref *BucketRef `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
-}
-
-func (x *MarkBucketClosedRequest) Reset() {
-	*x = MarkBucketClosedRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_bucket_proto_msgTypes[9]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *InclusionProofResponse) GetTreeAlg() uint32 {
+	if x != nil {
+		return x.TreeAlg
 	}
+	return 0
 }
 
-func (x *MarkBucketClosedRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*MarkBucketClosedRequest) ProtoMessage() {}
-
-func (x *MarkBucketClosedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bucket_proto_msgTypes[9]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *InclusionProofResponse) GetEpoch() *EpochProof {
+	if x != nil {
+		return x.Epoch
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use MarkBucketClosedRequest.ProtoReflect.Descriptor instead.
-func (*MarkBucketClosedRequest) Descriptor() ([]byte, []int) {
-	return file_bucket_proto_rawDescGZIP(), []int{9}
+	return nil
 }
 
-func (x *MarkBucketClosedRequest) GetRef() *BucketRef {
+func (x *InclusionProofResponse) GetPolicy() string {
 	if x != nil {
-		return x.Ref
+		return x.Policy
 	}
-	return nil
+	return ""
 }
 
-type MarkBucketClosedResponse struct {
+// Epoch roll-up: one RFC 6962 tree over the roots of buckets closed on one UTC
+// day, so the ledger anchors one root per epoch instead of one per bucket.
+type EpochMember struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Bucket *BucketInfo `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Ref       *BucketRef `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
+	LeafIndex uint32     `protobuf:"varint,2,opt,name=leaf_index,json=leafIndex,proto3" json:"leaf_index,omitempty"`
+	RootHash  []byte     `protobuf:"bytes,3,opt,name=root_hash,json=rootHash,proto3" json:"root_hash,omitempty"` // bucket root the epoch committed to
+	LeafCount uint32     `protobuf:"varint,4,opt,name=leaf_count,json=leafCount,proto3" json:"leaf_count,omitempty"`
+	TreeAlg   uint32     `protobuf:"varint,5,opt,name=tree_alg,json=treeAlg,proto3" json:"tree_alg,omitempty"`
 }
 
-func (x *MarkBucketClosedResponse) Reset() {
-	*x = MarkBucketClosedResponse{}
+func (x *EpochMember) Reset() {
+	*x = EpochMember{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bucket_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -629,13 +796,13 @@ func (x *MarkBucketClosedResponse) Reset() {
 	}
 }
 
-func (x *MarkBucketClosedResponse) String() string {
+func (x *EpochMember) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarkBucketClosedResponse) ProtoMessage() {}
+func (*EpochMember) ProtoMessage() {}
 
-func (x *MarkBucketClosedResponse) ProtoReflect() protoreflect.Message {
+func (x *EpochMember) ProtoReflect() protoreflect.Message {
 	mi := &file_bucket_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -647,30 +814,67 @@ func (x *MarkBucketClosedResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarkBucketClosedResponse.ProtoReflect.Descriptor instead.
-func (*MarkBucketClosedResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use EpochMember.ProtoReflect.Descriptor instead.
+func (*EpochMember) Descriptor() ([]byte, []int) {
 	return file_bucket_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *MarkBucketClosedResponse) GetBucket() *BucketInfo {
+func (x *EpochMember) GetRef() *BucketRef {
 	if x != nil {
-		return x.Bucket
+		return x.Ref
 	}
 	return nil
 }
 
-type SetBucketAnchoredRequest struct {
+func (x *EpochMember) GetLeafIndex() uint32 {
+	if x != nil {
+		return x.LeafIndex
+	}
+	return 0
+}
+
+func (x *EpochMember) GetRootHash() []byte {
+	if x != nil {
+		return x.RootHash
+	}
+	return nil
+}
+
+func (x *EpochMember) GetLeafCount() uint32 {
+	if x != nil {
+		return x.LeafCount
+	}
+	return 0
+}
+
+func (x *EpochMember) GetTreeAlg() uint32 {
+	if x != nil {
+		return x.TreeAlg
+	}
+	return 0
+}
+
+type EpochInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ref        *BucketRef `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
-	Cid        string     `protobuf:"bytes,2,opt,name=cid,proto3" json:"cid,omitempty"`
-	AnchoredTx string     `protobuf:"bytes,3,opt,name=anchored_tx,json=anchoredTx,proto3" json:"anchored_tx,omitempty"`
+	Id          uint64         `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Period      string         `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"` // UTC day, YYYY-MM-DD
+	RootHash    []byte         `protobuf:"bytes,3,opt,name=root_hash,json=rootHash,proto3" json:"root_hash,omitempty"`
+	LeafCount   uint32         `protobuf:"varint,4,opt,name=leaf_count,json=leafCount,proto3" json:"leaf_count,omitempty"`
+	TreeAlg     uint32         `protobuf:"varint,5,opt,name=tree_alg,json=treeAlg,proto3" json:"tree_alg,omitempty"` // always 2
+	Status      string         `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`                   // needs_anchoring|anchoring_pending|anchored|failed
+	Cid         string         `protobuf:"bytes,7,opt,name=cid,proto3" json:"cid,omitempty"`                         // epoch manifest CIDv1 (links member bucket manifests)
+	AnchoredTx  string         `protobuf:"bytes,8,opt,name=anchored_tx,json=anchoredTx,proto3" json:"anchored_tx,omitempty"`
+	AnchoredAt  string         `protobuf:"bytes,9,opt,name=anchored_at,json=anchoredAt,proto3" json:"anchored_at,omitempty"` // RFC3339
+	CreatedAt   string         `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`   // RFC3339
+	Members     []*EpochMember `protobuf:"bytes,11,rep,name=members,proto3" json:"members,omitempty"`
+	AnchorProof []byte         `protobuf:"bytes,12,opt,name=anchor_proof,json=anchorProof,proto3" json:"anchor_proof,omitempty"` // as BucketInfo.anchor_proof
 }
 
-func (x *SetBucketAnchoredRequest) Reset() {
-	*x = SetBucketAnchoredRequest{}
+func (x *EpochInfo) Reset() {
+	*x = EpochInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bucket_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -678,13 +882,13 @@ func (x *SetBucketAnchoredRequest) Reset() {
 	}
 }
 
-func (x *SetBucketAnchoredRequest) String() string {
+func (x *EpochInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetBucketAnchoredRequest) ProtoMessage() {}
+func (*EpochInfo) ProtoMessage() {}
 
-func (x *SetBucketAnchoredRequest) ProtoReflect() protoreflect.Message {
+func (x *EpochInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_bucket_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -696,42 +900,112 @@ func (x *SetBucketAnchoredRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetBucketAnchoredRequest.ProtoReflect.Descriptor instead.
-func (*SetBucketAnchoredRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use EpochInfo.ProtoReflect.Descriptor instead.
+func (*EpochInfo) Descriptor() ([]byte, []int) {
 	return file_bucket_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *SetBucketAnchoredRequest) GetRef() *BucketRef {
+func (x *EpochInfo) GetId() uint64 {
 	if x != nil {
-		return x.Ref
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-func (x *SetBucketAnchoredRequest) GetCid() string {
+func (x *EpochInfo) GetPeriod() string {
 	if x != nil {
-		return x.Cid
+		return x.Period
 	}
 	return ""
 }
 
-func (x *SetBucketAnchoredRequest) GetAnchoredTx() string {
+func (x *EpochInfo) GetRootHash() []byte {
 	if x != nil {
-		return x.AnchoredTx
+		return x.RootHash
+	}
+	return nil
+}
+
+func (x *EpochInfo) GetLeafCount() uint32 {
+	if x != nil {
+		return x.LeafCount
+	}
+	return 0
+}
+
+func (x *EpochInfo) GetTreeAlg() uint32 {
+	if x != nil {
+		return x.TreeAlg
+	}
+	return 0
+}
+
+func (x *EpochInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
 	}
 	return ""
 }
 
-type SetBucketAnchoredResponse struct {
+func (x *EpochInfo) GetCid() string {
+	if x != nil {
+		return x.Cid
+	}
+	return ""
+}
+
+func (x *EpochInfo) GetAnchoredTx() string {
+	if x != nil {
+		return x.AnchoredTx
+	}
+	return ""
+}
+
+func (x *EpochInfo) GetAnchoredAt() string {
+	if x != nil {
+		return x.AnchoredAt
+	}
+	return ""
+}
+
+func (x *EpochInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *EpochInfo) GetMembers() []*EpochMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *EpochInfo) GetAnchorProof() []byte {
+	if x != nil {
+		return x.AnchorProof
+	}
+	return nil
+}
+
+// Second level of an inclusion proof: bucket root -> epoch root. leaf is
+// sha256 over the bucket's scope, key, tree_alg, leaf_count and root (see
+// crypto.EpochLeaf); it is hashed as 0x00||leaf like any RFC 6962 leaf.
+type EpochProof struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Bucket *BucketInfo `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Epoch           *EpochInfo                     `protobuf:"bytes,1,opt,name=epoch,proto3" json:"epoch,omitempty"` // without members
+	LeafIndex       uint32                         `protobuf:"varint,2,opt,name=leaf_index,json=leafIndex,proto3" json:"leaf_index,omitempty"`
+	Leaf            []byte                         `protobuf:"bytes,3,opt,name=leaf,proto3" json:"leaf,omitempty"`
+	Path            []*InclusionProofResponse_Step `protobuf:"bytes,4,rep,name=path,proto3" json:"path,omitempty"`
+	BucketLeafCount uint32                         `protobuf:"varint,5,opt,name=bucket_leaf_count,json=bucketLeafCount,proto3" json:"bucket_leaf_count,omitempty"`
 }
 
-func (x *SetBucketAnchoredResponse) Reset() {
-	*x = SetBucketAnchoredResponse{}
+func (x *EpochProof) Reset() {
+	*x = EpochProof{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bucket_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -739,13 +1013,13 @@ func (x *SetBucketAnchoredResponse) Reset() {
 	}
 }
 
-func (x *SetBucketAnchoredResponse) String() string {
+func (x *EpochProof) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetBucketAnchoredResponse) ProtoMessage() {}
+func (*EpochProof) ProtoMessage() {}
 
-func (x *SetBucketAnchoredResponse) ProtoReflect() protoreflect.Message {
+func (x *EpochProof) ProtoReflect() protoreflect.Message {
 	mi := &file_bucket_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -757,31 +1031,61 @@ func (x *SetBucketAnchoredResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetBucketAnchoredResponse.ProtoReflect.Descriptor instead.
-func (*SetBucketAnchoredResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use EpochProof.ProtoReflect.Descriptor instead.
+func (*EpochProof) Descriptor() ([]byte, []int) {
 	return file_bucket_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *SetBucketAnchoredResponse) GetBucket() *BucketInfo {
+func (x *EpochProof) GetEpoch() *EpochInfo {
 	if x != nil {
-		return x.Bucket
+		return x.Epoch
 	}
 	return nil
 }
 
-// ADD:
-type ListBucketsByStatusRequest struct {
+func (x *EpochProof) GetLeafIndex() uint32 {
+	if x != nil {
+		return x.LeafIndex
+	}
+	return 0
+}
+
+func (x *EpochProof) GetLeaf() []byte {
+	if x != nil {
+		return x.Leaf
+	}
+	return nil
+}
+
+func (x *EpochProof) GetPath() []*InclusionProofResponse_Step {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *EpochProof) GetBucketLeafCount() uint32 {
+	if x != nil {
+		return x.BucketLeafCount
+	}
+	return 0
+}
+
+// Proves the bucket at old_size leaves is a prefix of the bucket at new_size
+// leaves (RFC 6962 §2.1.2). Both sizes must be roots the bucket published;
+// new_size = 0 means the current size. Only tree_alg 2 buckets support this.
+type ConsistencyProofRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status    string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Limit     int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Ref     *BucketRef `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
+	OldSize uint32     `protobuf:"varint,2,opt,name=old_size,json=oldSize,proto3" json:"old_size,omitempty"`
+	NewSize uint32     `protobuf:"varint,3,opt,name=new_size,json=newSize,proto3" json:"new_size,omitempty"`
 }
 
-func (x *ListBucketsByStatusRequest) Reset() {
-	*x = ListBucketsByStatusRequest{}
+func (x *ConsistencyProofRequest) Reset() {
+	*x = ConsistencyProofRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bucket_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -789,13 +1093,13 @@ func (x *ListBucketsByStatusRequest) Reset() {
 	}
 }
 
-func (x *ListBucketsByStatusRequest) String() string {
+func (x *ConsistencyProofRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBucketsByStatusRequest) ProtoMessage() {}
+func (*ConsistencyProofRequest) ProtoMessage() {}
 
-func (x *ListBucketsByStatusRequest) ProtoReflect() protoreflect.Message {
+func (x *ConsistencyProofRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_bucket_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -807,43 +1111,47 @@ func (x *ListBucketsByStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBucketsByStatusRequest.ProtoReflect.Descriptor instead.
-func (*ListBucketsByStatusRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ConsistencyProofRequest.ProtoReflect.Descriptor instead.
+func (*ConsistencyProofRequest) Descriptor() ([]byte, []int) {
 	return file_bucket_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ListBucketsByStatusRequest) GetStatus() string {
+func (x *ConsistencyProofRequest) GetRef() *BucketRef {
 	if x != nil {
-		return x.Status
+		return x.Ref
 	}
-	return ""
+	return nil
 }
 
-func (x *ListBucketsByStatusRequest) GetLimit() int32 {
+func (x *ConsistencyProofRequest) GetOldSize() uint32 {
 	if x != nil {
-		return x.Limit
+		return x.OldSize
 	}
 	return 0
 }
 
-func (x *ListBucketsByStatusRequest) GetPageToken() string {
+func (x *ConsistencyProofRequest) GetNewSize() uint32 {
 	if x != nil {
-		return x.PageToken
+		return x.NewSize
 	}
-	return ""
+	return 0
 }
 
-type ListBucketsByStatusResponse struct {
+type ConsistencyProofResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Buckets       []*BucketInfo `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets,omitempty"`
-	NextPageToken string        `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	OldSize uint32   `protobuf:"varint,1,opt,name=old_size,json=oldSize,proto3" json:"old_size,omitempty"`
+	NewSize uint32   `protobuf:"varint,2,opt,name=new_size,json=newSize,proto3" json:"new_size,omitempty"`
+	OldRoot []byte   `protobuf:"bytes,3,opt,name=old_root,json=oldRoot,proto3" json:"old_root,omitempty"`
+	NewRoot []byte   `protobuf:"bytes,4,opt,name=new_root,json=newRoot,proto3" json:"new_root,omitempty"`
+	Proof   [][]byte `protobuf:"bytes,5,rep,name=proof,proto3" json:"proof,omitempty"`
+	TreeAlg uint32   `protobuf:"varint,6,opt,name=tree_alg,json=treeAlg,proto3" json:"tree_alg,omitempty"`
 }
 
-func (x *ListBucketsByStatusResponse) Reset() {
-	*x = ListBucketsByStatusResponse{}
+func (x *ConsistencyProofResponse) Reset() {
+	*x = ConsistencyProofResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bucket_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -851,13 +1159,13 @@ func (x *ListBucketsByStatusResponse) Reset() {
 	}
 }
 
-func (x *ListBucketsByStatusResponse) String() string {
+func (x *ConsistencyProofResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBucketsByStatusResponse) ProtoMessage() {}
+func (*ConsistencyProofResponse) ProtoMessage() {}
 
-func (x *ListBucketsByStatusResponse) ProtoReflect() protoreflect.Message {
+func (x *ConsistencyProofResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_bucket_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -869,36 +1177,65 @@ func (x *ListBucketsByStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBucketsByStatusResponse.ProtoReflect.Descriptor instead.
-func (*ListBucketsByStatusResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ConsistencyProofResponse.ProtoReflect.Descriptor instead.
+func (*ConsistencyProofResponse) Descriptor() ([]byte, []int) {
 	return file_bucket_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ListBucketsByStatusResponse) GetBuckets() []*BucketInfo {
+func (x *ConsistencyProofResponse) GetOldSize() uint32 {
 	if x != nil {
-		return x.Buckets
+		return x.OldSize
+	}
+	return 0
+}
+
+func (x *ConsistencyProofResponse) GetNewSize() uint32 {
+	if x != nil {
+		return x.NewSize
+	}
+	return 0
+}
+
+func (x *ConsistencyProofResponse) GetOldRoot() []byte {
+	if x != nil {
+		return x.OldRoot
 	}
 	return nil
 }
 
-func (x *ListBucketsByStatusResponse) GetNextPageToken() string {
+func (x *ConsistencyProofResponse) GetNewRoot() []byte {
 	if x != nil {
-		return x.NextPageToken
+		return x.NewRoot
 	}
-	return ""
+	return nil
 }
 
-type InclusionProofResponse_Step struct {
+func (x *ConsistencyProofResponse) GetProof() [][]byte {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
+func (x *ConsistencyProofResponse) GetTreeAlg() uint32 {
+	if x != nil {
+		return x.TreeAlg
+	}
+	return 0
+}
+
+// Self-contained evidence bundle (items, leaves, proofs, anchor record) for
+// offline checking with trustflow-verify.
+type ExportBucketRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sibling       []byte `protobuf:"bytes,1,opt,name=sibling,proto3" json:"sibling,omitempty"`
-	SiblingIsLeft bool   `protobuf:"varint,2,opt,name=sibling_is_left,json=siblingIsLeft,proto3" json:"sibling_is_left,omitempty"`
+	Ref *BucketRef `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
 }
 
-func (x *InclusionProofResponse_Step) Reset() {
-	*x = InclusionProofResponse_Step{}
+func (x *ExportBucketRequest) Reset() {
+	*x = ExportBucketRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bucket_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -906,13 +1243,13 @@ func (x *InclusionProofResponse_Step) Reset() {
 	}
 }
 
-func (x *InclusionProofResponse_Step) String() string {
+func (x *ExportBucketRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InclusionProofResponse_Step) ProtoMessage() {}
+func (*ExportBucketRequest) ProtoMessage() {}
 
-func (x *InclusionProofResponse_Step) ProtoReflect() protoreflect.Message {
+func (x *ExportBucketRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_bucket_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -924,253 +1261,3022 @@ func (x *InclusionProofResponse_Step) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InclusionProofResponse_Step.ProtoReflect.Descriptor instead.
-func (*InclusionProofResponse_Step) Descriptor() ([]byte, []int) {
-	return file_bucket_proto_rawDescGZIP(), []int{8, 0}
+// Deprecated: Use ExportBucketRequest.ProtoReflect.Descriptor instead.
+func (*ExportBucketRequest) Descriptor() ([]byte, []int) {
+	return file_bucket_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *InclusionProofResponse_Step) GetSibling() []byte {
+func (x *ExportBucketRequest) GetRef() *BucketRef {
 	if x != nil {
-		return x.Sibling
+		return x.Ref
 	}
 	return nil
 }
 
-func (x *InclusionProofResponse_Step) GetSiblingIsLeft() bool {
-	if x != nil {
-		return x.SiblingIsLeft
+type ExportBucketResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BundleJson []byte `protobuf:"bytes,1,opt,name=bundle_json,json=bundleJson,proto3" json:"bundle_json,omitempty"`
+}
+
+func (x *ExportBucketResponse) Reset() {
+	*x = ExportBucketResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bucket_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return false
 }
 
-var File_bucket_proto protoreflect.FileDescriptor
+func (x *ExportBucketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-var file_bucket_proto_rawDesc = []byte{
-	0x0a, 0x0c, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13,
-	0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
-	0x2e, 0x76, 0x31, 0x22, 0x47, 0x0a, 0x05, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
-	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1d, 0x0a,
-	0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x5c, 0x0a, 0x09,
-	0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x66, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x63, 0x6f,
-	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74,
-	0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x63, 0x6f, 0x70, 0x65, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x62,
-	0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x22, 0x83, 0x02, 0x0a, 0x0a, 0x42,
-	0x75, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x30, 0x0a, 0x03, 0x72, 0x65, 0x66,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c,
-	0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x63,
-	0x6b, 0x65, 0x74, 0x52, 0x65, 0x66, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x1b, 0x0a, 0x09, 0x72,
-	0x6f, 0x6f, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08,
-	0x72, 0x6f, 0x6f, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x65, 0x61, 0x66,
-	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6c, 0x65,
-	0x61, 0x66, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69,
-	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x65, 0x64, 0x5f, 0x74, 0x78,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x65, 0x64,
-	0x54, 0x78, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x65,
-	0x64, 0x41, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x41, 0x74,
-	0x22, 0x7b, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f,
-	0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x6f, 0x70,
-	0x65, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1d,
-	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x78, 0x0a,
-	0x13, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f,
-	0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x63, 0x6b,
-	0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12,
-	0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61,
-	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x44, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x42, 0x75,
-	0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x03, 0x72,
-	0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74,
-	0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42,
-	0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x66, 0x52, 0x03, 0x72, 0x65, 0x66, 0x22, 0x4c, 0x0a,
-	0x11, 0x47, 0x65, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62,
-	0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x75, 0x0a, 0x15, 0x49,
-	0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75,
-	0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65,
-	0x66, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x49, 0x64, 0x22, 0xe2, 0x01, 0x0a, 0x16, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e,
-	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a,
-	0x09, 0x6c, 0x65, 0x61, 0x66, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x08, 0x6c, 0x65, 0x61, 0x66, 0x48, 0x61, 0x73, 0x68, 0x12, 0x44, 0x0a, 0x04, 0x70, 0x61,
-	0x74, 0x68, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74,
-	0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49,
-	0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x65, 0x70, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
-	0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x74, 0x48, 0x61, 0x73, 0x68, 0x1a, 0x48, 0x0a,
-	0x04, 0x53, 0x74, 0x65, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x69, 0x62, 0x6c, 0x69, 0x6e, 0x67,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x73, 0x69, 0x62, 0x6c, 0x69, 0x6e, 0x67, 0x12,
-	0x26, 0x0a, 0x0f, 0x73, 0x69, 0x62, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x73, 0x5f, 0x6c, 0x65,
-	0x66, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x69, 0x62, 0x6c, 0x69, 0x6e,
-	0x67, 0x49, 0x73, 0x4c, 0x65, 0x66, 0x74, 0x22, 0x4b, 0x0a, 0x17, 0x4d, 0x61, 0x72, 0x6b, 0x42,
-	0x75, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x30, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1e, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b,
-	0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x66, 0x52,
-	0x03, 0x72, 0x65, 0x66, 0x22, 0x53, 0x0a, 0x18, 0x4d, 0x61, 0x72, 0x6b, 0x42, 0x75, 0x63, 0x6b,
-	0x65, 0x74, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x37, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1f, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63,
-	0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x7f, 0x0a, 0x18, 0x53, 0x65, 0x74,
-	0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x65, 0x64, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62,
-	0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52,
-	0x65, 0x66, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6e, 0x63,
-	0x68, 0x6f, 0x72, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x65, 0x64, 0x54, 0x78, 0x22, 0x54, 0x0a, 0x19, 0x53, 0x65,
-	0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x65, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66,
-	0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75,
-	0x63, 0x6b, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
-	0x22, 0x69, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x42,
-	0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
-	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x80, 0x01, 0x0a, 0x1b,
-	0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x42, 0x79, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x07, 0x62,
-	0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x74,
-	0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x62,
-	0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70,
-	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x32, 0x97,
-	0x05, 0x0a, 0x0d, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0x60, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12,
-	0x27, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b,
-	0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74,
-	0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x5a, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12,
-	0x25, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b,
-	0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c,
-	0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69,
-	0x0a, 0x0e, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66,
-	0x12, 0x2a, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63,
-	0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e,
-	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x74,
-	0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x6f,
-	0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x10, 0x4d, 0x61, 0x72,
-	0x6b, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x12, 0x2c, 0x2e,
-	0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6c,
-	0x6f, 0x73, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x74, 0x72,
-	0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6c, 0x6f, 0x73,
-	0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x11, 0x53, 0x65,
-	0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x65, 0x64, 0x12,
-	0x2d, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b,
-	0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x41,
-	0x6e, 0x63, 0x68, 0x6f, 0x72, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e,
-	0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65,
-	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x41, 0x6e,
-	0x63, 0x68, 0x6f, 0x72, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x78,
-	0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x42, 0x79, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2f, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f,
-	0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x42, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c,
-	0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x42, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x43, 0x5a, 0x41, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x75, 0x73, 0x70, 0x6c, 0x75, 0x73, 0x62, 0x75,
-	0x73, 0x2f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2f, 0x64, 0x61, 0x74, 0x61,
-	0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x62, 0x75, 0x63, 0x6b,
-	0x65, 0x74, 0x76, 0x31, 0x3b, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x76, 0x31, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (*ExportBucketResponse) ProtoMessage() {}
+
+func (x *ExportBucketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bucket_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var (
-	file_bucket_proto_rawDescOnce sync.Once
-	file_bucket_proto_rawDescData = file_bucket_proto_rawDesc
-)
+// Deprecated: Use ExportBucketResponse.ProtoReflect.Descriptor instead.
+func (*ExportBucketResponse) Descriptor() ([]byte, []int) {
+	return file_bucket_proto_rawDescGZIP(), []int{16}
+}
 
-func file_bucket_proto_rawDescGZIP() []byte {
-	file_bucket_proto_rawDescOnce.Do(func() {
-		file_bucket_proto_rawDescData = protoimpl.X.CompressGZIP(file_bucket_proto_rawDescData)
-	})
-	return file_bucket_proto_rawDescData
+func (x *ExportBucketResponse) GetBundleJson() []byte {
+	if x != nil {
+		return x.BundleJson
+	}
+	return nil
 }
 
-var file_bucket_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
-var file_bucket_proto_goTypes = []any{
-	(*Scope)(nil),                       // 0: trustflow.bucket.v1.Scope
-	(*BucketRef)(nil),                   // 1: trustflow.bucket.v1.BucketRef
-	(*BucketInfo)(nil),                  // 2: trustflow.bucket.v1.BucketInfo
-	(*ListBucketsRequest)(nil),          // 3: trustflow.bucket.v1.ListBucketsRequest
-	(*ListBucketsResponse)(nil),         // 4: trustflow.bucket.v1.ListBucketsResponse
-	(*GetBucketRequest)(nil),            // 5: trustflow.bucket.v1.GetBucketRequest
-	(*GetBucketResponse)(nil),           // 6: trustflow.bucket.v1.GetBucketResponse
-	(*InclusionProofRequest)(nil),       // 7: trustflow.bucket.v1.InclusionProofRequest
-	(*InclusionProofResponse)(nil),      // 8: trustflow.bucket.v1.InclusionProofResponse
-	(*MarkBucketClosedRequest)(nil),     // 9: trustflow.bucket.v1.MarkBucketClosedRequest
-	(*MarkBucketClosedResponse)(nil),    // 10: trustflow.bucket.v1.MarkBucketClosedResponse
-	(*SetBucketAnchoredRequest)(nil),    // 11: trustflow.bucket.v1.SetBucketAnchoredRequest
-	(*SetBucketAnchoredResponse)(nil),   // 12: trustflow.bucket.v1.SetBucketAnchoredResponse
-	(*ListBucketsByStatusRequest)(nil),  // 13: trustflow.bucket.v1.ListBucketsByStatusRequest
-	(*ListBucketsByStatusResponse)(nil), // 14: trustflow.bucket.v1.ListBucketsByStatusResponse
-	(*InclusionProofResponse_Step)(nil), // 15: trustflow.bucket.v1.InclusionProofResponse.Step
+// Proof that seq from_seq..to_seq of a scope (seq_in_entity) are all present,
+// against the sequence log a sealed bucket's chain link commits to.
+// from_seq 0 means 1; to_seq 0 means the latest committed seq.
+type CompletenessProofRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Scope   *Scope `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"`
+	FromSeq uint64 `protobuf:"varint,2,opt,name=from_seq,json=fromSeq,proto3" json:"from_seq,omitempty"`
+	ToSeq   uint64 `protobuf:"varint,3,opt,name=to_seq,json=toSeq,proto3" json:"to_seq,omitempty"`
 }
-var file_bucket_proto_depIdxs = []int32{
-	0,  // 0: trustflow.bucket.v1.BucketRef.scope:type_name -> trustflow.bucket.v1.Scope
-	1,  // 1: trustflow.bucket.v1.BucketInfo.ref:type_name -> trustflow.bucket.v1.BucketRef
-	0,  // 2: trustflow.bucket.v1.ListBucketsRequest.scope:type_name -> trustflow.bucket.v1.Scope
-	2,  // 3: trustflow.bucket.v1.ListBucketsResponse.buckets:type_name -> trustflow.bucket.v1.BucketInfo
-	1,  // 4: trustflow.bucket.v1.GetBucketRequest.ref:type_name -> trustflow.bucket.v1.BucketRef
-	2,  // 5: trustflow.bucket.v1.GetBucketResponse.bucket:type_name -> trustflow.bucket.v1.BucketInfo
-	1,  // 6: trustflow.bucket.v1.InclusionProofRequest.ref:type_name -> trustflow.bucket.v1.BucketRef
-	15, // 7: trustflow.bucket.v1.InclusionProofResponse.path:type_name -> trustflow.bucket.v1.InclusionProofResponse.Step
-	1,  // 8: trustflow.bucket.v1.MarkBucketClosedRequest.ref:type_name -> trustflow.bucket.v1.BucketRef
-	2,  // 9: trustflow.bucket.v1.MarkBucketClosedResponse.bucket:type_name -> trustflow.bucket.v1.BucketInfo
-	1,  // 10: trustflow.bucket.v1.SetBucketAnchoredRequest.ref:type_name -> trustflow.bucket.v1.BucketRef
-	2,  // 11: trustflow.bucket.v1.SetBucketAnchoredResponse.bucket:type_name -> trustflow.bucket.v1.BucketInfo
-	2,  // 12: trustflow.bucket.v1.ListBucketsByStatusResponse.buckets:type_name -> trustflow.bucket.v1.BucketInfo
-	3,  // 13: trustflow.bucket.v1.BucketService.ListBuckets:input_type -> trustflow.bucket.v1.ListBucketsRequest
-	5,  // 14: trustflow.bucket.v1.BucketService.GetBucket:input_type -> trustflow.bucket.v1.GetBucketRequest
-	7,  // 15: trustflow.bucket.v1.BucketService.InclusionProof:input_type -> trustflow.bucket.v1.InclusionProofRequest
-	9,  // 16: trustflow.bucket.v1.BucketService.MarkBucketClosed:input_type -> trustflow.bucket.v1.MarkBucketClosedRequest
-	11, // 17: trustflow.bucket.v1.BucketService.SetBucketAnchored:input_type -> trustflow.bucket.v1.SetBucketAnchoredRequest
-	13, // 18: trustflow.bucket.v1.BucketService.ListBucketsByStatus:input_type -> trustflow.bucket.v1.ListBucketsByStatusRequest
-	4,  // 19: trustflow.bucket.v1.BucketService.ListBuckets:output_type -> trustflow.bucket.v1.ListBucketsResponse
-	6,  // 20: trustflow.bucket.v1.BucketService.GetBucket:output_type -> trustflow.bucket.v1.GetBucketResponse
-	8,  // 21: trustflow.bucket.v1.BucketService.InclusionProof:output_type -> trustflow.bucket.v1.InclusionProofResponse
-	10, // 22: trustflow.bucket.v1.BucketService.MarkBucketClosed:output_type -> trustflow.bucket.v1.MarkBucketClosedResponse
-	12, // 23: trustflow.bucket.v1.BucketService.SetBucketAnchored:output_type -> trustflow.bucket.v1.SetBucketAnchoredResponse
-	14, // 24: trustflow.bucket.v1.BucketService.ListBucketsByStatus:output_type -> trustflow.bucket.v1.ListBucketsByStatusResponse
-	19, // [19:25] is the sub-list for method output_type
-	13, // [13:19] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+
+func (x *CompletenessProofRequest) Reset() {
+	*x = CompletenessProofRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bucket_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompletenessProofRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompletenessProofRequest) ProtoMessage() {}
+
+func (x *CompletenessProofRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bucket_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompletenessProofRequest.ProtoReflect.Descriptor instead.
+func (*CompletenessProofRequest) Descriptor() ([]byte, []int) {
+	return file_bucket_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CompletenessProofRequest) GetScope() *Scope {
+	if x != nil {
+		return x.Scope
+	}
+	return nil
+}
+
+func (x *CompletenessProofRequest) GetFromSeq() uint64 {
+	if x != nil {
+		return x.FromSeq
+	}
+	return 0
+}
+
+func (x *CompletenessProofRequest) GetToSeq() uint64 {
+	if x != nil {
+		return x.ToSeq
+	}
+	return 0
+}
+
+type CompletenessProofResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProofJson []byte `protobuf:"bytes,1,opt,name=proof_json,json=proofJson,proto3" json:"proof_json,omitempty"`
+}
+
+func (x *CompletenessProofResponse) Reset() {
+	*x = CompletenessProofResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bucket_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompletenessProofResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompletenessProofResponse) ProtoMessage() {}
+
+func (x *CompletenessProofResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bucket_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompletenessProofResponse.ProtoReflect.Descriptor instead.
+func (*CompletenessProofResponse) Descriptor() ([]byte, []int) {
+	return file_bucket_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CompletenessProofResponse) GetProofJson() []byte {
+	if x != nil {
+		return x.ProofJson
+	}
+	return nil
+}
+
+// Runner-facing (ledger will call these). Bucket status only moves
+//
+//	open -> needs_anchoring -> anchoring -> anchoring_pending -> anchored,
+//	anchoring -> failed, anchoring_pending -> needs_anchoring | failed,
+//	failed -> needs_anchoring | anchor_failed, anchor_failed -> needs_anchoring
+//
+// (epoch members too); actor (required) and reason are recorded in the
+// bucket's history.
+type MarkBucketClosedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ref    *BucketRef `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
+	Actor  string     `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	Reason string     `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *MarkBucketClosedRequest) Reset() {
+	*x = MarkBucketClosedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bucket_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarkBucketClosedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkBucketClosedRequest) ProtoMessage() {}
+
+func (x *MarkBucketClosedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bucket_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkBucketClosedRequest.ProtoReflect.Descriptor instead.
+func (*MarkBucketClosedRequest) Descriptor() ([]byte, []int) {
+	return file_bucket_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *MarkBucketClosedRequest) GetRef() *BucketRef {
+	if x != nil {
+		return x.Ref
+	}
+	return nil
+}
+
+func (x *MarkBucketClosedRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *MarkBucketClosedRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type MarkBucketClosedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bucket *BucketInfo `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+}
+
+func (x *MarkBucketClosedResponse) Reset() {
+	*x = MarkBucketClosedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bucket_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarkBucketClosedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkBucketClosedResponse) ProtoMessage() {}
+
+func (x *MarkBucketClosedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bucket_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkBucketClosedResponse.ProtoReflect.Descriptor instead.
+func (*MarkBucketClosedResponse) Descriptor() ([]byte, []int) {
+	return file_bucket_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *MarkBucketClosedResponse) GetBucket() *BucketInfo {
+	if x != nil {
+		return x.Bucket
+	}
+	return nil
+}
+
+// Only for a bucket the caller claimed (status anchoring): records the sent
+// tx and moves it to anchoring_pending.
+type SetBucketAnchorPendingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ref         *BucketRef `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
+	Cid         string     `protobuf:"bytes,2,opt,name=cid,proto3" json:"cid,omitempty"`
+	AnchoredTx  string     `protobuf:"bytes,3,opt,name=anchored_tx,json=anchoredTx,proto3" json:"anchored_tx,omitempty"`
+	Actor       string     `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`
+	AnchorProof []byte     `protobuf:"bytes,5,opt,name=anchor_proof,json=anchorProof,proto3" json:"anchor_proof,omitempty"` // when the anchor is a token rather than a chain tx
+}
+
+func (x *SetBucketAnchorPendingRequest) Reset() {
+	*x = SetBucketAnchorPendingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bucket_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetBucketAnchorPendingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBucketAnchorPendingRequest) ProtoMessage() {}
+
+func (x *SetBucketAnchorPendingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bucket_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBucketAnchorPendingRequest.ProtoReflect.Descriptor instead.
+func (*SetBucketAnchorPendingRequest) Descriptor() ([]byte, []int) {
+	return file_bucket_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SetBucketAnchorPendingRequest) GetRef() *BucketRef {
+	if x != nil {
+		return x.Ref
+	}
+	return nil
+}
+
+func (x *SetBucketAnchorPendingRequest) GetCid() string {
+	if x != nil {
+		return x.Cid
+	}
+	return ""
+}
+
+func (x *SetBucketAnchorPendingRequest) GetAnchoredTx() string {
+	if x != nil {
+		return x.AnchoredTx
+	}
+	return ""
+}
+
+func (x *SetBucketAnchorPendingRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *SetBucketAnchorPendingRequest) GetAnchorProof() []byte {
+	if x != nil {
+		return x.AnchorProof
+	}
+	return nil
+}
+
+type SetBucketAnchorPendingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bucket *BucketInfo `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+}
+
+func (x *SetBucketAnchorPendingResponse) Reset() {
+	*x = SetBucketAnchorPendingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bucket_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetBucketAnchorPendingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBucketAnchorPendingResponse) ProtoMessage() {}
+
+func (x *SetBucketAnchorPendingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bucket_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBucketAnchorPendingResponse.ProtoReflect.Descriptor instead.
+func (*SetBucketAnchorPendingResponse) Descriptor() ([]byte, []int) {
+	return file_bucket_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SetBucketAnchorPendingResponse) GetBucket() *BucketInfo {
+	if x != nil {
+		return x.Bucket
+	}
+	return nil
+}
+
+// Only for an anchoring_pending bucket whose tx is anchored_tx, once that tx
+// has enough confirmations.
+type SetBucketAnchoredRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ref         *BucketRef `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
+	AnchoredTx  string     `protobuf:"bytes,3,opt,name=anchored_tx,json=anchoredTx,proto3" json:"anchored_tx,omitempty"`
+	Actor       string     `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`
+	BlockNumber uint64     `protobuf:"varint,5,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	BlockHash   string     `protobuf:"bytes,6,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+}
+
+func (x *SetBucketAnchoredRequest) Reset() {
+	*x = SetBucketAnchoredRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bucket_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetBucketAnchoredRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBucketAnchoredRequest) ProtoMessage() {}
+
+func (x *SetBucketAnchoredRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bucket_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBucketAnchoredRequest.ProtoReflect.Descriptor instead.
+func (*SetBucketAnchoredRequest) Descriptor() ([]byte, []int) {
+	return file_bucket_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SetBucketAnchoredRequest) GetRef() *BucketRef {
+	if x != nil {
+		return x.Ref
+	}
+	return nil
+}
+
+func (x *SetBucketAnchoredRequest) GetAnchoredTx() string {
+	if x != nil {
+		return x.AnchoredTx
+	}
+	return ""
+}
+
+func (x *SetBucketAnchoredRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *SetBucketAnchoredRequest) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *SetBucketAnchoredRequest) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+type SetBucketAnchoredResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bucket *BucketInfo `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+}
+
+func (x *SetBucketAnchoredResponse) Reset() {
+	*x = SetBucketAnchoredResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bucket_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetBucketAnchoredResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBucketAnchoredResponse) ProtoMessage() {}
+
+func (x *SetBucketAnchoredResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bucket_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBucketAnchoredResponse.ProtoReflect.Descriptor instead.
+func (*SetBucketAnchoredResponse) Descriptor() ([]byte, []int) {
+	return file_bucket_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SetBucketAnchoredResponse) GetBucket() *BucketInfo {
+	if x != nil {
+		return x.Bucket
+	}
+	return nil
+}
+
+// Claims a bucket (anchoring), marks it failed, gives it up (anchor_failed)
+// or retries it (needs_anchoring), e.g. when its pending tx is dropped.
+// Sealing and anchoring use the RPCs above.
+type SetBucketStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ref    *BucketRef `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
+	Status string     `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Actor  string     `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	Reason string     `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *SetBucketStatusRequest) Reset() {
+	*x = SetBucketStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bucket_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetBucketStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBucketStatusRequest) ProtoMessage() {}
+
+func (x *SetBucketStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bucket_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBucketStatusRequest.ProtoReflect.Descriptor instead.
+func (*SetBucketStatusRequest) Descriptor() ([]byte, []int) {
+	return file_bucket_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SetBucketStatusRequest) GetRef() *BucketRef {
+	if x != nil {
+		return x.Ref
+	}
+	return nil
+}
+
+func (x *SetBucketStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SetBucketStatusRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *SetBucketStatusRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type SetBucketStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bucket *BucketInfo `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+}
+
+func (x *SetBucketStatusResponse) Reset() {
+	*x = SetBucketStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bucket_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetBucketStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBucketStatusResponse) ProtoMessage() {}
+
+func (x *SetBucketStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bucket_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBucketStatusResponse.ProtoReflect.Descriptor instead.
+func (*SetBucketStatusResponse) Descriptor() ([]byte, []int) {
+	return file_bucket_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SetBucketStatusResponse) GetBucket() *BucketInfo {
+	if x != nil {
+		return x.Bucket
+	}
+	return nil
+}
+
+type BucketStatusEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromStatus string `protobuf:"bytes,1,opt,name=from_status,json=fromStatus,proto3" json:"from_status,omitempty"`
+	ToStatus   string `protobuf:"bytes,2,opt,name=to_status,json=toStatus,proto3" json:"to_status,omitempty"`
+	Actor      string `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	Reason     string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	At         string `protobuf:"bytes,5,opt,name=at,proto3" json:"at,omitempty"` // RFC3339
+}
+
+func (x *BucketStatusEvent) Reset() {
+	*x = BucketStatusEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bucket_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BucketStatusEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BucketStatusEvent) ProtoMessage() {}
+
+func (x *BucketStatusEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bucket_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BucketStatusEvent.ProtoReflect.Descriptor instead.
+func (*BucketStatusEvent) Descriptor() ([]byte, []int) {
+	return file_bucket_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *BucketStatusEvent) GetFromStatus() string {
+	if x != nil {
+		return x.FromStatus
+	}
+	return ""
+}
+
+func (x *BucketStatusEvent) GetToStatus() string {
+	if x != nil {
+		return x.ToStatus
+	}
+	return ""
+}
+
+func (x *BucketStatusEvent) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *BucketStatusEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BucketStatusEvent) GetAt() string {
+	if x != nil {
+		return x.At
+	}
+	return ""
+}
+
+type ListBucketHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ref *BucketRef `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
+}
+
+func (x *ListBucketHistoryRequest) Reset() {
+	*x = ListBucketHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bucket_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBucketHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBucketHistoryRequest) ProtoMessage() {}
+
+func (x *ListBucketHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bucket_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBucketHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListBucketHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_bucket_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListBucketHistoryRequest) GetRef() *BucketRef {
+	if x != nil {
+		return x.Ref
+	}
+	return nil
+}
+
+type ListBucketHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*BucketStatusEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *ListBucketHistoryResponse) Reset() {
+	*x = ListBucketHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bucket_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBucketHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBucketHistoryResponse) ProtoMessage() {}
+
+func (x *ListBucketHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bucket_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBucketHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListBucketHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_bucket_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListBucketHistoryResponse) GetEvents() []*BucketStatusEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// Ledger stores a checkpoint it signed; the root must be one the bucket published.
+type AddBucketCheckpointRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ref        *BucketRef  `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
+	Checkpoint *Checkpoint `protobuf:"bytes,2,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+}
+
+func (x *AddBucketCheckpointRequest) Reset() {
+	*x = AddBucketCheckpointRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bucket_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddBucketCheckpointRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddBucketCheckpointRequest) ProtoMessage() {}
+
+func (x *AddBucketCheckpointRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bucket_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddBucketCheckpointRequest.ProtoReflect.Descriptor instead.
+func (*AddBucketCheckpointRequest) Descriptor() ([]byte, []int) {
+	return file_bucket_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *AddBucketCheckpointRequest) GetRef() *BucketRef {
+	if x != nil {
+		return x.Ref
+	}
+	return nil
+}
+
+func (x *AddBucketCheckpointRequest) GetCheckpoint() *Checkpoint {
+	if x != nil {
+		return x.Checkpoint
+	}
+	return nil
+}
+
+type AddBucketCheckpointResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AddBucketCheckpointResponse) Reset() {
+	*x = AddBucketCheckpointResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bucket_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddBucketCheckpointResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddBucketCheckpointResponse) ProtoMessage() {}
+
+func (x *AddBucketCheckpointResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bucket_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddBucketCheckpointResponse.ProtoReflect.Descriptor instead.
+func (*AddBucketCheckpointResponse) Descriptor() ([]byte, []int) {
+	return file_bucket_proto_rawDescGZIP(), []int{31}
+}
+
+// Writes the bucket as a CARv1 (DAG-CBOR manifest + item blocks) to the data
+// server's blob directory; cid is the manifest CIDv1 to anchor and store.
+type PackBucketRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ref *BucketRef `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
+}
+
+func (x *PackBucketRequest) Reset() {
+	*x = PackBucketRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bucket_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PackBucketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackBucketRequest) ProtoMessage() {}
+
+func (x *PackBucketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bucket_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackBucketRequest.ProtoReflect.Descriptor instead.
+func (*PackBucketRequest) Descriptor() ([]byte, []int) {
+	return file_bucket_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *PackBucketRequest) GetRef() *BucketRef {
+	if x != nil {
+		return x.Ref
+	}
+	return nil
+}
+
+type PackBucketResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cid     string `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	CarPath string `protobuf:"bytes,2,opt,name=car_path,json=carPath,proto3" json:"car_path,omitempty"`
+	CarSize uint64 `protobuf:"varint,3,opt,name=car_size,json=carSize,proto3" json:"car_size,omitempty"`
+}
+
+func (x *PackBucketResponse) Reset() {
+	*x = PackBucketResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bucket_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PackBucketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackBucketResponse) ProtoMessage() {}
+
+func (x *PackBucketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bucket_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackBucketResponse.ProtoReflect.Descriptor instead.
+func (*PackBucketResponse) Descriptor() ([]byte, []int) {
+	return file_bucket_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *PackBucketResponse) GetCid() string {
+	if x != nil {
+		return x.Cid
+	}
+	return ""
+}
+
+func (x *PackBucketResponse) GetCarPath() string {
+	if x != nil {
+		return x.CarPath
+	}
+	return ""
+}
+
+func (x *PackBucketResponse) GetCarSize() uint64 {
+	if x != nil {
+		return x.CarSize
+	}
+	return 0
+}
+
+// Sets the bucketing policy for new buckets of a scope; entity_kind "project"
+// with the project id covers all its issues. An empty policy removes the
+// override (the data server's BUCKET_POLICY applies).
+type SetBucketPolicyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Scope  *Scope `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"`
+	Policy string `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+}
+
+func (x *SetBucketPolicyRequest) Reset() {
+	*x = SetBucketPolicyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bucket_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetBucketPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBucketPolicyRequest) ProtoMessage() {}
+
+func (x *SetBucketPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bucket_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBucketPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetBucketPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_bucket_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SetBucketPolicyRequest) GetScope() *Scope {
+	if x != nil {
+		return x.Scope
+	}
+	return nil
+}
+
+func (x *SetBucketPolicyRequest) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+type SetBucketPolicyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Policy string `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+}
+
+func (x *SetBucketPolicyResponse) Reset() {
+	*x = SetBucketPolicyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bucket_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetBucketPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBucketPolicyResponse) ProtoMessage() {}
+
+func (x *SetBucketPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bucket_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBucketPolicyResponse.ProtoReflect.Descriptor instead.
+func (*SetBucketPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_bucket_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SetBucketPolicyResponse) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+// The project a scope belongs to (empty when none), which the ledger charges
+// for anchoring its buckets: a "project" scope is its own, an issue scope
+// belongs to the project that imported the issue first.
+type GetScopeProjectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Scope *Scope `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"`
+}
+
+func (x *GetScopeProjectRequest) Reset() {
+	*x = GetScopeProjectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bucket_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetScopeProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScopeProjectRequest) ProtoMessage() {}
+
+func (x *GetScopeProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bucket_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScopeProjectRequest.ProtoReflect.Descriptor instead.
+func (*GetScopeProjectRequest) Descriptor() ([]byte, []int) {
+	return file_bucket_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetScopeProjectRequest) GetScope() *Scope {
+	if x != nil {
+		return x.Scope
+	}
+	return nil
+}
+
+type GetScopeProjectResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+}
+
+func (x *GetScopeProjectResponse) Reset() {
+	*x = GetScopeProjectResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bucket_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetScopeProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScopeProjectResponse) ProtoMessage() {}
+
+func (x *GetScopeProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bucket_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScopeProjectResponse.ProtoReflect.Descriptor instead.
+func (*GetScopeProjectResponse) Descriptor() ([]byte, []int) {
+	return file_bucket_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetScopeProjectResponse) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+// Rolls buckets the caller claimed (anchoring) and packed (PackBucket) into
+// one new epoch over period (the UTC day YYYY-MM-DD; empty = today), e.g. the
+// buckets of one day or of one ledger tick; the caller then anchors it with
+// SetEpochAnchorPending.
+type SealEpochRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Refs   []*BucketRef `protobuf:"bytes,1,rep,name=refs,proto3" json:"refs,omitempty"`
+	Period string       `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"`
+}
+
+func (x *SealEpochRequest) Reset() {
+	*x = SealEpochRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bucket_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SealEpochRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SealEpochRequest) ProtoMessage() {}
+
+func (x *SealEpochRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bucket_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SealEpochRequest.ProtoReflect.Descriptor instead.
+func (*SealEpochRequest) Descriptor() ([]byte, []int) {
+	return file_bucket_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SealEpochRequest) GetRefs() []*BucketRef {
+	if x != nil {
+		return x.Refs
+	}
+	return nil
+}
+
+func (x *SealEpochRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+type SealEpochResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch *EpochInfo `protobuf:"bytes,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+}
+
+func (x *SealEpochResponse) Reset() {
+	*x = SealEpochResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bucket_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SealEpochResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SealEpochResponse) ProtoMessage() {}
+
+func (x *SealEpochResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bucket_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SealEpochResponse.ProtoReflect.Descriptor instead.
+func (*SealEpochResponse) Descriptor() ([]byte, []int) {
+	return file_bucket_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SealEpochResponse) GetEpoch() *EpochInfo {
+	if x != nil {
+		return x.Epoch
+	}
+	return nil
+}
+
+type GetEpochRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetEpochRequest) Reset() {
+	*x = GetEpochRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bucket_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEpochRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEpochRequest) ProtoMessage() {}
+
+func (x *GetEpochRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bucket_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEpochRequest.ProtoReflect.Descriptor instead.
+func (*GetEpochRequest) Descriptor() ([]byte, []int) {
+	return file_bucket_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetEpochRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetEpochResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch *EpochInfo `protobuf:"bytes,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+}
+
+func (x *GetEpochResponse) Reset() {
+	*x = GetEpochResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bucket_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEpochResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEpochResponse) ProtoMessage() {}
+
+func (x *GetEpochResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bucket_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEpochResponse.ProtoReflect.Descriptor instead.
+func (*GetEpochResponse) Descriptor() ([]byte, []int) {
+	return file_bucket_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetEpochResponse) GetEpoch() *EpochInfo {
+	if x != nil {
+		return x.Epoch
+	}
+	return nil
+}
+
+type ListEpochsByStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status    string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Limit     int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListEpochsByStatusRequest) Reset() {
+	*x = ListEpochsByStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bucket_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListEpochsByStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEpochsByStatusRequest) ProtoMessage() {}
+
+func (x *ListEpochsByStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bucket_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEpochsByStatusRequest.ProtoReflect.Descriptor instead.
+func (*ListEpochsByStatusRequest) Descriptor() ([]byte, []int) {
+	return file_bucket_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListEpochsByStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListEpochsByStatusRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListEpochsByStatusRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListEpochsByStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epochs        []*EpochInfo `protobuf:"bytes,1,rep,name=epochs,proto3" json:"epochs,omitempty"`
+	NextPageToken string       `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListEpochsByStatusResponse) Reset() {
+	*x = ListEpochsByStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bucket_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListEpochsByStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEpochsByStatusResponse) ProtoMessage() {}
+
+func (x *ListEpochsByStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bucket_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEpochsByStatusResponse.ProtoReflect.Descriptor instead.
+func (*ListEpochsByStatusResponse) Descriptor() ([]byte, []int) {
+	return file_bucket_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListEpochsByStatusResponse) GetEpochs() []*EpochInfo {
+	if x != nil {
+		return x.Epochs
+	}
+	return nil
+}
+
+func (x *ListEpochsByStatusResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Records the tx sent for a needs_anchoring epoch; it and every member bucket
+// wait in anchoring_pending until SetBucketAnchored confirms the members.
+type SetEpochAnchorPendingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AnchoredTx  string `protobuf:"bytes,2,opt,name=anchored_tx,json=anchoredTx,proto3" json:"anchored_tx,omitempty"`
+	Actor       string `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	AnchorProof []byte `protobuf:"bytes,4,opt,name=anchor_proof,json=anchorProof,proto3" json:"anchor_proof,omitempty"`
+}
+
+func (x *SetEpochAnchorPendingRequest) Reset() {
+	*x = SetEpochAnchorPendingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bucket_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetEpochAnchorPendingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetEpochAnchorPendingRequest) ProtoMessage() {}
+
+func (x *SetEpochAnchorPendingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bucket_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetEpochAnchorPendingRequest.ProtoReflect.Descriptor instead.
+func (*SetEpochAnchorPendingRequest) Descriptor() ([]byte, []int) {
+	return file_bucket_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SetEpochAnchorPendingRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SetEpochAnchorPendingRequest) GetAnchoredTx() string {
+	if x != nil {
+		return x.AnchoredTx
+	}
+	return ""
+}
+
+func (x *SetEpochAnchorPendingRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *SetEpochAnchorPendingRequest) GetAnchorProof() []byte {
+	if x != nil {
+		return x.AnchorProof
+	}
+	return nil
+}
+
+type SetEpochAnchorPendingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch *EpochInfo `protobuf:"bytes,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+}
+
+func (x *SetEpochAnchorPendingResponse) Reset() {
+	*x = SetEpochAnchorPendingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bucket_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetEpochAnchorPendingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetEpochAnchorPendingResponse) ProtoMessage() {}
+
+func (x *SetEpochAnchorPendingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bucket_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetEpochAnchorPendingResponse.ProtoReflect.Descriptor instead.
+func (*SetEpochAnchorPendingResponse) Descriptor() ([]byte, []int) {
+	return file_bucket_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SetEpochAnchorPendingResponse) GetEpoch() *EpochInfo {
+	if x != nil {
+		return x.Epoch
+	}
+	return nil
+}
+
+// Integrity audit: a bucket's evidence re-derived from the database.
+type AuditCheck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Check  string `protobuf:"bytes,1,opt,name=check,proto3" json:"check,omitempty"` // items | root | proofs | anchor | epoch | frontier | recorded_root
+	Ok     bool   `protobuf:"varint,2,opt,name=ok,proto3" json:"ok,omitempty"`
+	Detail string `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"` // failure, or a note when the check was skipped
+}
+
+func (x *AuditCheck) Reset() {
+	*x = AuditCheck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bucket_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditCheck) ProtoMessage() {}
+
+func (x *AuditCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_bucket_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditCheck.ProtoReflect.Descriptor instead.
+func (*AuditCheck) Descriptor() ([]byte, []int) {
+	return file_bucket_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *AuditCheck) GetCheck() string {
+	if x != nil {
+		return x.Check
+	}
+	return ""
+}
+
+func (x *AuditCheck) GetOk() bool {
+	if x != nil {
+		return x.Ok
+	}
+	return false
+}
+
+func (x *AuditCheck) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+type AuditFinding struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          uint64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Ref         *BucketRef `protobuf:"bytes,2,opt,name=ref,proto3" json:"ref,omitempty"`
+	Check       string     `protobuf:"bytes,3,opt,name=check,proto3" json:"check,omitempty"`
+	Detail      string     `protobuf:"bytes,4,opt,name=detail,proto3" json:"detail,omitempty"`
+	FirstSeenAt string     `protobuf:"bytes,5,opt,name=first_seen_at,json=firstSeenAt,proto3" json:"first_seen_at,omitempty"` // RFC3339
+	LastSeenAt  string     `protobuf:"bytes,6,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`    // RFC3339
+	ResolvedAt  string     `protobuf:"bytes,7,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`      // RFC3339; empty while open
+}
+
+func (x *AuditFinding) Reset() {
+	*x = AuditFinding{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bucket_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditFinding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditFinding) ProtoMessage() {}
+
+func (x *AuditFinding) ProtoReflect() protoreflect.Message {
+	mi := &file_bucket_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditFinding.ProtoReflect.Descriptor instead.
+func (*AuditFinding) Descriptor() ([]byte, []int) {
+	return file_bucket_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *AuditFinding) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuditFinding) GetRef() *BucketRef {
+	if x != nil {
+		return x.Ref
+	}
+	return nil
+}
+
+func (x *AuditFinding) GetCheck() string {
+	if x != nil {
+		return x.Check
+	}
+	return ""
+}
+
+func (x *AuditFinding) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *AuditFinding) GetFirstSeenAt() string {
+	if x != nil {
+		return x.FirstSeenAt
+	}
+	return ""
+}
+
+func (x *AuditFinding) GetLastSeenAt() string {
+	if x != nil {
+		return x.LastSeenAt
+	}
+	return ""
+}
+
+func (x *AuditFinding) GetResolvedAt() string {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return ""
+}
+
+type AuditBucketRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ref *BucketRef `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
+}
+
+func (x *AuditBucketRequest) Reset() {
+	*x = AuditBucketRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bucket_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditBucketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditBucketRequest) ProtoMessage() {}
+
+func (x *AuditBucketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bucket_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditBucketRequest.ProtoReflect.Descriptor instead.
+func (*AuditBucketRequest) Descriptor() ([]byte, []int) {
+	return file_bucket_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *AuditBucketRequest) GetRef() *BucketRef {
+	if x != nil {
+		return x.Ref
+	}
+	return nil
+}
+
+type AuditBucketResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Checks []*AuditCheck `protobuf:"bytes,1,rep,name=checks,proto3" json:"checks,omitempty"`
+	Ok     bool          `protobuf:"varint,2,opt,name=ok,proto3" json:"ok,omitempty"`
+}
+
+func (x *AuditBucketResponse) Reset() {
+	*x = AuditBucketResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bucket_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditBucketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditBucketResponse) ProtoMessage() {}
+
+func (x *AuditBucketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bucket_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditBucketResponse.ProtoReflect.Descriptor instead.
+func (*AuditBucketResponse) Descriptor() ([]byte, []int) {
+	return file_bucket_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *AuditBucketResponse) GetChecks() []*AuditCheck {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
+func (x *AuditBucketResponse) GetOk() bool {
+	if x != nil {
+		return x.Ok
+	}
+	return false
+}
+
+// Empty scope = every scope; open findings only unless include_resolved.
+type ListAuditFindingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Scope           *Scope `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"`
+	IncludeResolved bool   `protobuf:"varint,2,opt,name=include_resolved,json=includeResolved,proto3" json:"include_resolved,omitempty"`
+	Limit           int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	PageToken       string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListAuditFindingsRequest) Reset() {
+	*x = ListAuditFindingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bucket_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAuditFindingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditFindingsRequest) ProtoMessage() {}
+
+func (x *ListAuditFindingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bucket_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditFindingsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditFindingsRequest) Descriptor() ([]byte, []int) {
+	return file_bucket_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListAuditFindingsRequest) GetScope() *Scope {
+	if x != nil {
+		return x.Scope
+	}
+	return nil
+}
+
+func (x *ListAuditFindingsRequest) GetIncludeResolved() bool {
+	if x != nil {
+		return x.IncludeResolved
+	}
+	return false
+}
+
+func (x *ListAuditFindingsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListAuditFindingsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListAuditFindingsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Findings      []*AuditFinding `protobuf:"bytes,1,rep,name=findings,proto3" json:"findings,omitempty"`
+	NextPageToken string          `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListAuditFindingsResponse) Reset() {
+	*x = ListAuditFindingsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bucket_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAuditFindingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditFindingsResponse) ProtoMessage() {}
+
+func (x *ListAuditFindingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bucket_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditFindingsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditFindingsResponse) Descriptor() ([]byte, []int) {
+	return file_bucket_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListAuditFindingsResponse) GetFindings() []*AuditFinding {
+	if x != nil {
+		return x.Findings
+	}
+	return nil
+}
+
+func (x *ListAuditFindingsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// ADD:
+type ListBucketsByStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status    string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Limit     int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListBucketsByStatusRequest) Reset() {
+	*x = ListBucketsByStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bucket_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBucketsByStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBucketsByStatusRequest) ProtoMessage() {}
+
+func (x *ListBucketsByStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bucket_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBucketsByStatusRequest.ProtoReflect.Descriptor instead.
+func (*ListBucketsByStatusRequest) Descriptor() ([]byte, []int) {
+	return file_bucket_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListBucketsByStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListBucketsByStatusRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListBucketsByStatusRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListBucketsByStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Buckets       []*BucketInfo `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	NextPageToken string        `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListBucketsByStatusResponse) Reset() {
+	*x = ListBucketsByStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bucket_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBucketsByStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBucketsByStatusResponse) ProtoMessage() {}
+
+func (x *ListBucketsByStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bucket_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBucketsByStatusResponse.ProtoReflect.Descriptor instead.
+func (*ListBucketsByStatusResponse) Descriptor() ([]byte, []int) {
+	return file_bucket_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListBucketsByStatusResponse) GetBuckets() []*BucketInfo {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+func (x *ListBucketsByStatusResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type InclusionProofResponse_Step struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sibling       []byte `protobuf:"bytes,1,opt,name=sibling,proto3" json:"sibling,omitempty"`
+	SiblingIsLeft bool   `protobuf:"varint,2,opt,name=sibling_is_left,json=siblingIsLeft,proto3" json:"sibling_is_left,omitempty"`
+}
+
+func (x *InclusionProofResponse_Step) Reset() {
+	*x = InclusionProofResponse_Step{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bucket_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InclusionProofResponse_Step) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InclusionProofResponse_Step) ProtoMessage() {}
+
+func (x *InclusionProofResponse_Step) ProtoReflect() protoreflect.Message {
+	mi := &file_bucket_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InclusionProofResponse_Step.ProtoReflect.Descriptor instead.
+func (*InclusionProofResponse_Step) Descriptor() ([]byte, []int) {
+	return file_bucket_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *InclusionProofResponse_Step) GetSibling() []byte {
+	if x != nil {
+		return x.Sibling
+	}
+	return nil
+}
+
+func (x *InclusionProofResponse_Step) GetSiblingIsLeft() bool {
+	if x != nil {
+		return x.SiblingIsLeft
+	}
+	return false
+}
+
+var File_bucket_proto protoreflect.FileDescriptor
+
+var file_bucket_proto_rawDesc = []byte{
+	0x0a, 0x0c, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13,
+	0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x2e, 0x76, 0x31, 0x22, 0x47, 0x0a, 0x05, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x5c, 0x0a, 0x09,
+	0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x66, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x63, 0x6f,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74,
+	0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x63, 0x6f, 0x70, 0x65, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x62,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x22, 0xb5, 0x01, 0x0a, 0x0a, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x65, 0x61,
+	0x66, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6c,
+	0x65, 0x61, 0x66, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x6f, 0x74,
+	0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x72, 0x6f, 0x6f,
+	0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x61, 0x6c,
+	0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x72, 0x65, 0x65, 0x41, 0x6c, 0x67,
+	0x12, 0x15, 0x0a, 0x06, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0xf9, 0x04, 0x0a, 0x0a, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x30, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x66, 0x52, 0x03,
+	0x72, 0x65, 0x66, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x74, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x65, 0x61, 0x66, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6c, 0x65, 0x61, 0x66, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6e, 0x63,
+	0x68, 0x6f, 0x72, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x65, 0x64, 0x54, 0x78, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6e,
+	0x63, 0x68, 0x6f, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63,
+	0x6c, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x41, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x65, 0x65,
+	0x5f, 0x61, 0x6c, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x72, 0x65, 0x65,
+	0x41, 0x6c, 0x67, 0x12, 0x3f, 0x0a, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66,
+	0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d,
+	0x65, 0x6e, 0x64, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x72, 0x6f, 0x6f,
+	0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x70, 0x72, 0x65, 0x76, 0x52, 0x6f, 0x6f,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x71, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x65, 0x71, 0x12, 0x19,
+	0x0a, 0x08, 0x6c, 0x6f, 0x67, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x07, 0x6c, 0x6f, 0x67, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x6f, 0x67,
+	0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6c, 0x6f, 0x67,
+	0x52, 0x6f, 0x6f, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x65, 0x64,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x11, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x61, 0x6e,
+	0x63, 0x68, 0x6f, 0x72, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x2e, 0x0a, 0x13, 0x61,
+	0x6e, 0x63, 0x68, 0x6f, 0x72, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72,
+	0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x61,
+	0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0b, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0x7b,
+	0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52,
+	0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x78, 0x0a, 0x13, 0x4c,
+	0x69, 0x73, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x39, 0x0a, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x26, 0x0a,
+	0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x44, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x42, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x03, 0x72, 0x65, 0x66,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c,
+	0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x52, 0x65, 0x66, 0x52, 0x03, 0x72, 0x65, 0x66, 0x22, 0x83, 0x01, 0x0a, 0x11,
+	0x47, 0x65, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x37, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x35, 0x0a, 0x05, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x72, 0x75, 0x73,
+	0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x70, 0x6f, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x22, 0x75, 0x0a, 0x15, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x72,
+	0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x03, 0x72, 0x65,
+	0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66,
+	0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x66, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x2a, 0x0a, 0x11,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0xcc, 0x02, 0x0a, 0x16, 0x49, 0x6e, 0x63,
+	0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x65, 0x61, 0x66, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6c, 0x65, 0x61, 0x66, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x44, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30,
+	0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x72,
+	0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x65, 0x70,
+	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x74, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x61, 0x6c, 0x67, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x72, 0x65, 0x65, 0x41, 0x6c, 0x67, 0x12, 0x35,
+	0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x05,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x1a, 0x48, 0x0a,
+	0x04, 0x53, 0x74, 0x65, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x69, 0x62, 0x6c, 0x69, 0x6e, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x73, 0x69, 0x62, 0x6c, 0x69, 0x6e, 0x67, 0x12,
+	0x26, 0x0a, 0x0f, 0x73, 0x69, 0x62, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x73, 0x5f, 0x6c, 0x65,
+	0x66, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x69, 0x62, 0x6c, 0x69, 0x6e,
+	0x67, 0x49, 0x73, 0x4c, 0x65, 0x66, 0x74, 0x22, 0xb5, 0x01, 0x0a, 0x0b, 0x45, 0x70, 0x6f, 0x63,
+	0x68, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77,
+	0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x52, 0x65, 0x66, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x65, 0x61,
+	0x66, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6c,
+	0x65, 0x61, 0x66, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x6f, 0x74,
+	0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x72, 0x6f, 0x6f,
+	0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x65, 0x61, 0x66, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6c, 0x65, 0x61, 0x66, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x61, 0x6c, 0x67,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x72, 0x65, 0x65, 0x41, 0x6c, 0x67, 0x22,
+	0xf4, 0x02, 0x0a, 0x09, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x74, 0x48, 0x61,
+	0x73, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x65, 0x61, 0x66, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6c, 0x65, 0x61, 0x66, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x61, 0x6c, 0x67, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x72, 0x65, 0x65, 0x41, 0x6c, 0x67, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72,
+	0x65, 0x64, 0x5f, 0x74, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x6e, 0x63,
+	0x68, 0x6f, 0x72, 0x65, 0x64, 0x54, 0x78, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6e, 0x63, 0x68, 0x6f,
+	0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x6e,
+	0x63, 0x68, 0x6f, 0x72, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3a, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74,
+	0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x70, 0x6f, 0x63, 0x68, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x70, 0x72,
+	0x6f, 0x6f, 0x66, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x61, 0x6e, 0x63, 0x68, 0x6f,
+	0x72, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0xe7, 0x01, 0x0a, 0x0a, 0x45, 0x70, 0x6f, 0x63, 0x68,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x34, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77,
+	0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x6c,
+	0x65, 0x61, 0x66, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x09, 0x6c, 0x65, 0x61, 0x66, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x65,
+	0x61, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x6c, 0x65, 0x61, 0x66, 0x12, 0x44,
+	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x74,
+	0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x65, 0x70, 0x52, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x12, 0x2a, 0x0a, 0x11, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x6c,
+	0x65, 0x61, 0x66, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x66, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x81, 0x01, 0x0a, 0x17, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x03,
+	0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x72, 0x75, 0x73,
+	0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x66, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x19,
+	0x0a, 0x08, 0x6f, 0x6c, 0x64, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x07, 0x6f, 0x6c, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x65, 0x77,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6e, 0x65, 0x77,
+	0x53, 0x69, 0x7a, 0x65, 0x22, 0xb7, 0x01, 0x0a, 0x18, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x6c, 0x64, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x07, 0x6f, 0x6c, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x6e, 0x65, 0x77, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
+	0x6e, 0x65, 0x77, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x6c, 0x64, 0x5f, 0x72,
+	0x6f, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6f, 0x6c, 0x64, 0x52, 0x6f,
+	0x6f, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x05, 0x70, 0x72,
+	0x6f, 0x6f, 0x66, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x61, 0x6c, 0x67, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x72, 0x65, 0x65, 0x41, 0x6c, 0x67, 0x22, 0x47,
+	0x0a, 0x13, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52,
+	0x65, 0x66, 0x52, 0x03, 0x72, 0x65, 0x66, 0x22, 0x37, 0x0a, 0x14, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x4a, 0x73, 0x6f, 0x6e,
+	0x22, 0x7e, 0x0a, 0x18, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x6e, 0x65, 0x73, 0x73,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x05,
+	0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x72,
+	0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x19,
+	0x0a, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x73, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x66, 0x72, 0x6f, 0x6d, 0x53, 0x65, 0x71, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x6f, 0x5f,
+	0x73, 0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x53, 0x65, 0x71,
+	0x22, 0x3a, 0x0a, 0x19, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x6e, 0x65, 0x73, 0x73,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x4a, 0x73, 0x6f, 0x6e, 0x22, 0x79, 0x0a, 0x17,
+	0x4d, 0x61, 0x72, 0x6b, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77,
+	0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x52, 0x65, 0x66, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x53, 0x0a, 0x18, 0x4d, 0x61, 0x72, 0x6b, 0x42,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x22, 0xbd, 0x01, 0x0a,
+	0x1d, 0x53, 0x65, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30,
+	0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x72,
+	0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x66, 0x52, 0x03, 0x72, 0x65, 0x66,
+	0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63,
+	0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x65, 0x64, 0x5f, 0x74,
+	0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x65,
+	0x64, 0x54, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x6e, 0x63,
+	0x68, 0x6f, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0b, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0x59, 0x0a, 0x1e,
+	0x53, 0x65, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x50,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37,
+	0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x22, 0xcb, 0x01, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x42,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x65, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65,
+	0x66, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72,
+	0x65, 0x64, 0x5f, 0x74, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x6e, 0x63,
+	0x68, 0x6f, 0x72, 0x65, 0x64, 0x54, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x21, 0x0a,
+	0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x4a,
+	0x04, 0x08, 0x02, 0x10, 0x03, 0x22, 0x54, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x42, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x90, 0x01, 0x0a, 0x16,
+	0x53, 0x65, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x52, 0x65, 0x66, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x52,
+	0x0a, 0x17, 0x53, 0x65, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x62, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x72, 0x75, 0x73,
+	0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x22, 0x8f, 0x01, 0x0a, 0x11, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x72, 0x6f, 0x6d,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66,
+	0x72, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x61, 0x74, 0x22, 0x4c, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x30, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x66, 0x52, 0x03, 0x72,
+	0x65, 0x66, 0x22, 0x5b, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3e, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22,
+	0x8f, 0x01, 0x0a, 0x1a, 0x41, 0x64, 0x64, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30,
+	0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x72,
+	0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x66, 0x52, 0x03, 0x72, 0x65, 0x66,
+	0x12, 0x3f, 0x0a, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77,
+	0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x22, 0x1d, 0x0a, 0x1b, 0x41, 0x64, 0x64, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x45, 0x0a, 0x11, 0x50, 0x61, 0x63, 0x6b, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52,
+	0x65, 0x66, 0x52, 0x03, 0x72, 0x65, 0x66, 0x22, 0x5c, 0x0a, 0x12, 0x50, 0x61, 0x63, 0x6b, 0x42,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12,
+	0x19, 0x0a, 0x08, 0x63, 0x61, 0x72, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x63, 0x61, 0x72, 0x50, 0x61, 0x74, 0x68, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x61,
+	0x72, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x63, 0x61,
+	0x72, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x62, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x42, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x30, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x31, 0x0a, 0x17, 0x53, 0x65, 0x74,
+	0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x4a, 0x0a, 0x16,
+	0x47, 0x65, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f,
+	0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x6f, 0x70,
+	0x65, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x22, 0x38, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53,
+	0x63, 0x6f, 0x70, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x49, 0x64, 0x22, 0x5e, 0x0a, 0x10, 0x53, 0x65, 0x61, 0x6c, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x04, 0x72, 0x65, 0x66, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77,
+	0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x52, 0x65, 0x66, 0x52, 0x04, 0x72, 0x65, 0x66, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x22, 0x49, 0x0a, 0x11, 0x53, 0x65, 0x61, 0x6c, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c,
+	0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x70, 0x6f,
+	0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x22, 0x21, 0x0a,
+	0x0f, 0x47, 0x65, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x48, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x22, 0x68, 0x0a, 0x19, 0x4c, 0x69,
+	0x73, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x42, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x7c, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x70, 0x6f, 0x63,
+	0x68, 0x73, 0x42, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x36, 0x0a, 0x06, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x06, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x88, 0x01, 0x0a, 0x1c, 0x53, 0x65, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x41,
+	0x6e, 0x63, 0x68, 0x6f, 0x72, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x65, 0x64, 0x5f,
+	0x74, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72,
+	0x65, 0x64, 0x54, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x6e,
+	0x63, 0x68, 0x6f, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0b, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0x55, 0x0a,
+	0x1d, 0x53, 0x65, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x50,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34,
+	0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x22, 0x4a, 0x0a, 0x0a, 0x41, 0x75, 0x64, 0x69, 0x74, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x22, 0xe5, 0x01, 0x0a, 0x0c, 0x41, 0x75, 0x64, 0x69, 0x74, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x30, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x66, 0x52, 0x03,
+	0x72, 0x65, 0x66, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x12, 0x22, 0x0a, 0x0d, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x5f,
+	0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x69, 0x72, 0x73, 0x74, 0x53,
+	0x65, 0x65, 0x6e, 0x41, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65,
+	0x65, 0x6e, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x61, 0x73,
+	0x74, 0x53, 0x65, 0x65, 0x6e, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x41, 0x74, 0x22, 0x46, 0x0a, 0x12, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30,
+	0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x72,
+	0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x66, 0x52, 0x03, 0x72, 0x65, 0x66,
+	0x22, 0x5e, 0x0a, 0x13, 0x41, 0x75, 0x64, 0x69, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66,
+	0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x06, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73,
+	0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x6f, 0x6b,
+	0x22, 0xac, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x46, 0x69,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a,
+	0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74,
+	0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12,
+	0x29, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x82, 0x01, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x46, 0x69, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a,
+	0x08, 0x66, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x46, 0x69, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x52, 0x08, 0x66, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x26, 0x0a, 0x0f,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x69, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x42, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x80, 0x01, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x42,
+	0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x39, 0x0a, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x32, 0xfe, 0x12, 0x0a, 0x0d, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x60, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x12, 0x27, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x74,
+	0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x42, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x12, 0x25, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x74, 0x72, 0x75,
+	0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x69, 0x0a, 0x0e, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x50,
+	0x72, 0x6f, 0x6f, 0x66, 0x12, 0x2a, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77,
+	0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x63, 0x6c, 0x75,
+	0x73, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2b, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a,
+	0x10, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x12, 0x2c, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2d, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63,
+	0x79, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63,
+	0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x28,
+	0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74,
+	0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x11, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x6e,
+	0x65, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x2d, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74,
+	0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x6f, 0x66,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66,
+	0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x10, 0x4d, 0x61, 0x72, 0x6b, 0x42,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x12, 0x2c, 0x2e, 0x74, 0x72,
+	0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6c, 0x6f, 0x73,
+	0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x74, 0x72, 0x75, 0x73,
+	0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x61, 0x72, 0x6b, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x81, 0x01, 0x0a, 0x16, 0x53, 0x65, 0x74,
+	0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x50, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x12, 0x32, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x42, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66,
+	0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
+	0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x50, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x11,
+	0x53, 0x65, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x65,
+	0x64, 0x12, 0x2d, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2e, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x6c, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x2b, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x42, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2c, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72,
+	0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x12, 0x2d, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x5d, 0x0a, 0x0a, 0x50, 0x61, 0x63, 0x6b, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x12, 0x26, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x42, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74,
+	0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x61, 0x63, 0x6b, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x78, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x2f, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74,
+	0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x64, 0x64, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x74, 0x72, 0x75, 0x73,
+	0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x64, 0x64, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x78, 0x0a, 0x13, 0x4c,
+	0x69, 0x73, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x42, 0x79, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x2f, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x42, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x42, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x42, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2b, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74,
+	0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x65, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f,
+	0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x42,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x2b, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c,
+	0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x63, 0x6f, 0x70, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x6f,
+	0x70, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x5a, 0x0a, 0x09, 0x53, 0x65, 0x61, 0x6c, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x25,
+	0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x6c, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f,
+	0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x6c,
+	0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a,
+	0x08, 0x47, 0x65, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x24, 0x2e, 0x74, 0x72, 0x75, 0x73,
+	0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x25, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x75, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x73, 0x42, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2e, 0x2e, 0x74,
+	0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x42, 0x79, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x74,
+	0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x42, 0x79, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7e, 0x0a,
+	0x15, 0x53, 0x65, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x50,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x31, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c,
+	0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74,
+	0x45, 0x70, 0x6f, 0x63, 0x68, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x50, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x74, 0x72, 0x75, 0x73,
+	0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x50, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a,
+	0x0b, 0x41, 0x75, 0x64, 0x69, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x27, 0x2e, 0x74,
+	0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f,
+	0x77, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x72, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x46, 0x69, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x73, 0x12, 0x2d, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77,
+	0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x42, 0x43, 0x5a, 0x41, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x67, 0x75, 0x73, 0x70, 0x6c, 0x75, 0x73, 0x62, 0x75, 0x73, 0x2f, 0x74, 0x72, 0x75,
+	0x73, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x76, 0x31, 0x3b,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_bucket_proto_rawDescOnce sync.Once
+	file_bucket_proto_rawDescData = file_bucket_proto_rawDesc
+)
+
+func file_bucket_proto_rawDescGZIP() []byte {
+	file_bucket_proto_rawDescOnce.Do(func() {
+		file_bucket_proto_rawDescData = protoimpl.X.CompressGZIP(file_bucket_proto_rawDescData)
+	})
+	return file_bucket_proto_rawDescData
+}
+
+var file_bucket_proto_msgTypes = make([]protoimpl.MessageInfo, 55)
+var file_bucket_proto_goTypes = []any{
+	(*Scope)(nil),                          // 0: trustflow.bucket.v1.Scope
+	(*BucketRef)(nil),                      // 1: trustflow.bucket.v1.BucketRef
+	(*Checkpoint)(nil),                     // 2: trustflow.bucket.v1.Checkpoint
+	(*BucketInfo)(nil),                     // 3: trustflow.bucket.v1.BucketInfo
+	(*ListBucketsRequest)(nil),             // 4: trustflow.bucket.v1.ListBucketsRequest
+	(*ListBucketsResponse)(nil),            // 5: trustflow.bucket.v1.ListBucketsResponse
+	(*GetBucketRequest)(nil),               // 6: trustflow.bucket.v1.GetBucketRequest
+	(*GetBucketResponse)(nil),              // 7: trustflow.bucket.v1.GetBucketResponse
+	(*InclusionProofRequest)(nil),          // 8: trustflow.bucket.v1.InclusionProofRequest
+	(*InclusionProofResponse)(nil),         // 9: trustflow.bucket.v1.InclusionProofResponse
+	(*EpochMember)(nil),                    // 10: trustflow.bucket.v1.EpochMember
+	(*EpochInfo)(nil),                      // 11: trustflow.bucket.v1.EpochInfo
+	(*EpochProof)(nil),                     // 12: trustflow.bucket.v1.EpochProof
+	(*ConsistencyProofRequest)(nil),        // 13: trustflow.bucket.v1.ConsistencyProofRequest
+	(*ConsistencyProofResponse)(nil),       // 14: trustflow.bucket.v1.ConsistencyProofResponse
+	(*ExportBucketRequest)(nil),            // 15: trustflow.bucket.v1.ExportBucketRequest
+	(*ExportBucketResponse)(nil),           // 16: trustflow.bucket.v1.ExportBucketResponse
+	(*CompletenessProofRequest)(nil),       // 17: trustflow.bucket.v1.CompletenessProofRequest
+	(*CompletenessProofResponse)(nil),      // 18: trustflow.bucket.v1.CompletenessProofResponse
+	(*MarkBucketClosedRequest)(nil),        // 19: trustflow.bucket.v1.MarkBucketClosedRequest
+	(*MarkBucketClosedResponse)(nil),       // 20: trustflow.bucket.v1.MarkBucketClosedResponse
+	(*SetBucketAnchorPendingRequest)(nil),  // 21: trustflow.bucket.v1.SetBucketAnchorPendingRequest
+	(*SetBucketAnchorPendingResponse)(nil), // 22: trustflow.bucket.v1.SetBucketAnchorPendingResponse
+	(*SetBucketAnchoredRequest)(nil),       // 23: trustflow.bucket.v1.SetBucketAnchoredRequest
+	(*SetBucketAnchoredResponse)(nil),      // 24: trustflow.bucket.v1.SetBucketAnchoredResponse
+	(*SetBucketStatusRequest)(nil),         // 25: trustflow.bucket.v1.SetBucketStatusRequest
+	(*SetBucketStatusResponse)(nil),        // 26: trustflow.bucket.v1.SetBucketStatusResponse
+	(*BucketStatusEvent)(nil),              // 27: trustflow.bucket.v1.BucketStatusEvent
+	(*ListBucketHistoryRequest)(nil),       // 28: trustflow.bucket.v1.ListBucketHistoryRequest
+	(*ListBucketHistoryResponse)(nil),      // 29: trustflow.bucket.v1.ListBucketHistoryResponse
+	(*AddBucketCheckpointRequest)(nil),     // 30: trustflow.bucket.v1.AddBucketCheckpointRequest
+	(*AddBucketCheckpointResponse)(nil),    // 31: trustflow.bucket.v1.AddBucketCheckpointResponse
+	(*PackBucketRequest)(nil),              // 32: trustflow.bucket.v1.PackBucketRequest
+	(*PackBucketResponse)(nil),             // 33: trustflow.bucket.v1.PackBucketResponse
+	(*SetBucketPolicyRequest)(nil),         // 34: trustflow.bucket.v1.SetBucketPolicyRequest
+	(*SetBucketPolicyResponse)(nil),        // 35: trustflow.bucket.v1.SetBucketPolicyResponse
+	(*GetScopeProjectRequest)(nil),         // 36: trustflow.bucket.v1.GetScopeProjectRequest
+	(*GetScopeProjectResponse)(nil),        // 37: trustflow.bucket.v1.GetScopeProjectResponse
+	(*SealEpochRequest)(nil),               // 38: trustflow.bucket.v1.SealEpochRequest
+	(*SealEpochResponse)(nil),              // 39: trustflow.bucket.v1.SealEpochResponse
+	(*GetEpochRequest)(nil),                // 40: trustflow.bucket.v1.GetEpochRequest
+	(*GetEpochResponse)(nil),               // 41: trustflow.bucket.v1.GetEpochResponse
+	(*ListEpochsByStatusRequest)(nil),      // 42: trustflow.bucket.v1.ListEpochsByStatusRequest
+	(*ListEpochsByStatusResponse)(nil),     // 43: trustflow.bucket.v1.ListEpochsByStatusResponse
+	(*SetEpochAnchorPendingRequest)(nil),   // 44: trustflow.bucket.v1.SetEpochAnchorPendingRequest
+	(*SetEpochAnchorPendingResponse)(nil),  // 45: trustflow.bucket.v1.SetEpochAnchorPendingResponse
+	(*AuditCheck)(nil),                     // 46: trustflow.bucket.v1.AuditCheck
+	(*AuditFinding)(nil),                   // 47: trustflow.bucket.v1.AuditFinding
+	(*AuditBucketRequest)(nil),             // 48: trustflow.bucket.v1.AuditBucketRequest
+	(*AuditBucketResponse)(nil),            // 49: trustflow.bucket.v1.AuditBucketResponse
+	(*ListAuditFindingsRequest)(nil),       // 50: trustflow.bucket.v1.ListAuditFindingsRequest
+	(*ListAuditFindingsResponse)(nil),      // 51: trustflow.bucket.v1.ListAuditFindingsResponse
+	(*ListBucketsByStatusRequest)(nil),     // 52: trustflow.bucket.v1.ListBucketsByStatusRequest
+	(*ListBucketsByStatusResponse)(nil),    // 53: trustflow.bucket.v1.ListBucketsByStatusResponse
+	(*InclusionProofResponse_Step)(nil),    // 54: trustflow.bucket.v1.InclusionProofResponse.Step
+}
+var file_bucket_proto_depIdxs = []int32{
+	0,  // 0: trustflow.bucket.v1.BucketRef.scope:type_name -> trustflow.bucket.v1.Scope
+	1,  // 1: trustflow.bucket.v1.BucketInfo.ref:type_name -> trustflow.bucket.v1.BucketRef
+	2,  // 2: trustflow.bucket.v1.BucketInfo.checkpoint:type_name -> trustflow.bucket.v1.Checkpoint
+	0,  // 3: trustflow.bucket.v1.ListBucketsRequest.scope:type_name -> trustflow.bucket.v1.Scope
+	3,  // 4: trustflow.bucket.v1.ListBucketsResponse.buckets:type_name -> trustflow.bucket.v1.BucketInfo
+	1,  // 5: trustflow.bucket.v1.GetBucketRequest.ref:type_name -> trustflow.bucket.v1.BucketRef
+	3,  // 6: trustflow.bucket.v1.GetBucketResponse.bucket:type_name -> trustflow.bucket.v1.BucketInfo
+	12, // 7: trustflow.bucket.v1.GetBucketResponse.epoch:type_name -> trustflow.bucket.v1.EpochProof
+	1,  // 8: trustflow.bucket.v1.InclusionProofRequest.ref:type_name -> trustflow.bucket.v1.BucketRef
+	54, // 9: trustflow.bucket.v1.InclusionProofResponse.path:type_name -> trustflow.bucket.v1.InclusionProofResponse.Step
+	12, // 10: trustflow.bucket.v1.InclusionProofResponse.epoch:type_name -> trustflow.bucket.v1.EpochProof
+	1,  // 11: trustflow.bucket.v1.EpochMember.ref:type_name -> trustflow.bucket.v1.BucketRef
+	10, // 12: trustflow.bucket.v1.EpochInfo.members:type_name -> trustflow.bucket.v1.EpochMember
+	11, // 13: trustflow.bucket.v1.EpochProof.epoch:type_name -> trustflow.bucket.v1.EpochInfo
+	54, // 14: trustflow.bucket.v1.EpochProof.path:type_name -> trustflow.bucket.v1.InclusionProofResponse.Step
+	1,  // 15: trustflow.bucket.v1.ConsistencyProofRequest.ref:type_name -> trustflow.bucket.v1.BucketRef
+	1,  // 16: trustflow.bucket.v1.ExportBucketRequest.ref:type_name -> trustflow.bucket.v1.BucketRef
+	0,  // 17: trustflow.bucket.v1.CompletenessProofRequest.scope:type_name -> trustflow.bucket.v1.Scope
+	1,  // 18: trustflow.bucket.v1.MarkBucketClosedRequest.ref:type_name -> trustflow.bucket.v1.BucketRef
+	3,  // 19: trustflow.bucket.v1.MarkBucketClosedResponse.bucket:type_name -> trustflow.bucket.v1.BucketInfo
+	1,  // 20: trustflow.bucket.v1.SetBucketAnchorPendingRequest.ref:type_name -> trustflow.bucket.v1.BucketRef
+	3,  // 21: trustflow.bucket.v1.SetBucketAnchorPendingResponse.bucket:type_name -> trustflow.bucket.v1.BucketInfo
+	1,  // 22: trustflow.bucket.v1.SetBucketAnchoredRequest.ref:type_name -> trustflow.bucket.v1.BucketRef
+	3,  // 23: trustflow.bucket.v1.SetBucketAnchoredResponse.bucket:type_name -> trustflow.bucket.v1.BucketInfo
+	1,  // 24: trustflow.bucket.v1.SetBucketStatusRequest.ref:type_name -> trustflow.bucket.v1.BucketRef
+	3,  // 25: trustflow.bucket.v1.SetBucketStatusResponse.bucket:type_name -> trustflow.bucket.v1.BucketInfo
+	1,  // 26: trustflow.bucket.v1.ListBucketHistoryRequest.ref:type_name -> trustflow.bucket.v1.BucketRef
+	27, // 27: trustflow.bucket.v1.ListBucketHistoryResponse.events:type_name -> trustflow.bucket.v1.BucketStatusEvent
+	1,  // 28: trustflow.bucket.v1.AddBucketCheckpointRequest.ref:type_name -> trustflow.bucket.v1.BucketRef
+	2,  // 29: trustflow.bucket.v1.AddBucketCheckpointRequest.checkpoint:type_name -> trustflow.bucket.v1.Checkpoint
+	1,  // 30: trustflow.bucket.v1.PackBucketRequest.ref:type_name -> trustflow.bucket.v1.BucketRef
+	0,  // 31: trustflow.bucket.v1.SetBucketPolicyRequest.scope:type_name -> trustflow.bucket.v1.Scope
+	0,  // 32: trustflow.bucket.v1.GetScopeProjectRequest.scope:type_name -> trustflow.bucket.v1.Scope
+	1,  // 33: trustflow.bucket.v1.SealEpochRequest.refs:type_name -> trustflow.bucket.v1.BucketRef
+	11, // 34: trustflow.bucket.v1.SealEpochResponse.epoch:type_name -> trustflow.bucket.v1.EpochInfo
+	11, // 35: trustflow.bucket.v1.GetEpochResponse.epoch:type_name -> trustflow.bucket.v1.EpochInfo
+	11, // 36: trustflow.bucket.v1.ListEpochsByStatusResponse.epochs:type_name -> trustflow.bucket.v1.EpochInfo
+	11, // 37: trustflow.bucket.v1.SetEpochAnchorPendingResponse.epoch:type_name -> trustflow.bucket.v1.EpochInfo
+	1,  // 38: trustflow.bucket.v1.AuditFinding.ref:type_name -> trustflow.bucket.v1.BucketRef
+	1,  // 39: trustflow.bucket.v1.AuditBucketRequest.ref:type_name -> trustflow.bucket.v1.BucketRef
+	46, // 40: trustflow.bucket.v1.AuditBucketResponse.checks:type_name -> trustflow.bucket.v1.AuditCheck
+	0,  // 41: trustflow.bucket.v1.ListAuditFindingsRequest.scope:type_name -> trustflow.bucket.v1.Scope
+	47, // 42: trustflow.bucket.v1.ListAuditFindingsResponse.findings:type_name -> trustflow.bucket.v1.AuditFinding
+	3,  // 43: trustflow.bucket.v1.ListBucketsByStatusResponse.buckets:type_name -> trustflow.bucket.v1.BucketInfo
+	4,  // 44: trustflow.bucket.v1.BucketService.ListBuckets:input_type -> trustflow.bucket.v1.ListBucketsRequest
+	6,  // 45: trustflow.bucket.v1.BucketService.GetBucket:input_type -> trustflow.bucket.v1.GetBucketRequest
+	8,  // 46: trustflow.bucket.v1.BucketService.InclusionProof:input_type -> trustflow.bucket.v1.InclusionProofRequest
+	13, // 47: trustflow.bucket.v1.BucketService.ConsistencyProof:input_type -> trustflow.bucket.v1.ConsistencyProofRequest
+	15, // 48: trustflow.bucket.v1.BucketService.ExportBucket:input_type -> trustflow.bucket.v1.ExportBucketRequest
+	17, // 49: trustflow.bucket.v1.BucketService.CompletenessProof:input_type -> trustflow.bucket.v1.CompletenessProofRequest
+	19, // 50: trustflow.bucket.v1.BucketService.MarkBucketClosed:input_type -> trustflow.bucket.v1.MarkBucketClosedRequest
+	21, // 51: trustflow.bucket.v1.BucketService.SetBucketAnchorPending:input_type -> trustflow.bucket.v1.SetBucketAnchorPendingRequest
+	23, // 52: trustflow.bucket.v1.BucketService.SetBucketAnchored:input_type -> trustflow.bucket.v1.SetBucketAnchoredRequest
+	25, // 53: trustflow.bucket.v1.BucketService.SetBucketStatus:input_type -> trustflow.bucket.v1.SetBucketStatusRequest
+	28, // 54: trustflow.bucket.v1.BucketService.ListBucketHistory:input_type -> trustflow.bucket.v1.ListBucketHistoryRequest
+	32, // 55: trustflow.bucket.v1.BucketService.PackBucket:input_type -> trustflow.bucket.v1.PackBucketRequest
+	30, // 56: trustflow.bucket.v1.BucketService.AddBucketCheckpoint:input_type -> trustflow.bucket.v1.AddBucketCheckpointRequest
+	52, // 57: trustflow.bucket.v1.BucketService.ListBucketsByStatus:input_type -> trustflow.bucket.v1.ListBucketsByStatusRequest
+	34, // 58: trustflow.bucket.v1.BucketService.SetBucketPolicy:input_type -> trustflow.bucket.v1.SetBucketPolicyRequest
+	36, // 59: trustflow.bucket.v1.BucketService.GetScopeProject:input_type -> trustflow.bucket.v1.GetScopeProjectRequest
+	38, // 60: trustflow.bucket.v1.BucketService.SealEpoch:input_type -> trustflow.bucket.v1.SealEpochRequest
+	40, // 61: trustflow.bucket.v1.BucketService.GetEpoch:input_type -> trustflow.bucket.v1.GetEpochRequest
+	42, // 62: trustflow.bucket.v1.BucketService.ListEpochsByStatus:input_type -> trustflow.bucket.v1.ListEpochsByStatusRequest
+	44, // 63: trustflow.bucket.v1.BucketService.SetEpochAnchorPending:input_type -> trustflow.bucket.v1.SetEpochAnchorPendingRequest
+	48, // 64: trustflow.bucket.v1.BucketService.AuditBucket:input_type -> trustflow.bucket.v1.AuditBucketRequest
+	50, // 65: trustflow.bucket.v1.BucketService.ListAuditFindings:input_type -> trustflow.bucket.v1.ListAuditFindingsRequest
+	5,  // 66: trustflow.bucket.v1.BucketService.ListBuckets:output_type -> trustflow.bucket.v1.ListBucketsResponse
+	7,  // 67: trustflow.bucket.v1.BucketService.GetBucket:output_type -> trustflow.bucket.v1.GetBucketResponse
+	9,  // 68: trustflow.bucket.v1.BucketService.InclusionProof:output_type -> trustflow.bucket.v1.InclusionProofResponse
+	14, // 69: trustflow.bucket.v1.BucketService.ConsistencyProof:output_type -> trustflow.bucket.v1.ConsistencyProofResponse
+	16, // 70: trustflow.bucket.v1.BucketService.ExportBucket:output_type -> trustflow.bucket.v1.ExportBucketResponse
+	18, // 71: trustflow.bucket.v1.BucketService.CompletenessProof:output_type -> trustflow.bucket.v1.CompletenessProofResponse
+	20, // 72: trustflow.bucket.v1.BucketService.MarkBucketClosed:output_type -> trustflow.bucket.v1.MarkBucketClosedResponse
+	22, // 73: trustflow.bucket.v1.BucketService.SetBucketAnchorPending:output_type -> trustflow.bucket.v1.SetBucketAnchorPendingResponse
+	24, // 74: trustflow.bucket.v1.BucketService.SetBucketAnchored:output_type -> trustflow.bucket.v1.SetBucketAnchoredResponse
+	26, // 75: trustflow.bucket.v1.BucketService.SetBucketStatus:output_type -> trustflow.bucket.v1.SetBucketStatusResponse
+	29, // 76: trustflow.bucket.v1.BucketService.ListBucketHistory:output_type -> trustflow.bucket.v1.ListBucketHistoryResponse
+	33, // 77: trustflow.bucket.v1.BucketService.PackBucket:output_type -> trustflow.bucket.v1.PackBucketResponse
+	31, // 78: trustflow.bucket.v1.BucketService.AddBucketCheckpoint:output_type -> trustflow.bucket.v1.AddBucketCheckpointResponse
+	53, // 79: trustflow.bucket.v1.BucketService.ListBucketsByStatus:output_type -> trustflow.bucket.v1.ListBucketsByStatusResponse
+	35, // 80: trustflow.bucket.v1.BucketService.SetBucketPolicy:output_type -> trustflow.bucket.v1.SetBucketPolicyResponse
+	37, // 81: trustflow.bucket.v1.BucketService.GetScopeProject:output_type -> trustflow.bucket.v1.GetScopeProjectResponse
+	39, // 82: trustflow.bucket.v1.BucketService.SealEpoch:output_type -> trustflow.bucket.v1.SealEpochResponse
+	41, // 83: trustflow.bucket.v1.BucketService.GetEpoch:output_type -> trustflow.bucket.v1.GetEpochResponse
+	43, // 84: trustflow.bucket.v1.BucketService.ListEpochsByStatus:output_type -> trustflow.bucket.v1.ListEpochsByStatusResponse
+	45, // 85: trustflow.bucket.v1.BucketService.SetEpochAnchorPending:output_type -> trustflow.bucket.v1.SetEpochAnchorPendingResponse
+	49, // 86: trustflow.bucket.v1.BucketService.AuditBucket:output_type -> trustflow.bucket.v1.AuditBucketResponse
+	51, // 87: trustflow.bucket.v1.BucketService.ListAuditFindings:output_type -> trustflow.bucket.v1.ListAuditFindingsResponse
+	66, // [66:88] is the sub-list for method output_type
+	44, // [44:66] is the sub-list for method input_type
+	44, // [44:44] is the sub-list for extension type_name
+	44, // [44:44] is the sub-list for extension extendee
+	0,  // [0:44] is the sub-list for field type_name
 }
 
 func init() { file_bucket_proto_init() }
@@ -1191,8 +4297,212 @@ func file_bucket_proto_init() {
 				return nil
 			}
 		}
-		file_bucket_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*BucketRef); i {
+		file_bucket_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*BucketRef); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bucket_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*Checkpoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bucket_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*BucketInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bucket_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*ListBucketsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bucket_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*ListBucketsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bucket_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*GetBucketRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bucket_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*GetBucketResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bucket_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*InclusionProofRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bucket_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*InclusionProofResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bucket_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*EpochMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bucket_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*EpochInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bucket_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*EpochProof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bucket_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*ConsistencyProofRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bucket_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*ConsistencyProofResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bucket_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportBucketRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bucket_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportBucketResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bucket_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*CompletenessProofRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bucket_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*CompletenessProofResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1203,8 +4513,8 @@ func file_bucket_proto_init() {
 				return nil
 			}
 		}
-		file_bucket_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*BucketInfo); i {
+		file_bucket_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*MarkBucketClosedRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1215,8 +4525,8 @@ func file_bucket_proto_init() {
 				return nil
 			}
 		}
-		file_bucket_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*ListBucketsRequest); i {
+		file_bucket_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*MarkBucketClosedResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1227,8 +4537,8 @@ func file_bucket_proto_init() {
 				return nil
 			}
 		}
-		file_bucket_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*ListBucketsResponse); i {
+		file_bucket_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*SetBucketAnchorPendingRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1239,8 +4549,8 @@ func file_bucket_proto_init() {
 				return nil
 			}
 		}
-		file_bucket_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*GetBucketRequest); i {
+		file_bucket_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*SetBucketAnchorPendingResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1251,8 +4561,8 @@ func file_bucket_proto_init() {
 				return nil
 			}
 		}
-		file_bucket_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*GetBucketResponse); i {
+		file_bucket_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*SetBucketAnchoredRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1263,8 +4573,8 @@ func file_bucket_proto_init() {
 				return nil
 			}
 		}
-		file_bucket_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*InclusionProofRequest); i {
+		file_bucket_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*SetBucketAnchoredResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1275,8 +4585,8 @@ func file_bucket_proto_init() {
 				return nil
 			}
 		}
-		file_bucket_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*InclusionProofResponse); i {
+		file_bucket_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*SetBucketStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1287,8 +4597,8 @@ func file_bucket_proto_init() {
 				return nil
 			}
 		}
-		file_bucket_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*MarkBucketClosedRequest); i {
+		file_bucket_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*SetBucketStatusResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1299,8 +4609,8 @@ func file_bucket_proto_init() {
 				return nil
 			}
 		}
-		file_bucket_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*MarkBucketClosedResponse); i {
+		file_bucket_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*BucketStatusEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1311,8 +4621,8 @@ func file_bucket_proto_init() {
 				return nil
 			}
 		}
-		file_bucket_proto_msgTypes[11].Exporter = func(v any, i int) any {
-			switch v := v.(*SetBucketAnchoredRequest); i {
+		file_bucket_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*ListBucketHistoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1323,8 +4633,8 @@ func file_bucket_proto_init() {
 				return nil
 			}
 		}
-		file_bucket_proto_msgTypes[12].Exporter = func(v any, i int) any {
-			switch v := v.(*SetBucketAnchoredResponse); i {
+		file_bucket_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*ListBucketHistoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1335,7 +4645,271 @@ func file_bucket_proto_init() {
 				return nil
 			}
 		}
-		file_bucket_proto_msgTypes[13].Exporter = func(v any, i int) any {
+		file_bucket_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*AddBucketCheckpointRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bucket_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*AddBucketCheckpointResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bucket_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*PackBucketRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bucket_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*PackBucketResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bucket_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*SetBucketPolicyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bucket_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*SetBucketPolicyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bucket_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*GetScopeProjectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bucket_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*GetScopeProjectResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bucket_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*SealEpochRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bucket_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*SealEpochResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bucket_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*GetEpochRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bucket_proto_msgTypes[41].Exporter = func(v any, i int) any {
+			switch v := v.(*GetEpochResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bucket_proto_msgTypes[42].Exporter = func(v any, i int) any {
+			switch v := v.(*ListEpochsByStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bucket_proto_msgTypes[43].Exporter = func(v any, i int) any {
+			switch v := v.(*ListEpochsByStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bucket_proto_msgTypes[44].Exporter = func(v any, i int) any {
+			switch v := v.(*SetEpochAnchorPendingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bucket_proto_msgTypes[45].Exporter = func(v any, i int) any {
+			switch v := v.(*SetEpochAnchorPendingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bucket_proto_msgTypes[46].Exporter = func(v any, i int) any {
+			switch v := v.(*AuditCheck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bucket_proto_msgTypes[47].Exporter = func(v any, i int) any {
+			switch v := v.(*AuditFinding); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bucket_proto_msgTypes[48].Exporter = func(v any, i int) any {
+			switch v := v.(*AuditBucketRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bucket_proto_msgTypes[49].Exporter = func(v any, i int) any {
+			switch v := v.(*AuditBucketResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bucket_proto_msgTypes[50].Exporter = func(v any, i int) any {
+			switch v := v.(*ListAuditFindingsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bucket_proto_msgTypes[51].Exporter = func(v any, i int) any {
+			switch v := v.(*ListAuditFindingsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bucket_proto_msgTypes[52].Exporter = func(v any, i int) any {
 			switch v := v.(*ListBucketsByStatusRequest); i {
 			case 0:
 				return &v.state
@@ -1347,7 +4921,7 @@ func file_bucket_proto_init() {
 				return nil
 			}
 		}
-		file_bucket_proto_msgTypes[14].Exporter = func(v any, i int) any {
+		file_bucket_proto_msgTypes[53].Exporter = func(v any, i int) any {
 			switch v := v.(*ListBucketsByStatusResponse); i {
 			case 0:
 				return &v.state
@@ -1359,7 +4933,7 @@ func file_bucket_proto_init() {
 				return nil
 			}
 		}
-		file_bucket_proto_msgTypes[15].Exporter = func(v any, i int) any {
+		file_bucket_proto_msgTypes[54].Exporter = func(v any, i int) any {
 			switch v := v.(*InclusionProofResponse_Step); i {
 			case 0:
 				return &v.state
@@ -1378,7 +4952,7 @@ func file_bucket_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_bucket_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   55,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
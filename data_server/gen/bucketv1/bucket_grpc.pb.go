@@ -19,12 +19,28 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	BucketService_ListBuckets_FullMethodName         = "/trustflow.bucket.v1.BucketService/ListBuckets"
-	BucketService_GetBucket_FullMethodName           = "/trustflow.bucket.v1.BucketService/GetBucket"
-	BucketService_InclusionProof_FullMethodName      = "/trustflow.bucket.v1.BucketService/InclusionProof"
-	BucketService_MarkBucketClosed_FullMethodName    = "/trustflow.bucket.v1.BucketService/MarkBucketClosed"
-	BucketService_SetBucketAnchored_FullMethodName   = "/trustflow.bucket.v1.BucketService/SetBucketAnchored"
-	BucketService_ListBucketsByStatus_FullMethodName = "/trustflow.bucket.v1.BucketService/ListBucketsByStatus"
+	BucketService_ListBuckets_FullMethodName            = "/trustflow.bucket.v1.BucketService/ListBuckets"
+	BucketService_GetBucket_FullMethodName              = "/trustflow.bucket.v1.BucketService/GetBucket"
+	BucketService_InclusionProof_FullMethodName         = "/trustflow.bucket.v1.BucketService/InclusionProof"
+	BucketService_ConsistencyProof_FullMethodName       = "/trustflow.bucket.v1.BucketService/ConsistencyProof"
+	BucketService_ExportBucket_FullMethodName           = "/trustflow.bucket.v1.BucketService/ExportBucket"
+	BucketService_CompletenessProof_FullMethodName      = "/trustflow.bucket.v1.BucketService/CompletenessProof"
+	BucketService_MarkBucketClosed_FullMethodName       = "/trustflow.bucket.v1.BucketService/MarkBucketClosed"
+	BucketService_SetBucketAnchorPending_FullMethodName = "/trustflow.bucket.v1.BucketService/SetBucketAnchorPending"
+	BucketService_SetBucketAnchored_FullMethodName      = "/trustflow.bucket.v1.BucketService/SetBucketAnchored"
+	BucketService_SetBucketStatus_FullMethodName        = "/trustflow.bucket.v1.BucketService/SetBucketStatus"
+	BucketService_ListBucketHistory_FullMethodName      = "/trustflow.bucket.v1.BucketService/ListBucketHistory"
+	BucketService_PackBucket_FullMethodName             = "/trustflow.bucket.v1.BucketService/PackBucket"
+	BucketService_AddBucketCheckpoint_FullMethodName    = "/trustflow.bucket.v1.BucketService/AddBucketCheckpoint"
+	BucketService_ListBucketsByStatus_FullMethodName    = "/trustflow.bucket.v1.BucketService/ListBucketsByStatus"
+	BucketService_SetBucketPolicy_FullMethodName        = "/trustflow.bucket.v1.BucketService/SetBucketPolicy"
+	BucketService_GetScopeProject_FullMethodName        = "/trustflow.bucket.v1.BucketService/GetScopeProject"
+	BucketService_SealEpoch_FullMethodName              = "/trustflow.bucket.v1.BucketService/SealEpoch"
+	BucketService_GetEpoch_FullMethodName               = "/trustflow.bucket.v1.BucketService/GetEpoch"
+	BucketService_ListEpochsByStatus_FullMethodName     = "/trustflow.bucket.v1.BucketService/ListEpochsByStatus"
+	BucketService_SetEpochAnchorPending_FullMethodName  = "/trustflow.bucket.v1.BucketService/SetEpochAnchorPending"
+	BucketService_AuditBucket_FullMethodName            = "/trustflow.bucket.v1.BucketService/AuditBucket"
+	BucketService_ListAuditFindings_FullMethodName      = "/trustflow.bucket.v1.BucketService/ListAuditFindings"
 )
 
 // BucketServiceClient is the client API for BucketService service.
@@ -34,10 +50,28 @@ type BucketServiceClient interface {
 	ListBuckets(ctx context.Context, in *ListBucketsRequest, opts ...grpc.CallOption) (*ListBucketsResponse, error)
 	GetBucket(ctx context.Context, in *GetBucketRequest, opts ...grpc.CallOption) (*GetBucketResponse, error)
 	InclusionProof(ctx context.Context, in *InclusionProofRequest, opts ...grpc.CallOption) (*InclusionProofResponse, error)
+	ConsistencyProof(ctx context.Context, in *ConsistencyProofRequest, opts ...grpc.CallOption) (*ConsistencyProofResponse, error)
+	ExportBucket(ctx context.Context, in *ExportBucketRequest, opts ...grpc.CallOption) (*ExportBucketResponse, error)
+	CompletenessProof(ctx context.Context, in *CompletenessProofRequest, opts ...grpc.CallOption) (*CompletenessProofResponse, error)
 	// Let DS or ledger close/anchor explicitly (optional if DS auto-closes).
 	MarkBucketClosed(ctx context.Context, in *MarkBucketClosedRequest, opts ...grpc.CallOption) (*MarkBucketClosedResponse, error)
+	SetBucketAnchorPending(ctx context.Context, in *SetBucketAnchorPendingRequest, opts ...grpc.CallOption) (*SetBucketAnchorPendingResponse, error)
 	SetBucketAnchored(ctx context.Context, in *SetBucketAnchoredRequest, opts ...grpc.CallOption) (*SetBucketAnchoredResponse, error)
+	SetBucketStatus(ctx context.Context, in *SetBucketStatusRequest, opts ...grpc.CallOption) (*SetBucketStatusResponse, error)
+	ListBucketHistory(ctx context.Context, in *ListBucketHistoryRequest, opts ...grpc.CallOption) (*ListBucketHistoryResponse, error)
+	PackBucket(ctx context.Context, in *PackBucketRequest, opts ...grpc.CallOption) (*PackBucketResponse, error)
+	AddBucketCheckpoint(ctx context.Context, in *AddBucketCheckpointRequest, opts ...grpc.CallOption) (*AddBucketCheckpointResponse, error)
 	ListBucketsByStatus(ctx context.Context, in *ListBucketsByStatusRequest, opts ...grpc.CallOption) (*ListBucketsByStatusResponse, error)
+	SetBucketPolicy(ctx context.Context, in *SetBucketPolicyRequest, opts ...grpc.CallOption) (*SetBucketPolicyResponse, error)
+	GetScopeProject(ctx context.Context, in *GetScopeProjectRequest, opts ...grpc.CallOption) (*GetScopeProjectResponse, error)
+	// Epoch roll-ups (ledger anchors one root per epoch).
+	SealEpoch(ctx context.Context, in *SealEpochRequest, opts ...grpc.CallOption) (*SealEpochResponse, error)
+	GetEpoch(ctx context.Context, in *GetEpochRequest, opts ...grpc.CallOption) (*GetEpochResponse, error)
+	ListEpochsByStatus(ctx context.Context, in *ListEpochsByStatusRequest, opts ...grpc.CallOption) (*ListEpochsByStatusResponse, error)
+	SetEpochAnchorPending(ctx context.Context, in *SetEpochAnchorPendingRequest, opts ...grpc.CallOption) (*SetEpochAnchorPendingResponse, error)
+	// Integrity auditor (also runs in the background).
+	AuditBucket(ctx context.Context, in *AuditBucketRequest, opts ...grpc.CallOption) (*AuditBucketResponse, error)
+	ListAuditFindings(ctx context.Context, in *ListAuditFindingsRequest, opts ...grpc.CallOption) (*ListAuditFindingsResponse, error)
 }
 
 type bucketServiceClient struct {
@@ -78,6 +112,36 @@ func (c *bucketServiceClient) InclusionProof(ctx context.Context, in *InclusionP
 	return out, nil
 }
 
+func (c *bucketServiceClient) ConsistencyProof(ctx context.Context, in *ConsistencyProofRequest, opts ...grpc.CallOption) (*ConsistencyProofResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConsistencyProofResponse)
+	err := c.cc.Invoke(ctx, BucketService_ConsistencyProof_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bucketServiceClient) ExportBucket(ctx context.Context, in *ExportBucketRequest, opts ...grpc.CallOption) (*ExportBucketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportBucketResponse)
+	err := c.cc.Invoke(ctx, BucketService_ExportBucket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bucketServiceClient) CompletenessProof(ctx context.Context, in *CompletenessProofRequest, opts ...grpc.CallOption) (*CompletenessProofResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompletenessProofResponse)
+	err := c.cc.Invoke(ctx, BucketService_CompletenessProof_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bucketServiceClient) MarkBucketClosed(ctx context.Context, in *MarkBucketClosedRequest, opts ...grpc.CallOption) (*MarkBucketClosedResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MarkBucketClosedResponse)
@@ -88,6 +152,16 @@ func (c *bucketServiceClient) MarkBucketClosed(ctx context.Context, in *MarkBuck
 	return out, nil
 }
 
+func (c *bucketServiceClient) SetBucketAnchorPending(ctx context.Context, in *SetBucketAnchorPendingRequest, opts ...grpc.CallOption) (*SetBucketAnchorPendingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetBucketAnchorPendingResponse)
+	err := c.cc.Invoke(ctx, BucketService_SetBucketAnchorPending_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bucketServiceClient) SetBucketAnchored(ctx context.Context, in *SetBucketAnchoredRequest, opts ...grpc.CallOption) (*SetBucketAnchoredResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SetBucketAnchoredResponse)
@@ -98,6 +172,46 @@ func (c *bucketServiceClient) SetBucketAnchored(ctx context.Context, in *SetBuck
 	return out, nil
 }
 
+func (c *bucketServiceClient) SetBucketStatus(ctx context.Context, in *SetBucketStatusRequest, opts ...grpc.CallOption) (*SetBucketStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetBucketStatusResponse)
+	err := c.cc.Invoke(ctx, BucketService_SetBucketStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bucketServiceClient) ListBucketHistory(ctx context.Context, in *ListBucketHistoryRequest, opts ...grpc.CallOption) (*ListBucketHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBucketHistoryResponse)
+	err := c.cc.Invoke(ctx, BucketService_ListBucketHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bucketServiceClient) PackBucket(ctx context.Context, in *PackBucketRequest, opts ...grpc.CallOption) (*PackBucketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PackBucketResponse)
+	err := c.cc.Invoke(ctx, BucketService_PackBucket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bucketServiceClient) AddBucketCheckpoint(ctx context.Context, in *AddBucketCheckpointRequest, opts ...grpc.CallOption) (*AddBucketCheckpointResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddBucketCheckpointResponse)
+	err := c.cc.Invoke(ctx, BucketService_AddBucketCheckpoint_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bucketServiceClient) ListBucketsByStatus(ctx context.Context, in *ListBucketsByStatusRequest, opts ...grpc.CallOption) (*ListBucketsByStatusResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListBucketsByStatusResponse)
@@ -108,6 +222,86 @@ func (c *bucketServiceClient) ListBucketsByStatus(ctx context.Context, in *ListB
 	return out, nil
 }
 
+func (c *bucketServiceClient) SetBucketPolicy(ctx context.Context, in *SetBucketPolicyRequest, opts ...grpc.CallOption) (*SetBucketPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetBucketPolicyResponse)
+	err := c.cc.Invoke(ctx, BucketService_SetBucketPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bucketServiceClient) GetScopeProject(ctx context.Context, in *GetScopeProjectRequest, opts ...grpc.CallOption) (*GetScopeProjectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetScopeProjectResponse)
+	err := c.cc.Invoke(ctx, BucketService_GetScopeProject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bucketServiceClient) SealEpoch(ctx context.Context, in *SealEpochRequest, opts ...grpc.CallOption) (*SealEpochResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SealEpochResponse)
+	err := c.cc.Invoke(ctx, BucketService_SealEpoch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bucketServiceClient) GetEpoch(ctx context.Context, in *GetEpochRequest, opts ...grpc.CallOption) (*GetEpochResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEpochResponse)
+	err := c.cc.Invoke(ctx, BucketService_GetEpoch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bucketServiceClient) ListEpochsByStatus(ctx context.Context, in *ListEpochsByStatusRequest, opts ...grpc.CallOption) (*ListEpochsByStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEpochsByStatusResponse)
+	err := c.cc.Invoke(ctx, BucketService_ListEpochsByStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bucketServiceClient) SetEpochAnchorPending(ctx context.Context, in *SetEpochAnchorPendingRequest, opts ...grpc.CallOption) (*SetEpochAnchorPendingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetEpochAnchorPendingResponse)
+	err := c.cc.Invoke(ctx, BucketService_SetEpochAnchorPending_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bucketServiceClient) AuditBucket(ctx context.Context, in *AuditBucketRequest, opts ...grpc.CallOption) (*AuditBucketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuditBucketResponse)
+	err := c.cc.Invoke(ctx, BucketService_AuditBucket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bucketServiceClient) ListAuditFindings(ctx context.Context, in *ListAuditFindingsRequest, opts ...grpc.CallOption) (*ListAuditFindingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditFindingsResponse)
+	err := c.cc.Invoke(ctx, BucketService_ListAuditFindings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BucketServiceServer is the server API for BucketService service.
 // All implementations must embed UnimplementedBucketServiceServer
 // for forward compatibility.
@@ -115,10 +309,28 @@ type BucketServiceServer interface {
 	ListBuckets(context.Context, *ListBucketsRequest) (*ListBucketsResponse, error)
 	GetBucket(context.Context, *GetBucketRequest) (*GetBucketResponse, error)
 	InclusionProof(context.Context, *InclusionProofRequest) (*InclusionProofResponse, error)
+	ConsistencyProof(context.Context, *ConsistencyProofRequest) (*ConsistencyProofResponse, error)
+	ExportBucket(context.Context, *ExportBucketRequest) (*ExportBucketResponse, error)
+	CompletenessProof(context.Context, *CompletenessProofRequest) (*CompletenessProofResponse, error)
 	// Let DS or ledger close/anchor explicitly (optional if DS auto-closes).
 	MarkBucketClosed(context.Context, *MarkBucketClosedRequest) (*MarkBucketClosedResponse, error)
+	SetBucketAnchorPending(context.Context, *SetBucketAnchorPendingRequest) (*SetBucketAnchorPendingResponse, error)
 	SetBucketAnchored(context.Context, *SetBucketAnchoredRequest) (*SetBucketAnchoredResponse, error)
+	SetBucketStatus(context.Context, *SetBucketStatusRequest) (*SetBucketStatusResponse, error)
+	ListBucketHistory(context.Context, *ListBucketHistoryRequest) (*ListBucketHistoryResponse, error)
+	PackBucket(context.Context, *PackBucketRequest) (*PackBucketResponse, error)
+	AddBucketCheckpoint(context.Context, *AddBucketCheckpointRequest) (*AddBucketCheckpointResponse, error)
 	ListBucketsByStatus(context.Context, *ListBucketsByStatusRequest) (*ListBucketsByStatusResponse, error)
+	SetBucketPolicy(context.Context, *SetBucketPolicyRequest) (*SetBucketPolicyResponse, error)
+	GetScopeProject(context.Context, *GetScopeProjectRequest) (*GetScopeProjectResponse, error)
+	// Epoch roll-ups (ledger anchors one root per epoch).
+	SealEpoch(context.Context, *SealEpochRequest) (*SealEpochResponse, error)
+	GetEpoch(context.Context, *GetEpochRequest) (*GetEpochResponse, error)
+	ListEpochsByStatus(context.Context, *ListEpochsByStatusRequest) (*ListEpochsByStatusResponse, error)
+	SetEpochAnchorPending(context.Context, *SetEpochAnchorPendingRequest) (*SetEpochAnchorPendingResponse, error)
+	// Integrity auditor (also runs in the background).
+	AuditBucket(context.Context, *AuditBucketRequest) (*AuditBucketResponse, error)
+	ListAuditFindings(context.Context, *ListAuditFindingsRequest) (*ListAuditFindingsResponse, error)
 	mustEmbedUnimplementedBucketServiceServer()
 }
 
@@ -138,15 +350,63 @@ func (UnimplementedBucketServiceServer) GetBucket(context.Context, *GetBucketReq
 func (UnimplementedBucketServiceServer) InclusionProof(context.Context, *InclusionProofRequest) (*InclusionProofResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InclusionProof not implemented")
 }
+func (UnimplementedBucketServiceServer) ConsistencyProof(context.Context, *ConsistencyProofRequest) (*ConsistencyProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsistencyProof not implemented")
+}
+func (UnimplementedBucketServiceServer) ExportBucket(context.Context, *ExportBucketRequest) (*ExportBucketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportBucket not implemented")
+}
+func (UnimplementedBucketServiceServer) CompletenessProof(context.Context, *CompletenessProofRequest) (*CompletenessProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompletenessProof not implemented")
+}
 func (UnimplementedBucketServiceServer) MarkBucketClosed(context.Context, *MarkBucketClosedRequest) (*MarkBucketClosedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MarkBucketClosed not implemented")
 }
+func (UnimplementedBucketServiceServer) SetBucketAnchorPending(context.Context, *SetBucketAnchorPendingRequest) (*SetBucketAnchorPendingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBucketAnchorPending not implemented")
+}
 func (UnimplementedBucketServiceServer) SetBucketAnchored(context.Context, *SetBucketAnchoredRequest) (*SetBucketAnchoredResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetBucketAnchored not implemented")
 }
+func (UnimplementedBucketServiceServer) SetBucketStatus(context.Context, *SetBucketStatusRequest) (*SetBucketStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBucketStatus not implemented")
+}
+func (UnimplementedBucketServiceServer) ListBucketHistory(context.Context, *ListBucketHistoryRequest) (*ListBucketHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBucketHistory not implemented")
+}
+func (UnimplementedBucketServiceServer) PackBucket(context.Context, *PackBucketRequest) (*PackBucketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PackBucket not implemented")
+}
+func (UnimplementedBucketServiceServer) AddBucketCheckpoint(context.Context, *AddBucketCheckpointRequest) (*AddBucketCheckpointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBucketCheckpoint not implemented")
+}
 func (UnimplementedBucketServiceServer) ListBucketsByStatus(context.Context, *ListBucketsByStatusRequest) (*ListBucketsByStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListBucketsByStatus not implemented")
 }
+func (UnimplementedBucketServiceServer) SetBucketPolicy(context.Context, *SetBucketPolicyRequest) (*SetBucketPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBucketPolicy not implemented")
+}
+func (UnimplementedBucketServiceServer) GetScopeProject(context.Context, *GetScopeProjectRequest) (*GetScopeProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScopeProject not implemented")
+}
+func (UnimplementedBucketServiceServer) SealEpoch(context.Context, *SealEpochRequest) (*SealEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SealEpoch not implemented")
+}
+func (UnimplementedBucketServiceServer) GetEpoch(context.Context, *GetEpochRequest) (*GetEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEpoch not implemented")
+}
+func (UnimplementedBucketServiceServer) ListEpochsByStatus(context.Context, *ListEpochsByStatusRequest) (*ListEpochsByStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEpochsByStatus not implemented")
+}
+func (UnimplementedBucketServiceServer) SetEpochAnchorPending(context.Context, *SetEpochAnchorPendingRequest) (*SetEpochAnchorPendingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEpochAnchorPending not implemented")
+}
+func (UnimplementedBucketServiceServer) AuditBucket(context.Context, *AuditBucketRequest) (*AuditBucketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuditBucket not implemented")
+}
+func (UnimplementedBucketServiceServer) ListAuditFindings(context.Context, *ListAuditFindingsRequest) (*ListAuditFindingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditFindings not implemented")
+}
 func (UnimplementedBucketServiceServer) mustEmbedUnimplementedBucketServiceServer() {}
 func (UnimplementedBucketServiceServer) testEmbeddedByValue()                       {}
 
@@ -222,6 +482,60 @@ func _BucketService_InclusionProof_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BucketService_ConsistencyProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsistencyProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BucketServiceServer).ConsistencyProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BucketService_ConsistencyProof_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BucketServiceServer).ConsistencyProof(ctx, req.(*ConsistencyProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BucketService_ExportBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportBucketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BucketServiceServer).ExportBucket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BucketService_ExportBucket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BucketServiceServer).ExportBucket(ctx, req.(*ExportBucketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BucketService_CompletenessProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompletenessProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BucketServiceServer).CompletenessProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BucketService_CompletenessProof_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BucketServiceServer).CompletenessProof(ctx, req.(*CompletenessProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BucketService_MarkBucketClosed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MarkBucketClosedRequest)
 	if err := dec(in); err != nil {
@@ -240,6 +554,24 @@ func _BucketService_MarkBucketClosed_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BucketService_SetBucketAnchorPending_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBucketAnchorPendingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BucketServiceServer).SetBucketAnchorPending(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BucketService_SetBucketAnchorPending_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BucketServiceServer).SetBucketAnchorPending(ctx, req.(*SetBucketAnchorPendingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BucketService_SetBucketAnchored_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SetBucketAnchoredRequest)
 	if err := dec(in); err != nil {
@@ -258,6 +590,78 @@ func _BucketService_SetBucketAnchored_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BucketService_SetBucketStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBucketStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BucketServiceServer).SetBucketStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BucketService_SetBucketStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BucketServiceServer).SetBucketStatus(ctx, req.(*SetBucketStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BucketService_ListBucketHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBucketHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BucketServiceServer).ListBucketHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BucketService_ListBucketHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BucketServiceServer).ListBucketHistory(ctx, req.(*ListBucketHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BucketService_PackBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PackBucketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BucketServiceServer).PackBucket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BucketService_PackBucket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BucketServiceServer).PackBucket(ctx, req.(*PackBucketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BucketService_AddBucketCheckpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddBucketCheckpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BucketServiceServer).AddBucketCheckpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BucketService_AddBucketCheckpoint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BucketServiceServer).AddBucketCheckpoint(ctx, req.(*AddBucketCheckpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BucketService_ListBucketsByStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListBucketsByStatusRequest)
 	if err := dec(in); err != nil {
@@ -276,6 +680,150 @@ func _BucketService_ListBucketsByStatus_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BucketService_SetBucketPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBucketPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BucketServiceServer).SetBucketPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BucketService_SetBucketPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BucketServiceServer).SetBucketPolicy(ctx, req.(*SetBucketPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BucketService_GetScopeProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScopeProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BucketServiceServer).GetScopeProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BucketService_GetScopeProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BucketServiceServer).GetScopeProject(ctx, req.(*GetScopeProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BucketService_SealEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SealEpochRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BucketServiceServer).SealEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BucketService_SealEpoch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BucketServiceServer).SealEpoch(ctx, req.(*SealEpochRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BucketService_GetEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEpochRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BucketServiceServer).GetEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BucketService_GetEpoch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BucketServiceServer).GetEpoch(ctx, req.(*GetEpochRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BucketService_ListEpochsByStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEpochsByStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BucketServiceServer).ListEpochsByStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BucketService_ListEpochsByStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BucketServiceServer).ListEpochsByStatus(ctx, req.(*ListEpochsByStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BucketService_SetEpochAnchorPending_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetEpochAnchorPendingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BucketServiceServer).SetEpochAnchorPending(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BucketService_SetEpochAnchorPending_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BucketServiceServer).SetEpochAnchorPending(ctx, req.(*SetEpochAnchorPendingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BucketService_AuditBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditBucketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BucketServiceServer).AuditBucket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BucketService_AuditBucket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BucketServiceServer).AuditBucket(ctx, req.(*AuditBucketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BucketService_ListAuditFindings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditFindingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BucketServiceServer).ListAuditFindings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BucketService_ListAuditFindings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BucketServiceServer).ListAuditFindings(ctx, req.(*ListAuditFindingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BucketService_ServiceDesc is the grpc.ServiceDesc for BucketService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -295,18 +843,82 @@ var BucketService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InclusionProof",
 			Handler:    _BucketService_InclusionProof_Handler,
 		},
+		{
+			MethodName: "ConsistencyProof",
+			Handler:    _BucketService_ConsistencyProof_Handler,
+		},
+		{
+			MethodName: "ExportBucket",
+			Handler:    _BucketService_ExportBucket_Handler,
+		},
+		{
+			MethodName: "CompletenessProof",
+			Handler:    _BucketService_CompletenessProof_Handler,
+		},
 		{
 			MethodName: "MarkBucketClosed",
 			Handler:    _BucketService_MarkBucketClosed_Handler,
 		},
+		{
+			MethodName: "SetBucketAnchorPending",
+			Handler:    _BucketService_SetBucketAnchorPending_Handler,
+		},
 		{
 			MethodName: "SetBucketAnchored",
 			Handler:    _BucketService_SetBucketAnchored_Handler,
 		},
+		{
+			MethodName: "SetBucketStatus",
+			Handler:    _BucketService_SetBucketStatus_Handler,
+		},
+		{
+			MethodName: "ListBucketHistory",
+			Handler:    _BucketService_ListBucketHistory_Handler,
+		},
+		{
+			MethodName: "PackBucket",
+			Handler:    _BucketService_PackBucket_Handler,
+		},
+		{
+			MethodName: "AddBucketCheckpoint",
+			Handler:    _BucketService_AddBucketCheckpoint_Handler,
+		},
 		{
 			MethodName: "ListBucketsByStatus",
 			Handler:    _BucketService_ListBucketsByStatus_Handler,
 		},
+		{
+			MethodName: "SetBucketPolicy",
+			Handler:    _BucketService_SetBucketPolicy_Handler,
+		},
+		{
+			MethodName: "GetScopeProject",
+			Handler:    _BucketService_GetScopeProject_Handler,
+		},
+		{
+			MethodName: "SealEpoch",
+			Handler:    _BucketService_SealEpoch_Handler,
+		},
+		{
+			MethodName: "GetEpoch",
+			Handler:    _BucketService_GetEpoch_Handler,
+		},
+		{
+			MethodName: "ListEpochsByStatus",
+			Handler:    _BucketService_ListEpochsByStatus_Handler,
+		},
+		{
+			MethodName: "SetEpochAnchorPending",
+			Handler:    _BucketService_SetEpochAnchorPending_Handler,
+		},
+		{
+			MethodName: "AuditBucket",
+			Handler:    _BucketService_AuditBucket_Handler,
+		},
+		{
+			MethodName: "ListAuditFindings",
+			Handler:    _BucketService_ListAuditFindings_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "bucket.proto",
@@ -0,0 +1,61 @@
+// Package blobstore keeps exported artifacts (CAR files) in a local directory.
+package blobstore
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Dir stores blobs as files under Root.
+type Dir struct {
+	Root string
+}
+
+func NewDir(root string) (*Dir, error) {
+	if err := os.MkdirAll(root, 0o755); err != nil {
+		return nil, err
+	}
+	return &Dir{Root: root}, nil
+}
+
+// Put writes name atomically (temp file + rename) and returns its path and size.
+func (d *Dir) Put(name string, write func(io.Writer) error) (string, int64, error) {
+	if name == "" || strings.ContainsAny(name, `/\`) {
+		return "", 0, errors.New("blobstore: invalid name")
+	}
+	tmp, err := os.CreateTemp(d.Root, "."+name+".*")
+	if err != nil {
+		return "", 0, err
+	}
+	defer os.Remove(tmp.Name()) // no-op once renamed
+
+	if err := write(tmp); err != nil {
+		tmp.Close()
+		return "", 0, err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return "", 0, err
+	}
+	st, err := tmp.Stat()
+	if err != nil {
+		tmp.Close()
+		return "", 0, err
+	}
+	if err := tmp.Close(); err != nil {
+		return "", 0, err
+	}
+	path := filepath.Join(d.Root, name)
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return "", 0, err
+	}
+	return path, st.Size(), nil
+}
+
+// Path returns where name is (or would be) stored.
+func (d *Dir) Path(name string) string {
+	return filepath.Join(d.Root, name)
+}
@@ -0,0 +1,62 @@
+// Package car writes CARv1 archives (https://ipld.io/specs/transport/car/carv1/):
+// a varint-framed DAG-CBOR header followed by varint-framed (CID, block) pairs.
+package car
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+
+	cbor "github.com/fxamacker/cbor/v2"
+	cid "github.com/ipfs/go-cid"
+
+	"github.com/gusplusbus/trustflow/data_server/internal/service/crypto"
+)
+
+// Block is one IPLD block and its CID.
+type Block struct {
+	CID  cid.Cid
+	Data []byte
+}
+
+type header struct {
+	Roots   []cbor.Tag `cbor:"roots"`
+	Version uint64     `cbor:"version"`
+}
+
+var headerEnc, _ = cbor.CanonicalEncOptions().EncMode()
+
+// WriteV1 writes a CARv1 with a single root followed by blocks in order.
+func WriteV1(w io.Writer, root cid.Cid, blocks []Block) error {
+	bw := bufio.NewWriter(w)
+	h, err := headerEnc.Marshal(header{Roots: []cbor.Tag{crypto.Link(root)}, Version: 1})
+	if err != nil {
+		return err
+	}
+	if err := writeFrame(bw, h); err != nil {
+		return err
+	}
+	for _, b := range blocks {
+		if err := writeFrame(bw, b.CID.Bytes(), b.Data); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+func writeFrame(w *bufio.Writer, parts ...[]byte) error {
+	n := 0
+	for _, p := range parts {
+		n += len(p)
+	}
+	var lb [binary.MaxVarintLen64]byte
+	if _, err := w.Write(lb[:binary.PutUvarint(lb[:], uint64(n))]); err != nil {
+		return err
+	}
+	for _, p := range parts {
+		if _, err := w.Write(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package car
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"testing"
+
+	cbor "github.com/fxamacker/cbor/v2"
+	cid "github.com/ipfs/go-cid"
+
+	"github.com/gusplusbus/trustflow/data_server/internal/service/crypto"
+)
+
+func readFrame(t *testing.T, r *bufio.Reader) []byte {
+	t.Helper()
+	n, err := binary.ReadUvarint(r)
+	if err != nil {
+		t.Fatalf("read varint: %v", err)
+	}
+	b := make([]byte, n)
+	if _, err := io.ReadFull(r, b); err != nil {
+		t.Fatalf("read frame: %v", err)
+	}
+	return b
+}
+
+func TestWriteV1(t *testing.T) {
+	var blocks []Block
+	for _, s := range []string{"a", "b"} {
+		data, _ := cbor.Marshal(s)
+		c, err := crypto.BlockCID(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		blocks = append(blocks, Block{CID: c, Data: data})
+	}
+
+	var buf bytes.Buffer
+	if err := WriteV1(&buf, blocks[0].CID, blocks); err != nil {
+		t.Fatalf("WriteV1() error = %v", err)
+	}
+
+	r := bufio.NewReader(&buf)
+	var h header
+	if err := cbor.Unmarshal(readFrame(t, r), &h); err != nil {
+		t.Fatalf("decode header: %v", err)
+	}
+	if h.Version != 1 || len(h.Roots) != 1 || h.Roots[0].Number != 42 {
+		t.Fatalf("header = %+v", h)
+	}
+	root, err := cid.Cast(h.Roots[0].Content.([]byte)[1:])
+	if err != nil || !root.Equals(blocks[0].CID) {
+		t.Fatalf("root = %v, %v; want %v", root, err, blocks[0].CID)
+	}
+
+	for _, want := range blocks {
+		frame := readFrame(t, r)
+		n, c, err := cid.CidFromBytes(frame)
+		if err != nil {
+			t.Fatalf("block cid: %v", err)
+		}
+		if !c.Equals(want.CID) || !bytes.Equal(frame[n:], want.Data) {
+			t.Fatalf("block = %v %x, want %v %x", c, frame[n:], want.CID, want.Data)
+		}
+	}
+	if _, err := r.ReadByte(); err != io.EOF {
+		t.Fatalf("trailing data after last block")
+	}
+}
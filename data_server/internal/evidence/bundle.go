@@ -0,0 +1,129 @@
+// Package evidence defines the self-contained bucket bundle we hand to
+// sponsors and contributors, and the checks trustflow-verify runs on it.
+package evidence
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/gusplusbus/trustflow/data_server/internal/service/crypto"
+)
+
+// Format identifies the bundle layout; bump it on incompatible changes.
+const Format = "trustflow-bucket-bundle/1"
+
+// Hex is a byte string that travels as lowercase hex in JSON.
+type Hex []byte
+
+func (h Hex) MarshalText() ([]byte, error) { return []byte(hex.EncodeToString(h)), nil }
+
+func (h *Hex) UnmarshalText(b []byte) error {
+	v, err := hex.DecodeString(string(b))
+	if err != nil {
+		return err
+	}
+	*h = v
+	return nil
+}
+
+// Bundle is everything needed to recheck one bucket without our services.
+type Bundle struct {
+	Format     string  `json:"format"`
+	EntityKind string  `json:"entity_kind"`
+	EntityKey  string  `json:"entity_key"`
+	BucketKey  string  `json:"bucket_key"`
+	TreeAlg    uint8   `json:"tree_alg"`
+	Policy     string  `json:"policy,omitempty"`    // bucketing policy that keyed BucketKey
+	Amends     string  `json:"amends,omitempty"`    // sealed bucket this amendment extends
+	ChainSeq   uint32  `json:"chain_seq,omitempty"` // position in the scope's chain; the last leaf is the link
+	PrevRoot   Hex     `json:"prev_root,omitempty"` // root of the previous link (empty for the first)
+	LogSize    uint32  `json:"log_size,omitempty"`  // scope sequence log committed by the link
+	LogRoot    Hex     `json:"log_root,omitempty"`
+	LeafOrder  uint8   `json:"leaf_order,omitempty"` // 1 = items sorted by created_at, provider_event_id
+	RootHash   Hex     `json:"root_hash"`
+	LeafCount  uint32  `json:"leaf_count"`
+	Leaves     []Hex   `json:"leaves"` // item hashes in leaf order, then the chain link if chained
+	Items      []Item  `json:"items"`
+	Proofs     []Proof `json:"proofs"`
+	Anchor     Anchor  `json:"anchor"`
+}
+
+// chainLink is the bundle's chain link, or nil when unchained.
+func (b *Bundle) chainLink() *crypto.ChainLink {
+	if b.ChainSeq == 0 {
+		return nil
+	}
+	return &crypto.ChainLink{Seq: b.ChainSeq, PrevRoot: b.PrevRoot, LogSize: b.LogSize, LogRoot: b.LogRoot}
+}
+
+// itemLeaves are the leaves that must each match an item: all but the chain
+// link of a chained bucket.
+func (b *Bundle) itemLeaves() []Hex {
+	if b.ChainSeq == 0 || len(b.Leaves) == 0 {
+		return b.Leaves
+	}
+	return b.Leaves[:len(b.Leaves)-1]
+}
+
+// Item is a timeline item exactly as it was canonicalized and hashed.
+type Item struct {
+	Provider        string          `json:"provider"`
+	ProviderEventID string          `json:"provider_event_id"`
+	Type            string          `json:"type"`
+	Actor           *string         `json:"actor,omitempty"`
+	CreatedAt       time.Time       `json:"created_at"`
+	Payload         json.RawMessage `json:"payload"`
+	ItemHash        Hex             `json:"item_hash"`
+	CanonVersion    uint8           `json:"canon_version,omitempty"` // absent = 1 (legacy)
+}
+
+// Canon rebuilds the shape the item was hashed in, under its canon_version.
+func (it Item) Canon() (crypto.CanonItem, error) {
+	v := crypto.CanonVersion(it.CanonVersion)
+	if v == 0 {
+		v = crypto.CanonLegacy
+	}
+	return crypto.CanonicalItem(v, it.Provider, it.ProviderEventID, it.Type, it.Actor, it.CreatedAt, it.Payload)
+}
+
+// Proof is the inclusion proof of one item against RootHash.
+type Proof struct {
+	ProviderEventID string `json:"provider_event_id"`
+	LeafIndex       uint32 `json:"leaf_index"`
+	Path            []Step `json:"path"`
+}
+
+type Step struct {
+	Sibling       Hex  `json:"sibling"`
+	SiblingIsLeft bool `json:"sibling_is_left"`
+}
+
+// Anchor is the bucket's anchoring record at export time.
+type Anchor struct {
+	Status     string     `json:"status"`
+	CID        string     `json:"cid,omitempty"`
+	AnchoredTx string     `json:"anchored_tx,omitempty"`
+	Block      uint64     `json:"block,omitempty"` // block that included anchored_tx
+	BlockHash  string     `json:"block_hash,omitempty"`
+	Proof      Hex        `json:"proof,omitempty"` // e.g. RFC 3161 token of a tsa anchor
+	ClosedAt   *time.Time `json:"closed_at,omitempty"`
+	AnchoredAt *time.Time `json:"anchored_at,omitempty"`
+	Epoch      *Epoch     `json:"epoch,omitempty"` // set when the root was anchored via an epoch
+}
+
+// Epoch is the epoch the bucket root was rolled into: an RFC 6962 proof from
+// the bucket's epoch leaf (crypto.EpochLeaf) to the epoch root, which is what
+// the anchor transaction committed.
+type Epoch struct {
+	ID         int64      `json:"id"`
+	Period     string     `json:"period"`
+	RootHash   Hex        `json:"root_hash"`
+	LeafIndex  uint32     `json:"leaf_index"`
+	Path       []Step     `json:"path"`
+	Status     string     `json:"status"`
+	CID        string     `json:"cid,omitempty"`
+	AnchoredTx string     `json:"anchored_tx,omitempty"`
+	AnchoredAt *time.Time `json:"anchored_at,omitempty"`
+	Proof      Hex        `json:"proof,omitempty"`
+}
@@ -0,0 +1,90 @@
+package evidence
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/gusplusbus/trustflow/data_server/internal/service/crypto"
+)
+
+// CompletenessFormat identifies the completeness proof layout.
+const CompletenessFormat = "trustflow-completeness/1"
+
+// Completeness proves seq FromSeq..ToSeq of a scope are exactly Entries: they
+// are consecutive leaves of the scope's sequence log of LogSize entries, and
+// the chain link of bucket CommittedBy commits to that log's root.
+type Completeness struct {
+	Format      string     `json:"format"`
+	EntityKind  string     `json:"entity_kind"`
+	EntityKey   string     `json:"entity_key"`
+	FromSeq     uint64     `json:"from_seq"`
+	ToSeq       uint64     `json:"to_seq"`
+	LogSize     uint32     `json:"log_size"`
+	LogRoot     Hex        `json:"log_root"`
+	CommittedBy string     `json:"committed_by"` // bucket_key whose chain link commits the log
+	Entries     []SeqEntry `json:"entries"`
+	Proof       []Hex      `json:"proof"` // subtrees outside the range, left to right
+}
+
+// SeqEntry is one item of the range, by its place in the scope's history.
+type SeqEntry struct {
+	Seq             uint64 `json:"seq"`
+	ProviderEventID string `json:"provider_event_id"`
+	ItemHash        Hex    `json:"item_hash"`
+	BucketKey       string `json:"bucket_key"` // where the item's inclusion proof lives
+}
+
+// VerifyCompleteness checks the range proof and that b, the bundle of the
+// committing bucket, commits the same log. b itself is checked by Verify.
+func VerifyCompleteness(c *Completeness, b *Bundle) []Result {
+	if c.Format != CompletenessFormat {
+		return []Result{{Check: "format", Err: fmt.Errorf("unsupported completeness format %q", c.Format)}}
+	}
+	return []Result{checkRange(c), checkCommitment(c, b)}
+}
+
+func checkRange(c *Completeness) Result {
+	r := Result{Check: "range"}
+	if c.FromSeq == 0 || c.ToSeq < c.FromSeq || c.ToSeq > uint64(c.LogSize) {
+		r.Err = fmt.Errorf("seq %d..%d is not a range of a %d-entry log", c.FromSeq, c.ToSeq, c.LogSize)
+		return r
+	}
+	if uint64(len(c.Entries)) != c.ToSeq-c.FromSeq+1 {
+		r.Err = fmt.Errorf("%d entries for seq %d..%d", len(c.Entries), c.FromSeq, c.ToSeq)
+		return r
+	}
+	leaves := make([][]byte, len(c.Entries))
+	for i, e := range c.Entries {
+		if e.Seq != c.FromSeq+uint64(i) {
+			r.Err = fmt.Errorf("entry %d has seq %d, want %d", i, e.Seq, c.FromSeq+uint64(i))
+			return r
+		}
+		leaves[i] = crypto.SeqLeaf(c.EntityKind, c.EntityKey, e.Seq, e.ItemHash)
+	}
+	proof := make([][]byte, len(c.Proof))
+	for i, p := range c.Proof {
+		proof[i] = p
+	}
+	if !crypto.VerifyRange(uint64(c.LogSize), c.FromSeq-1, leaves, proof, c.LogRoot) {
+		r.Err = errors.New("entries are not that range of the sequence log")
+		return r
+	}
+	r.Note = fmt.Sprintf("seq %d..%d of %d, none missing", c.FromSeq, c.ToSeq, c.LogSize)
+	return r
+}
+
+func checkCommitment(c *Completeness, b *Bundle) Result {
+	r := Result{Check: "commitment"}
+	switch {
+	case b == nil:
+		r.Err = fmt.Errorf("bundle of committing bucket %s not supplied", c.CommittedBy)
+	case b.EntityKind != c.EntityKind || b.EntityKey != c.EntityKey || b.BucketKey != c.CommittedBy:
+		r.Err = fmt.Errorf("bundle is %s/%s/%s, proof is committed by %s/%s/%s", b.EntityKind, b.EntityKey, b.BucketKey, c.EntityKind, c.EntityKey, c.CommittedBy)
+	case b.ChainSeq == 0 || b.LogSize != c.LogSize || !bytes.Equal(b.LogRoot, c.LogRoot):
+		r.Err = fmt.Errorf("bucket %s does not commit a %d-entry log with root %x", b.BucketKey, c.LogSize, []byte(c.LogRoot))
+	default:
+		r.Note = fmt.Sprintf("committed by chain link %d (%s)", b.ChainSeq, b.BucketKey)
+	}
+	return r
+}
@@ -0,0 +1,103 @@
+package evidence
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gusplusbus/trustflow/data_server/internal/service/crypto"
+)
+
+// testCompleteness proves seq from..to of testBundle's items, whose sealing
+// chain link commits their sequence log.
+func testCompleteness(t *testing.T, from, to uint64) (*Completeness, *Bundle) {
+	t.Helper()
+	b := testBundle(t)
+	var entries [][]byte
+	for i, it := range b.Items {
+		entries = append(entries, crypto.SeqLeaf(b.EntityKind, b.EntityKey, uint64(i+1), it.ItemHash))
+	}
+	proof, root := crypto.RangeProof(entries, int(from-1), int(to))
+	link := crypto.ChainLink{Seq: 1, LogSize: uint32(len(entries)), LogRoot: root}
+	b.ChainSeq, b.LogSize, b.LogRoot = link.Seq, link.LogSize, link.LogRoot
+	b.Leaves = append(b.Leaves, crypto.ChainLeaf(b.EntityKind, b.EntityKey, link))
+	rebuild(b)
+
+	c := &Completeness{
+		Format:      CompletenessFormat,
+		EntityKind:  b.EntityKind,
+		EntityKey:   b.EntityKey,
+		FromSeq:     from,
+		ToSeq:       to,
+		LogSize:     link.LogSize,
+		LogRoot:     root,
+		CommittedBy: b.BucketKey,
+	}
+	for seq := from; seq <= to; seq++ {
+		it := b.Items[seq-1]
+		c.Entries = append(c.Entries, SeqEntry{Seq: seq, ProviderEventID: it.ProviderEventID, ItemHash: it.ItemHash, BucketKey: b.BucketKey})
+	}
+	for _, p := range proof {
+		c.Proof = append(c.Proof, p)
+	}
+	return c, b
+}
+
+func TestVerifyCompleteness(t *testing.T) {
+	c, b := testCompleteness(t, 1, 3)
+	if r := Verify(b, nil); !OK(r) {
+		t.Fatalf("bundle: %v", r)
+	}
+	if r := VerifyCompleteness(c, b); !OK(r) {
+		t.Fatalf("full range: %v", r)
+	}
+	if c, b := testCompleteness(t, 2, 2); !OK(VerifyCompleteness(c, b)) {
+		t.Fatal("single entry rejected")
+	}
+
+	for name, tc := range map[string]struct {
+		check  string
+		tamper func(c *Completeness, b *Bundle) *Bundle
+	}{
+		"dropped": {"range", func(c *Completeness, b *Bundle) *Bundle {
+			c.Entries = append(c.Entries[:1], c.Entries[2:]...)
+			c.ToSeq--
+			return b
+		}},
+		"renumbered": {"range", func(c *Completeness, b *Bundle) *Bundle {
+			c.Entries = c.Entries[:2]
+			c.Entries[1].Seq = 3
+			return b
+		}},
+		"swapped": {"range", func(c *Completeness, b *Bundle) *Bundle {
+			c.Entries[0].ItemHash, c.Entries[1].ItemHash = c.Entries[1].ItemHash, c.Entries[0].ItemHash
+			return b
+		}},
+		"shrunk": {"range,commitment", func(c *Completeness, b *Bundle) *Bundle {
+			c.LogSize, c.ToSeq, c.Entries = 2, 2, c.Entries[:2]
+			return b
+		}},
+		"no bundle": {"commitment", func(c *Completeness, b *Bundle) *Bundle {
+			return nil
+		}},
+		"other bucket": {"commitment", func(c *Completeness, b *Bundle) *Bundle {
+			b.BucketKey = "2025-08-23"
+			return b
+		}},
+		"other log": {"commitment", func(c *Completeness, b *Bundle) *Bundle {
+			b.LogSize = 4
+			return b
+		}},
+	} {
+		c, b := testCompleteness(t, 1, 3)
+		b = tc.tamper(c, b)
+		var failed []string
+		for _, r := range VerifyCompleteness(c, b) {
+			if r.Err != nil {
+				failed = append(failed, r.Check)
+			}
+		}
+		if strings.Join(failed, ",") != tc.check {
+			t.Errorf("%s: failed checks %v, want %s", name, failed, tc.check)
+		}
+	}
+}
@@ -0,0 +1,350 @@
+package evidence
+
+import (
+	"bytes"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gusplusbus/trustflow/data_server/internal/service/crypto"
+	"github.com/gusplusbus/trustflow/data_server/rfc3161"
+)
+
+// Result is the outcome of one check. Err is nil when it passed.
+type Result struct {
+	Check string
+	Note  string
+	Err   error
+}
+
+// OK reports whether every check passed.
+func OK(rs []Result) bool {
+	for _, r := range rs {
+		if r.Err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// Verify rechecks a bundle using only its own contents: item hashes, the
+// Merkle root, every inclusion proof, the chain link and the anchor record.
+// tsaRoots vouch for the token of a tsa anchor (nil => system roots).
+func Verify(b *Bundle, tsaRoots *x509.CertPool) []Result {
+	if b.Format != Format {
+		return []Result{{Check: "format", Err: fmt.Errorf("unsupported bundle format %q", b.Format)}}
+	}
+	alg := crypto.TreeAlg(b.TreeAlg)
+	if !alg.Valid() {
+		return []Result{{Check: "tree_alg", Err: fmt.Errorf("unknown tree_alg %d", b.TreeAlg)}}
+	}
+	return []Result{
+		checkItems(b),
+		checkOrder(b),
+		checkRoot(b, alg),
+		checkProofs(b, alg),
+		checkChain(b),
+		checkAnchor(b, alg, tsaRoots),
+		checkEpoch(b, alg),
+	}
+}
+
+// checkItems recomputes every item hash and matches items to leaves one to one.
+func checkItems(b *Bundle) Result {
+	r := Result{Check: "items"}
+	unmatched := map[string]int{}
+	for _, l := range b.itemLeaves() {
+		unmatched[string(l)]++
+	}
+	for _, it := range b.Items {
+		canon, err := it.Canon()
+		if err != nil {
+			r.Err = err
+			return r
+		}
+		_, h, err := crypto.HashDAGCBOR(canon)
+		if err != nil {
+			r.Err = fmt.Errorf("%s: %w", it.ProviderEventID, err)
+			return r
+		}
+		if !bytes.Equal(h, it.ItemHash) {
+			r.Err = fmt.Errorf("%s: content hashes to %x, bundle says %x", it.ProviderEventID, h, []byte(it.ItemHash))
+			return r
+		}
+		if unmatched[string(h)] == 0 {
+			r.Err = fmt.Errorf("%s: item is not a leaf of the bucket", it.ProviderEventID)
+			return r
+		}
+		unmatched[string(h)]--
+	}
+	for _, n := range unmatched {
+		if n > 0 {
+			r.Err = errors.New("bucket has leaves without a matching item")
+			return r
+		}
+	}
+	r.Note = fmt.Sprintf("%d items rehashed", len(b.Items))
+	return r
+}
+
+// checkOrder checks the item leaves of a canonically ordered bucket are its
+// items sorted by created_at, then provider_event_id.
+func checkOrder(b *Bundle) Result {
+	r := Result{Check: "order"}
+	o := crypto.LeafOrder(b.LeafOrder)
+	switch {
+	case !o.Valid():
+		r.Err = fmt.Errorf("unknown leaf_order %d", b.LeafOrder)
+		return r
+	case o == crypto.LeafArrival:
+		r.Note = "arrival order"
+		return r
+	}
+	in := make([]crypto.OrderedItem, len(b.Items))
+	for i, it := range b.Items {
+		in[i] = crypto.OrderedItem{CreatedAt: it.CreatedAt, ProviderEventID: it.ProviderEventID, ItemHash: it.ItemHash}
+	}
+	want, got := crypto.OrderLeaves(o, in), b.itemLeaves()
+	if len(want) != len(got) {
+		r.Err = fmt.Errorf("%d item leaves for %d items", len(got), len(want))
+		return r
+	}
+	for i := range want {
+		if !bytes.Equal(want[i], got[i]) {
+			r.Err = fmt.Errorf("leaf %d is out of canonical order", i)
+			return r
+		}
+	}
+	r.Note = "canonical (created_at, provider_event_id)"
+	return r
+}
+
+func checkRoot(b *Bundle, alg crypto.TreeAlg) Result {
+	r := Result{Check: "root"}
+	if uint32(len(b.Leaves)) != b.LeafCount {
+		r.Err = fmt.Errorf("%d leaves, bundle says leaf_count %d", len(b.Leaves), b.LeafCount)
+		return r
+	}
+	leaves := make([][]byte, len(b.Leaves))
+	for i, l := range b.Leaves {
+		leaves[i] = l
+	}
+	if root := crypto.BuildMerkleRoot(alg, leaves); !bytes.Equal(root, b.RootHash) {
+		r.Err = fmt.Errorf("leaves rebuild to %x, bundle says %x", root, []byte(b.RootHash))
+		return r
+	}
+	r.Note = fmt.Sprintf("%d leaves, tree_alg %d", len(leaves), alg)
+	return r
+}
+
+func checkProofs(b *Bundle, alg crypto.TreeAlg) Result {
+	r := Result{Check: "proofs"}
+	items := make(map[string]Item, len(b.Items))
+	for _, it := range b.Items {
+		items[it.ProviderEventID] = it
+	}
+	for _, p := range b.Proofs {
+		it, ok := items[p.ProviderEventID]
+		if !ok {
+			r.Err = fmt.Errorf("%s: proof for unknown item", p.ProviderEventID)
+			return r
+		}
+		if int(p.LeafIndex) >= len(b.Leaves) || !bytes.Equal(b.Leaves[p.LeafIndex], it.ItemHash) {
+			r.Err = fmt.Errorf("%s: leaf %d is not this item", p.ProviderEventID, p.LeafIndex)
+			return r
+		}
+		path := make([]crypto.ProofStep, len(p.Path))
+		for i, st := range p.Path {
+			path[i] = crypto.ProofStep{Sibling: st.Sibling, SiblingIsLeft: st.SiblingIsLeft}
+		}
+		if !crypto.VerifyProof(alg, it.ItemHash, path, b.RootHash) {
+			r.Err = fmt.Errorf("%s: inclusion proof does not reach the root", p.ProviderEventID)
+			return r
+		}
+	}
+	r.Note = fmt.Sprintf("%d inclusion proofs", len(b.Proofs))
+	return r
+}
+
+// checkAnchor checks the anchor record is complete for the bucket's status and
+// that its CID is the manifest of these leaves. It does not contact a chain;
+// a tsa anchor's token is verified offline (see checkToken).
+func checkAnchor(b *Bundle, alg crypto.TreeAlg, tsaRoots *x509.CertPool) Result {
+	r := Result{Check: "anchor"}
+	a := b.Anchor
+	if a.Status != "anchored" {
+		r.Note = fmt.Sprintf("not anchored (status %q)", a.Status)
+		return r
+	}
+	switch {
+	case a.CID == "":
+		r.Err = errors.New("anchored bucket has no cid")
+	case a.AnchoredTx == "":
+		r.Err = errors.New("anchored bucket has no anchored_tx")
+	case a.AnchoredAt == nil:
+		r.Err = errors.New("anchored bucket has no anchored_at")
+	case a.ClosedAt != nil && a.AnchoredAt.Before(*a.ClosedAt):
+		r.Err = errors.New("anchored_at is before closed_at")
+	case strings.HasPrefix(a.CID, "devcid-"):
+		r.Note = fmt.Sprintf("dev cid %s not checked, tx %s", a.CID, a.AnchoredTx)
+	default:
+		c, err := ManifestCID(b, alg)
+		if err != nil {
+			r.Err = err
+			return r
+		}
+		if c != a.CID {
+			r.Err = fmt.Errorf("cid %s, but the manifest of these leaves is %s", a.CID, c)
+			return r
+		}
+		r.Note = fmt.Sprintf("cid %s, tx %s", a.CID, a.AnchoredTx)
+	}
+	if r.Err == nil && strings.HasPrefix(a.AnchoredTx, "tsa:") {
+		var at string
+		if at, r.Err = checkToken(b, tsaRoots); r.Err == nil {
+			r.Note += ", timestamped " + at
+		}
+	}
+	return r
+}
+
+// checkToken verifies a tsa anchor's RFC 3161 token: signed by a TSA that
+// chains to roots, named by anchored_tx ("tsa:<serial hex>"), and
+// timestamping the root the anchor committed, the epoch root for a bucket
+// anchored through an epoch. It returns the token's time (RFC3339).
+func checkToken(b *Bundle, roots *x509.CertPool) (string, error) {
+	a := b.Anchor
+	token, root := a.Proof, b.RootHash
+	if e := a.Epoch; e != nil && e.AnchoredTx == a.AnchoredTx {
+		token, root = e.Proof, e.RootHash
+	}
+	if len(token) == 0 {
+		return "", errors.New("tsa anchor has no token")
+	}
+	info, err := rfc3161.Verify(token, roots)
+	if err != nil {
+		return "", err
+	}
+	switch {
+	case "tsa:"+info.Serial.Text(16) != a.AnchoredTx:
+		return "", fmt.Errorf("token is tsa:%s, not %s", info.Serial.Text(16), a.AnchoredTx)
+	case !bytes.Equal(info.Digest, root):
+		return "", fmt.Errorf("token timestamps %x, not root %x", info.Digest, []byte(root))
+	}
+	return info.GenTime.UTC().Format(time.RFC3339), nil
+}
+
+// checkEpoch checks the bucket root is a leaf of the epoch it was anchored
+// through. The epoch CID links every member bucket, so it is not rebuilt here.
+func checkEpoch(b *Bundle, alg crypto.TreeAlg) Result {
+	r := Result{Check: "epoch"}
+	e := b.Anchor.Epoch
+	if e == nil {
+		r.Note = "not in an epoch"
+		return r
+	}
+	leaf := crypto.EpochLeaf(b.EntityKind, b.EntityKey, b.BucketKey, alg, b.LeafCount, b.RootHash)
+	path := make([]crypto.ProofStep, len(e.Path))
+	for i, st := range e.Path {
+		path[i] = crypto.ProofStep{Sibling: st.Sibling, SiblingIsLeft: st.SiblingIsLeft}
+	}
+	switch {
+	case !crypto.VerifyProof(crypto.EpochTreeAlg, leaf, path, e.RootHash):
+		r.Err = fmt.Errorf("bucket root is not leaf %d of epoch %d", e.LeafIndex, e.ID)
+	case b.Anchor.Status == "anchored" && e.AnchoredTx != b.Anchor.AnchoredTx:
+		r.Err = fmt.Errorf("bucket anchored_tx %s, epoch %d says %s", b.Anchor.AnchoredTx, e.ID, e.AnchoredTx)
+	default:
+		r.Note = fmt.Sprintf("leaf %d of epoch %d (%s), root %x", e.LeafIndex, e.ID, e.Period, []byte(e.RootHash))
+	}
+	return r
+}
+
+// checkChain checks the last leaf of a chained bucket is its chain link, so the
+// root commits to prev_root. Whether prev_root is really the previous bucket's
+// root takes that bundle too; see VerifyChain.
+func checkChain(b *Bundle) Result {
+	r := Result{Check: "chain"}
+	link := b.chainLink()
+	if link == nil {
+		r.Note = "not chained (open, or sealed before chaining)"
+		return r
+	}
+	if len(b.Leaves) == 0 || !bytes.Equal(b.Leaves[len(b.Leaves)-1], crypto.ChainLeaf(b.EntityKind, b.EntityKey, *link)) {
+		r.Err = fmt.Errorf("last leaf is not chain link %d", link.Seq)
+		return r
+	}
+	if len(b.PrevRoot) == 0 {
+		r.Note = fmt.Sprintf("link %d, first of the chain", link.Seq)
+	} else {
+		r.Note = fmt.Sprintf("link %d, prev_root %x", link.Seq, []byte(b.PrevRoot))
+	}
+	return r
+}
+
+// VerifyChain checks bundles of one scope form an unbroken run of its bucket
+// chain: chain_seq increases by one and each prev_root is the root of the
+// bundle before. Unchained bundles are ignored. A run that does not start at
+// link 1 is reported in the note, as older links were not supplied.
+func VerifyChain(bundles []*Bundle) Result {
+	r := Result{Check: "chain_history"}
+	var links []*Bundle
+	for _, b := range bundles {
+		if b.ChainSeq == 0 {
+			continue
+		}
+		if b.EntityKind != bundles[0].EntityKind || b.EntityKey != bundles[0].EntityKey {
+			r.Err = fmt.Errorf("bundles of %s/%s and %s/%s do not share a chain", bundles[0].EntityKind, bundles[0].EntityKey, b.EntityKind, b.EntityKey)
+			return r
+		}
+		links = append(links, b)
+	}
+	if len(links) == 0 {
+		r.Note = "no chained buckets"
+		return r
+	}
+	sort.Slice(links, func(i, j int) bool { return links[i].ChainSeq < links[j].ChainSeq })
+	if links[0].ChainSeq == 1 && len(links[0].PrevRoot) != 0 {
+		r.Err = fmt.Errorf("link 1 (%s) has a prev_root", links[0].BucketKey)
+		return r
+	}
+	for i := 1; i < len(links); i++ {
+		prev, cur := links[i-1], links[i]
+		switch {
+		case cur.ChainSeq == prev.ChainSeq:
+			r.Err = fmt.Errorf("%s and %s are both link %d", prev.BucketKey, cur.BucketKey, cur.ChainSeq)
+			return r
+		case cur.ChainSeq != prev.ChainSeq+1:
+			r.Err = fmt.Errorf("links %d..%d missing between %s and %s", prev.ChainSeq+1, cur.ChainSeq-1, prev.BucketKey, cur.BucketKey)
+			return r
+		case !bytes.Equal(cur.PrevRoot, prev.RootHash):
+			r.Err = fmt.Errorf("link %d (%s) does not follow %s: prev_root %x, root %x", cur.ChainSeq, cur.BucketKey, prev.BucketKey, []byte(cur.PrevRoot), []byte(prev.RootHash))
+			return r
+		}
+	}
+	first, last := links[0].ChainSeq, links[len(links)-1].ChainSeq
+	r.Note = fmt.Sprintf("links %d..%d unbroken", first, last)
+	if first != 1 {
+		r.Note += fmt.Sprintf(" (links before %d not supplied)", first)
+	}
+	return r
+}
+
+// ManifestCID recomputes the CID of the bucket manifest PackBucket anchors.
+func ManifestCID(b *Bundle, alg crypto.TreeAlg) (string, error) {
+	leaves := make([][]byte, len(b.Leaves))
+	for i, l := range b.Leaves {
+		leaves[i] = l
+	}
+	m, err := crypto.NewBucketManifest(b.EntityKind, b.EntityKey, b.BucketKey, alg, b.RootHash, leaves, b.chainLink(), crypto.LeafOrder(b.LeafOrder))
+	if err != nil {
+		return "", err
+	}
+	_, c, err := crypto.EncodeManifest(m)
+	if err != nil {
+		return "", err
+	}
+	return c.String(), nil
+}
@@ -0,0 +1,271 @@
+package evidence
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gusplusbus/trustflow/data_server/internal/service/crypto"
+	"github.com/gusplusbus/trustflow/data_server/rfc3161"
+	"github.com/gusplusbus/trustflow/data_server/rfc3161/tsatest"
+)
+
+func testBundle(t *testing.T) *Bundle {
+	t.Helper()
+	b := &Bundle{
+		Format:     Format,
+		EntityKind: "issue",
+		EntityKey:  "gh#1",
+		BucketKey:  "2025-08-22",
+		TreeAlg:    uint8(crypto.TreeAlgRFC6962),
+		Anchor:     Anchor{Status: "open"},
+	}
+	for i, typ := range []string{"IssueComment", "LabeledEvent", "ClosedEvent"} {
+		it := Item{
+			Provider:        "github",
+			ProviderEventID: typ,
+			Type:            typ,
+			CreatedAt:       time.Date(2025, 8, 22, 10, i, 0, 0, time.UTC),
+			Payload:         json.RawMessage(`{"n":1,"body":"hi"}`),
+			CanonVersion:    uint8(crypto.DefaultCanon),
+		}
+		canon, err := it.Canon()
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, h, err := crypto.HashDAGCBOR(canon)
+		if err != nil {
+			t.Fatal(err)
+		}
+		it.ItemHash = h
+		b.Items = append(b.Items, it)
+		b.Leaves = append(b.Leaves, h)
+	}
+	rebuild(b)
+	return b
+}
+
+// rebuild recomputes the root and item proofs from b.Leaves.
+func rebuild(b *Bundle) {
+	leaves := make([][]byte, len(b.Leaves))
+	for i, l := range b.Leaves {
+		leaves[i] = l
+	}
+	b.LeafCount = uint32(len(leaves))
+	b.RootHash = crypto.BuildMerkleRoot(crypto.TreeAlgRFC6962, leaves)
+	b.Proofs = nil
+	for i, it := range b.Items {
+		_, path, _ := crypto.BuildProof(crypto.TreeAlgRFC6962, leaves, i)
+		p := Proof{ProviderEventID: it.ProviderEventID, LeafIndex: uint32(i)}
+		for _, st := range path {
+			p.Path = append(p.Path, Step{Sibling: st.Sibling, SiblingIsLeft: st.SiblingIsLeft})
+		}
+		b.Proofs = append(b.Proofs, p)
+	}
+}
+
+// seal makes b link seq of its scope's chain, following prev.
+func seal(b *Bundle, seq uint32, prev []byte) *Bundle {
+	b.ChainSeq, b.PrevRoot = seq, prev
+	b.Leaves = append(b.Leaves, crypto.ChainLeaf(b.EntityKind, b.EntityKey, crypto.ChainLink{Seq: seq, PrevRoot: prev}))
+	rebuild(b)
+	return b
+}
+
+func anchoredRecord(t *testing.T, b *Bundle) Anchor {
+	t.Helper()
+	c, err := ManifestCID(b, crypto.TreeAlg(b.TreeAlg))
+	if err != nil {
+		t.Fatal(err)
+	}
+	closed := time.Date(2025, 8, 23, 0, 5, 0, 0, time.UTC)
+	at := closed.Add(time.Minute)
+	return Anchor{Status: "anchored", CID: c, AnchoredTx: "0xabc", ClosedAt: &closed, AnchoredAt: &at}
+}
+
+// epochRecord rolls the bucket into a three-bucket epoch at leaf 1.
+func epochRecord(t *testing.T, b *Bundle) *Epoch {
+	t.Helper()
+	entries := [][]byte{
+		crypto.EpochLeaf("issue", "gh#0", "2025-08-22", crypto.TreeAlgRFC6962, 1, make([]byte, 32)),
+		crypto.EpochLeaf(b.EntityKind, b.EntityKey, b.BucketKey, crypto.TreeAlg(b.TreeAlg), b.LeafCount, b.RootHash),
+		crypto.EpochLeaf("issue", "gh#2", "2025-08-22", crypto.TreeAlgRFC6962, 1, make([]byte, 32)),
+	}
+	_, path, root := crypto.BuildProof(crypto.EpochTreeAlg, entries, 1)
+	e := &Epoch{ID: 7, Period: "2025-08-23", RootHash: root, LeafIndex: 1, Status: "anchored", AnchoredTx: b.Anchor.AnchoredTx}
+	for _, st := range path {
+		e.Path = append(e.Path, Step{Sibling: st.Sibling, SiblingIsLeft: st.SiblingIsLeft})
+	}
+	return e
+}
+
+// roundTrip sends the bundle through JSON the way trustflow-verify reads it.
+func roundTrip(t *testing.T, b *Bundle) *Bundle {
+	t.Helper()
+	raw, err := json.Marshal(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out Bundle
+	if err := json.Unmarshal(raw, &out); err != nil {
+		t.Fatal(err)
+	}
+	return &out
+}
+
+func TestVerifyBundle(t *testing.T) {
+	if rs := Verify(roundTrip(t, testBundle(t)), nil); !OK(rs) {
+		t.Fatalf("Verify() = %+v, want all ok", rs)
+	}
+	anchored := testBundle(t)
+	anchored.Anchor = anchoredRecord(t, anchored)
+	if rs := Verify(roundTrip(t, anchored), nil); !OK(rs) {
+		t.Fatalf("Verify(anchored) = %+v, want all ok", rs)
+	}
+	anchored.Anchor.Epoch = epochRecord(t, anchored)
+	if rs := Verify(roundTrip(t, anchored), nil); !OK(rs) {
+		t.Fatalf("Verify(epoch) = %+v, want all ok", rs)
+	}
+	chained := seal(testBundle(t), 3, make([]byte, 32))
+	chained.LeafOrder = uint8(crypto.LeafCanonical)
+	chained.Anchor = anchoredRecord(t, chained)
+	if rs := Verify(roundTrip(t, chained), nil); !OK(rs) {
+		t.Fatalf("Verify(chained) = %+v, want all ok", rs)
+	}
+
+	tamper := map[string]func(b *Bundle){
+		"payload":  func(b *Bundle) { b.Items[1].Payload = json.RawMessage(`{"n":2,"body":"hi"}`) },
+		"root":     func(b *Bundle) { b.RootHash[0] ^= 1 },
+		"leaf":     func(b *Bundle) { b.Leaves = b.Leaves[:2]; b.LeafCount = 2 },
+		"proof":    func(b *Bundle) { b.Proofs[0].Path[0].SiblingIsLeft = !b.Proofs[0].Path[0].SiblingIsLeft },
+		"tree_alg": func(b *Bundle) { b.TreeAlg = uint8(crypto.TreeAlgLegacy) },
+		"anchor":   func(b *Bundle) { b.Anchor = Anchor{Status: "anchored"} },
+		"cid": func(b *Bundle) {
+			b.Anchor = anchoredRecord(t, b)
+			b.Anchor.CID = "bafyreibnoelefnzgwbcacyt4vh52ymxvzbjq7mmqhtcnwarfq4lzegsiqe"
+		},
+		"chain": func(b *Bundle) {
+			seal(b, 3, make([]byte, 32))
+			b.PrevRoot = b.RootHash
+		},
+		"unchained": func(b *Bundle) {
+			seal(b, 3, make([]byte, 32))
+			b.ChainSeq, b.PrevRoot = 0, nil
+		},
+		"order": func(b *Bundle) {
+			b.LeafOrder = uint8(crypto.LeafCanonical)
+			b.Items[0], b.Items[1] = b.Items[1], b.Items[0]
+			b.Leaves[0], b.Leaves[1] = b.Leaves[1], b.Leaves[0]
+			rebuild(b)
+		},
+		"epoch": func(b *Bundle) {
+			b.Anchor.Epoch = epochRecord(t, b)
+			b.Anchor.Epoch.LeafIndex = 0
+			b.Anchor.Epoch.Path[0].SiblingIsLeft = !b.Anchor.Epoch.Path[0].SiblingIsLeft
+		},
+	}
+	for name, f := range tamper {
+		b := testBundle(t)
+		f(b)
+		if OK(Verify(roundTrip(t, b), nil)) {
+			t.Errorf("tampered %s: Verify() passed", name)
+		}
+	}
+}
+
+func TestVerifyTSAAnchor(t *testing.T) {
+	stub := tsatest.New()
+	defer stub.Close()
+	// stamp makes b's anchor a tsa token over root, named as the ledger names it
+	stamp := func(b *Bundle, root []byte) []byte {
+		token, err := stub.Token(root)
+		if err != nil {
+			t.Fatal(err)
+		}
+		info, err := rfc3161.Verify(token, stub.Roots())
+		if err != nil {
+			t.Fatal(err)
+		}
+		b.Anchor.AnchoredTx = "tsa:" + info.Serial.Text(16)
+		return token
+	}
+	anchor := func() *Bundle {
+		b := testBundle(t)
+		b.Anchor = anchoredRecord(t, b)
+		b.Anchor.Proof = stamp(b, b.RootHash)
+		return b
+	}
+	if rs := Verify(roundTrip(t, anchor()), stub.Roots()); !OK(rs) {
+		t.Fatalf("Verify(tsa) = %+v, want all ok", rs)
+	}
+	epoch := testBundle(t)
+	epoch.Anchor = anchoredRecord(t, epoch)
+	epoch.Anchor.Epoch = epochRecord(t, epoch)
+	epoch.Anchor.Epoch.Proof = stamp(epoch, epoch.Anchor.Epoch.RootHash)
+	epoch.Anchor.Epoch.AnchoredTx = epoch.Anchor.AnchoredTx
+	if rs := Verify(roundTrip(t, epoch), stub.Roots()); !OK(rs) {
+		t.Fatalf("Verify(tsa epoch) = %+v, want all ok", rs)
+	}
+
+	other := tsatest.New()
+	defer other.Close()
+	for name, tc := range map[string]struct {
+		f     func(b *Bundle)
+		roots *x509.CertPool
+	}{
+		"untrusted ca": {func(*Bundle) {}, other.Roots()},
+		"no token":     {func(b *Bundle) { b.Anchor.Proof = nil }, stub.Roots()},
+		"other root":   {func(b *Bundle) { b.Anchor.Proof = stamp(b, make([]byte, 32)) }, stub.Roots()},
+		"other token":  {func(b *Bundle) { b.Anchor.AnchoredTx = "tsa:ff" }, stub.Roots()},
+		"signature": {func(b *Bundle) {
+			i := bytes.Index(b.Anchor.Proof, b.RootHash)
+			b.Anchor.Proof = bytes.Clone(b.Anchor.Proof)
+			b.Anchor.Proof[i] ^= 1
+		}, stub.Roots()},
+	} {
+		b := anchor()
+		tc.f(b)
+		if rs := Verify(roundTrip(t, b), tc.roots); OK(rs) {
+			t.Errorf("%s: Verify() passed", name)
+		}
+	}
+}
+
+func TestVerifyChain(t *testing.T) {
+	link := func(key string, seq uint32, prev *Bundle) *Bundle {
+		b := testBundle(t)
+		b.BucketKey = key
+		var root []byte
+		if prev != nil {
+			root = prev.RootHash
+		}
+		return seal(b, seq, root)
+	}
+	b1 := link("2025-08-22", 1, nil)
+	b2 := link("2025-08-23", 2, b1)
+	b3 := link("2025-08-24", 3, b2)
+	open := testBundle(t)
+	open.BucketKey = "2025-08-25"
+
+	if r := VerifyChain([]*Bundle{b3, open, b1, b2}); r.Err != nil {
+		t.Fatalf("VerifyChain(all) = %v", r.Err)
+	}
+	if r := VerifyChain([]*Bundle{b2, b3}); r.Err != nil || !strings.Contains(r.Note, "not supplied") {
+		t.Fatalf("VerifyChain(tail) = %v, %q", r.Err, r.Note)
+	}
+
+	spliced := link("2025-08-24", 2, b1) // b2 dropped and b3 relinked past it
+	for name, bs := range map[string][]*Bundle{
+		"missing":   {b1, b3},
+		"duplicate": {b1, b2, spliced},
+		"reordered": {b1, link("2025-08-24", 2, b2)},
+		"genesis":   {link("2025-08-21", 1, b3), b2},
+	} {
+		if r := VerifyChain(bs); r.Err == nil {
+			t.Errorf("%s: VerifyChain() passed", name)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package grpcserver
 
 import (
 	"context"
+	"encoding/json"
 
 	bucketv1 "github.com/gusplusbus/trustflow/data_server/gen/bucketv1"
 	"github.com/gusplusbus/trustflow/data_server/internal/service"
@@ -36,29 +37,152 @@ func (s *BucketServer) GetBucket(ctx context.Context, req *bucketv1.GetBucketReq
 	if err != nil {
 		return nil, err
 	}
-	return &bucketv1.GetBucketResponse{Bucket: b.ToProto()}, nil
+	ep, err := s.svc.BucketEpochProof(ctx, b)
+	if err != nil {
+		return nil, err
+	}
+	return &bucketv1.GetBucketResponse{Bucket: b.ToProto(), Epoch: ep}, nil
 }
 
 func (s *BucketServer) InclusionProof(ctx context.Context, req *bucketv1.InclusionProofRequest) (*bucketv1.InclusionProofResponse, error) {
 	return s.svc.InclusionProof(ctx, *req.GetRef(), req.GetProviderEventId())
 }
 
+func (s *BucketServer) ConsistencyProof(ctx context.Context, req *bucketv1.ConsistencyProofRequest) (*bucketv1.ConsistencyProofResponse, error) {
+	return s.svc.ConsistencyProof(ctx, *req.GetRef(), req.GetOldSize(), req.GetNewSize())
+}
+
+func (s *BucketServer) ExportBucket(ctx context.Context, req *bucketv1.ExportBucketRequest) (*bucketv1.ExportBucketResponse, error) {
+	b, err := s.svc.ExportBucket(ctx, *req.GetRef())
+	if err != nil {
+		return nil, err
+	}
+	raw, err := json.MarshalIndent(b, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return &bucketv1.ExportBucketResponse{BundleJson: raw}, nil
+}
+
+func (s *BucketServer) CompletenessProof(ctx context.Context, req *bucketv1.CompletenessProofRequest) (*bucketv1.CompletenessProofResponse, error) {
+	c, err := s.svc.CompletenessProof(ctx, *req.GetScope(), req.GetFromSeq(), req.GetToSeq())
+	if err != nil {
+		return nil, err
+	}
+	raw, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return &bucketv1.CompletenessProofResponse{ProofJson: raw}, nil
+}
+
+func (s *BucketServer) PackBucket(ctx context.Context, req *bucketv1.PackBucketRequest) (*bucketv1.PackBucketResponse, error) {
+	return s.svc.PackBucket(ctx, *req.GetRef())
+}
+
+func (s *BucketServer) AddBucketCheckpoint(ctx context.Context, req *bucketv1.AddBucketCheckpointRequest) (*bucketv1.AddBucketCheckpointResponse, error) {
+	if err := s.svc.AddCheckpoint(ctx, *req.GetRef(), req.GetCheckpoint()); err != nil {
+		return nil, err
+	}
+	return &bucketv1.AddBucketCheckpointResponse{}, nil
+}
+
+func (s *BucketServer) SetBucketPolicy(ctx context.Context, req *bucketv1.SetBucketPolicyRequest) (*bucketv1.SetBucketPolicyResponse, error) {
+	p, err := s.svc.SetPolicy(ctx, *req.GetScope(), req.GetPolicy())
+	if err != nil {
+		return nil, err
+	}
+	return &bucketv1.SetBucketPolicyResponse{Policy: p}, nil
+}
+
+func (s *BucketServer) GetScopeProject(ctx context.Context, req *bucketv1.GetScopeProjectRequest) (*bucketv1.GetScopeProjectResponse, error) {
+	p, err := s.svc.ScopeProject(ctx, *req.GetScope())
+	if err != nil {
+		return nil, err
+	}
+	return &bucketv1.GetScopeProjectResponse{ProjectId: p}, nil
+}
+
 func (s *BucketServer) MarkBucketClosed(ctx context.Context, req *bucketv1.MarkBucketClosedRequest) (*bucketv1.MarkBucketClosedResponse, error) {
-	b, err := s.svc.MarkBucketClosed(ctx, *req.GetRef())
+	b, err := s.svc.MarkBucketClosed(ctx, *req.GetRef(), req.GetActor(), req.GetReason())
 	if err != nil {
 		return nil, err
 	}
 	return &bucketv1.MarkBucketClosedResponse{Bucket: b.ToProto()}, nil
 }
 
+func (s *BucketServer) SetBucketAnchorPending(ctx context.Context, req *bucketv1.SetBucketAnchorPendingRequest) (*bucketv1.SetBucketAnchorPendingResponse, error) {
+	b, err := s.svc.SetBucketAnchorPending(ctx, *req.GetRef(), req.GetCid(), req.GetAnchoredTx(), req.GetAnchorProof(), req.GetActor())
+	if err != nil {
+		return nil, err
+	}
+	return &bucketv1.SetBucketAnchorPendingResponse{Bucket: b.ToProto()}, nil
+}
+
 func (s *BucketServer) SetBucketAnchored(ctx context.Context, req *bucketv1.SetBucketAnchoredRequest) (*bucketv1.SetBucketAnchoredResponse, error) {
-	b, err := s.svc.SetBucketAnchored(ctx, *req.GetRef(), req.GetCid(), req.GetAnchoredTx())
+	b, err := s.svc.SetBucketAnchored(ctx, *req.GetRef(), req.GetAnchoredTx(), req.GetBlockNumber(), req.GetBlockHash(), req.GetActor())
 	if err != nil {
 		return nil, err
 	}
 	return &bucketv1.SetBucketAnchoredResponse{Bucket: b.ToProto()}, nil
 }
 
+func (s *BucketServer) SetBucketStatus(ctx context.Context, req *bucketv1.SetBucketStatusRequest) (*bucketv1.SetBucketStatusResponse, error) {
+	b, err := s.svc.SetBucketStatus(ctx, *req.GetRef(), req.GetStatus(), req.GetActor(), req.GetReason())
+	if err != nil {
+		return nil, err
+	}
+	return &bucketv1.SetBucketStatusResponse{Bucket: b.ToProto()}, nil
+}
+
+func (s *BucketServer) ListBucketHistory(ctx context.Context, req *bucketv1.ListBucketHistoryRequest) (*bucketv1.ListBucketHistoryResponse, error) {
+	events, err := s.svc.ListBucketHistory(ctx, *req.GetRef())
+	if err != nil {
+		return nil, err
+	}
+	return &bucketv1.ListBucketHistoryResponse{Events: events}, nil
+}
+
 func (s *BucketServer) ListBucketsByStatus(ctx context.Context, req *bucketv1.ListBucketsByStatusRequest) (*bucketv1.ListBucketsByStatusResponse, error) {
     return s.svc.ListByStatus(ctx, req.GetStatus(), req.GetLimit(), req.GetPageToken())
 }
+
+func (s *BucketServer) SealEpoch(ctx context.Context, req *bucketv1.SealEpochRequest) (*bucketv1.SealEpochResponse, error) {
+	e, err := s.svc.SealEpoch(ctx, req.GetPeriod(), req.GetRefs())
+	if err != nil {
+		return nil, err
+	}
+	return &bucketv1.SealEpochResponse{Epoch: e}, nil
+}
+
+func (s *BucketServer) GetEpoch(ctx context.Context, req *bucketv1.GetEpochRequest) (*bucketv1.GetEpochResponse, error) {
+	e, err := s.svc.GetEpoch(ctx, int64(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	return &bucketv1.GetEpochResponse{Epoch: e}, nil
+}
+
+func (s *BucketServer) ListEpochsByStatus(ctx context.Context, req *bucketv1.ListEpochsByStatusRequest) (*bucketv1.ListEpochsByStatusResponse, error) {
+	return s.svc.ListEpochsByStatus(ctx, req.GetStatus(), req.GetLimit(), req.GetPageToken())
+}
+
+func (s *BucketServer) SetEpochAnchorPending(ctx context.Context, req *bucketv1.SetEpochAnchorPendingRequest) (*bucketv1.SetEpochAnchorPendingResponse, error) {
+	e, err := s.svc.SetEpochAnchorPending(ctx, int64(req.GetId()), req.GetAnchoredTx(), req.GetAnchorProof(), req.GetActor())
+	if err != nil {
+		return nil, err
+	}
+	return &bucketv1.SetEpochAnchorPendingResponse{Epoch: e}, nil
+}
+
+func (s *BucketServer) AuditBucket(ctx context.Context, req *bucketv1.AuditBucketRequest) (*bucketv1.AuditBucketResponse, error) {
+	return s.svc.AuditBucket(ctx, *req.GetRef())
+}
+
+func (s *BucketServer) ListAuditFindings(ctx context.Context, req *bucketv1.ListAuditFindingsRequest) (*bucketv1.ListAuditFindingsResponse, error) {
+	var scope bucketv1.Scope
+	if sc := req.GetScope(); sc != nil {
+		scope = bucketv1.Scope{EntityKind: sc.GetEntityKind(), EntityKey: sc.GetEntityKey()}
+	}
+	return s.svc.ListAuditFindings(ctx, scope, req.GetIncludeResolved(), req.GetLimit(), req.GetPageToken())
+}
@@ -0,0 +1,78 @@
+package postgres
+
+import (
+	"context"
+	"time"
+)
+
+// AuditFindingRow is one failing integrity check of a bucket.
+type AuditFindingRow struct {
+	ID          int64
+	EntityKind  string
+	EntityKey   string
+	BucketKey   string
+	Check       string
+	Detail      string
+	FirstSeenAt time.Time
+	LastSeenAt  time.Time
+	ResolvedAt  *time.Time
+}
+
+// BucketKeyRow names a bucket.
+type BucketKeyRow struct {
+	EntityKind string
+	EntityKey  string
+	BucketKey  string
+}
+
+// SelectAuditDue returns up to limit buckets, least recently audited first.
+func (r *BucketRepo) SelectAuditDue(ctx context.Context, limit int32) ([]BucketKeyRow, error) {
+	rows, err := r.db.Query(ctx, r.q["tl_select_audit_due.sql"], limit)
+	if err != nil { return nil, err }
+	defer rows.Close()
+	var out []BucketKeyRow
+	for rows.Next() {
+		var b BucketKeyRow
+		if err := rows.Scan(&b.EntityKind, &b.EntityKey, &b.BucketKey); err != nil { return nil, err }
+		out = append(out, b)
+	}
+	return out, rows.Err()
+}
+
+func (r *BucketRepo) MarkAudited(ctx context.Context,
+	entityKind, entityKey, bucketKey string,
+) error {
+	_, err := r.db.Exec(ctx, r.q["tl_mark_audited.sql"], entityKind, entityKey, bucketKey)
+	return err
+}
+
+func (r *BucketRepo) UpsertAuditFinding(ctx context.Context,
+	entityKind, entityKey, bucketKey, check, detail string,
+) error {
+	_, err := r.db.Exec(ctx, r.q["tl_upsert_audit_finding.sql"], entityKind, entityKey, bucketKey, check, detail)
+	return err
+}
+
+// ResolveAuditFindings closes the bucket's open findings for checks that passed.
+func (r *BucketRepo) ResolveAuditFindings(ctx context.Context,
+	entityKind, entityKey, bucketKey string, passed []string,
+) error {
+	_, err := r.db.Exec(ctx, r.q["tl_resolve_audit_findings.sql"], entityKind, entityKey, bucketKey, passed)
+	return err
+}
+
+func (r *BucketRepo) ListAuditFindings(ctx context.Context,
+	entityKind, entityKey string, includeResolved bool, limit, offset int32,
+) ([]AuditFindingRow, error) {
+	rows, err := r.db.Query(ctx, r.q["tl_list_audit_findings.sql"], entityKind, entityKey, includeResolved, limit, offset)
+	if err != nil { return nil, err }
+	defer rows.Close()
+	var out []AuditFindingRow
+	for rows.Next() {
+		var f AuditFindingRow
+		if err := rows.Scan(&f.ID, &f.EntityKind, &f.EntityKey, &f.BucketKey, &f.Check, &f.Detail,
+			&f.FirstSeenAt, &f.LastSeenAt, &f.ResolvedAt); err != nil { return nil, err }
+		out = append(out, f)
+	}
+	return out, rows.Err()
+}
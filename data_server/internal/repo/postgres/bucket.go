@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"embed"
+	"errors"
 	"fmt"
 	"time"
 
@@ -29,6 +30,59 @@ func LoadEmbeddedQueries() map[string]string {
 		"tl_mark_bucket_closed.sql",
 		"tl_set_bucket_anchored.sql",
 		"tl_get_item_by_provider_event.sql",
+		"tl_ensure_bucket.sql",
+		"tl_lock_bucket_frontier.sql",
+		"tl_get_bucket_frontier.sql",
+		"tl_save_bucket_frontier.sql",
+		"tl_insert_node.sql",
+		"tl_get_leaf_index.sql",
+		"tl_select_proof_nodes.sql",
+		"tl_insert_bucket_root.sql",
+		"tl_get_bucket_root.sql",
+		"tl_select_items_for_bucket.sql",
+		"tl_insert_checkpoint.sql",
+		"tl_latest_checkpoints.sql",
+		"tl_set_bucket_cid.sql",
+		"tl_insert_epoch.sql",
+		"tl_insert_epoch_member.sql",
+		"tl_get_epoch.sql",
+		"tl_list_epochs_by_status.sql",
+		"tl_select_epoch_members.sql",
+		"tl_get_epoch_member.sql",
+		"tl_set_epoch_anchor_pending.sql",
+		"tl_set_epoch_anchored.sql",
+		"tl_fail_bucket_epoch.sql",
+		"tl_get_bucket_policy.sql",
+		"tl_get_issue_project.sql",
+		"tl_set_bucket_policy.sql",
+		"tl_delete_bucket_policy.sql",
+		"tl_lock_scope.sql",
+		"tl_latest_size_bucket.sql",
+		"tl_count_amendments.sql",
+		"tl_ensure_amendment.sql",
+		"tl_move_items.sql",
+		"tl_select_audit_due.sql",
+		"tl_mark_audited.sql",
+		"tl_upsert_audit_finding.sql",
+		"tl_resolve_audit_findings.sql",
+		"tl_list_audit_findings.sql",
+		"tl_chain_head.sql",
+		"tl_select_entity_log.sql",
+		"tl_log_commitment.sql",
+		"tl_lock_entity_seq.sql",
+		"tl_set_entity_seq.sql",
+		"tl_select_seq_anomalies.sql",
+		"tl_renumber_items.sql",
+		"tl_defer_entity_seq.sql",
+		"tl_select_log_commitments.sql",
+		"tl_delete_bucket_tree.sql",
+		"tl_restore_bucket_leaves.sql",
+		"tl_list_all_buckets.sql",
+		"tl_set_bucket_status.sql",
+		"tl_insert_status_event.sql",
+		"tl_list_status_events.sql",
+		"tl_list_idle_buckets.sql",
+		"tl_set_bucket_anchor_pending.sql",
 	}
 	m := make(map[string]string, len(names))
 	for _, n := range names {
@@ -41,6 +95,10 @@ func LoadEmbeddedQueries() map[string]string {
 	return m
 }
 
+// ErrBucketSealed is returned for writes to a bucket that is no longer open:
+// its leaves and root are final (see migration 019 for late arrivals).
+var ErrBucketSealed = errors.New("bucket is sealed")
+
 type BucketRow struct {
 	EntityKind string
 	EntityKey  string
@@ -52,6 +110,17 @@ type BucketRow struct {
 	ClosedAt   *time.Time
 	AnchoredTx *string
 	AnchoredAt *time.Time
+	TreeAlg    int16
+	Policy     string // bucketing policy that keyed it, e.g. "daily", "size:500"
+	Amends     *string // sealed bucket_key this amendment bucket extends
+	PrevRoot   []byte  // root of the scope's previously sealed bucket
+	ChainSeq   *int32  // position in the scope's chain; nil until sealed
+	LogSize    *int32  // scope sequence log size committed at the seal
+	LogRoot    []byte
+	LeafOrder  int16   // crypto.LeafOrder of the item leaves
+	AnchoredBlock     *int64  // block that included anchored_tx; set once confirmed
+	AnchoredBlockHash *string
+	AnchorProof       []byte // e.g. the RFC 3161 token of a tsa anchor
 }
 
 type LeafRow struct {
@@ -59,6 +128,23 @@ type LeafRow struct {
 	LeafHash  []byte
 }
 
+// FrontierRow is the incremental Merkle state of a bucket; Frontier is nil for
+// buckets written before frontiers existed.
+type FrontierRow struct {
+	LeafCount int32
+	Frontier  []byte
+	TreeAlg   int16
+	Policy    string
+	Status    string
+}
+
+// NodeRow is a completed Merkle node; level 0 rows come from the leaves table.
+type NodeRow struct {
+	Level int32
+	Idx   int64
+	Hash  []byte
+}
+
 type ItemLoc struct {
 	EntityKind string
 	EntityKey  string
@@ -67,6 +153,30 @@ type ItemLoc struct {
 	ItemHash   []byte
 }
 
+// ItemRow is a stored timeline item as it was hashed.
+type ItemRow struct {
+	Provider        string
+	ProviderEventID string
+	Type            string
+	Actor           *string
+	CreatedAt       time.Time
+	PayloadJSON     []byte
+	ItemHash        []byte
+	Seq             int64
+	CanonVersion    int16
+}
+
+// CheckpointRow is a ledger signature over a bucket root.
+type CheckpointRow struct {
+	BucketKey string
+	LeafCount int32
+	RootHash  []byte
+	TreeAlg   int16
+	KeyID     string
+	Signature []byte
+	SignedAt  time.Time
+}
+
 type BucketRepo struct {
 	db *pgxpool.Pool
 	q  map[string]string // load embedded SQL like your other repos
@@ -76,24 +186,61 @@ func NewBucketRepo(db *pgxpool.Pool, queries map[string]string) *BucketRepo {
 	return &BucketRepo{db: db, q: queries}
 }
 
+// Begin starts a tx for writes that span several repo calls.
+func (r *BucketRepo) Begin(ctx context.Context) (pgx.Tx, error) {
+	return r.db.Begin(ctx)
+}
+
 // Items (idempotent insert)
 func (r *BucketRepo) InsertItem(ctx context.Context, tx pgx.Tx,
 	entityKind, entityKey, provider, providerEventID, typ string, actor *string,
-	createdAt time.Time, payloadJSON []byte, itemHash []byte, bucketKey string,
+	createdAt time.Time, payloadJSON []byte, itemHash []byte, bucketKey string, canonVersion int16, seq int64,
 ) (bool, error) {
 	var a any
 	if actor == nil { a = nil } else { a = *actor }
 	ct, err := tx.Exec(ctx, r.q["tl_insert_item.sql"],
 		entityKind, entityKey, provider, providerEventID, typ, a,
-		createdAt, payloadJSON, itemHash, bucketKey)
+		createdAt, payloadJSON, itemHash, bucketKey, canonVersion, seq)
 	return ct.RowsAffected() == 1, err
 }
 
+// MoveItems points items inserted in tx at another bucket.
+func (r *BucketRepo) MoveItems(ctx context.Context, tx pgx.Tx,
+	entityKind, entityKey string, providerEventIDs []string, bucketKey string,
+) error {
+	_, err := tx.Exec(ctx, r.q["tl_move_items.sql"], entityKind, entityKey, providerEventIDs, bucketKey)
+	return err
+}
+
 // Leaves
+
+// InsertLeaf appends a leaf to an open bucket; it returns ErrBucketSealed
+// once the bucket has left 'open'.
 func (r *BucketRepo) InsertLeaf(ctx context.Context, tx pgx.Tx,
 	entityKind, entityKey, bucketKey string, leafIndex int32, leafHash []byte,
 ) error {
-	_, err := tx.Exec(ctx, r.q["tl_insert_leaf.sql"], entityKind, entityKey, bucketKey, leafIndex, leafHash)
+	ct, err := tx.Exec(ctx, r.q["tl_insert_leaf.sql"], entityKind, entityKey, bucketKey, leafIndex, leafHash)
+	if err == nil && ct.RowsAffected() == 0 {
+		return fmt.Errorf("%s/%s/%s: %w", entityKind, entityKey, bucketKey, ErrBucketSealed)
+	}
+	return err
+}
+
+// DeleteTree drops a bucket's leaves and nodes, keeping its recorded roots;
+// callers write the replacement tree in the same tx.
+func (r *BucketRepo) DeleteTree(ctx context.Context, tx pgx.Tx,
+	entityKind, entityKey, bucketKey string,
+) error {
+	_, err := tx.Exec(ctx, r.q["tl_delete_bucket_tree.sql"], entityKind, entityKey, bucketKey)
+	return err
+}
+
+// RestoreLeaves writes leaves as leaf rows 0..n-1 whatever the bucket's
+// status, after DeleteTree.
+func (r *BucketRepo) RestoreLeaves(ctx context.Context, tx pgx.Tx,
+	entityKind, entityKey, bucketKey string, leaves [][]byte,
+) error {
+	_, err := tx.Exec(ctx, r.q["tl_restore_bucket_leaves.sql"], entityKind, entityKey, bucketKey, leaves)
 	return err
 }
 
@@ -112,18 +259,39 @@ func (r *BucketRepo) SelectLeaves(ctx context.Context,
 	return out, rows.Err()
 }
 
+func (r *BucketRepo) SelectItems(ctx context.Context,
+	entityKind, entityKey, bucketKey string,
+) ([]ItemRow, error) {
+	rows, err := r.db.Query(ctx, r.q["tl_select_items_for_bucket.sql"], entityKind, entityKey, bucketKey)
+	if err != nil { return nil, err }
+	defer rows.Close()
+	var out []ItemRow
+	for rows.Next() {
+		var it ItemRow
+		if err := rows.Scan(&it.Provider, &it.ProviderEventID, &it.Type, &it.Actor, &it.CreatedAt, &it.PayloadJSON, &it.ItemHash, &it.Seq, &it.CanonVersion); err != nil { return nil, err }
+		out = append(out, it)
+	}
+	return out, rows.Err()
+}
+
 // Buckets
 func (r *BucketRepo) UpsertPerLeaf(ctx context.Context, tx pgx.Tx,
 	entityKind, entityKey, bucketKey string, newRoot []byte,
 ) error {
-	_, err := tx.Exec(ctx, r.q["tl_upsert_bucket_per_leaf.sql"], entityKind, entityKey, bucketKey, newRoot)
+	ct, err := tx.Exec(ctx, r.q["tl_upsert_bucket_per_leaf.sql"], entityKind, entityKey, bucketKey, newRoot)
+	if err == nil && ct.RowsAffected() == 0 {
+		return fmt.Errorf("%s/%s/%s: %w", entityKind, entityKey, bucketKey, ErrBucketSealed)
+	}
 	return err
 }
 
 func (r *BucketRepo) UpsertBatch(ctx context.Context, tx pgx.Tx,
 	entityKind, entityKey, bucketKey string, newRoot []byte, appended int32,
 ) error {
-	_, err := tx.Exec(ctx, r.q["tl_upsert_bucket_batch.sql"], entityKind, entityKey, bucketKey, newRoot, appended)
+	ct, err := tx.Exec(ctx, r.q["tl_upsert_bucket_batch.sql"], entityKind, entityKey, bucketKey, newRoot, appended)
+	if err == nil && ct.RowsAffected() == 0 {
+		return fmt.Errorf("%s/%s/%s: %w", entityKind, entityKey, bucketKey, ErrBucketSealed)
+	}
 	return err
 }
 
@@ -132,7 +300,7 @@ func (r *BucketRepo) GetBucket(ctx context.Context,
 ) (BucketRow, error) {
 	var b BucketRow
 	err := r.db.QueryRow(ctx, r.q["tl_get_bucket.sql"], entityKind, entityKey, bucketKey).
-		Scan(&b.EntityKind, &b.EntityKey, &b.BucketKey, &b.RootHash, &b.LeafCount, &b.Status, &b.CID, &b.ClosedAt, &b.AnchoredTx, &b.AnchoredAt)
+		Scan(&b.EntityKind, &b.EntityKey, &b.BucketKey, &b.RootHash, &b.LeafCount, &b.Status, &b.CID, &b.ClosedAt, &b.AnchoredTx, &b.AnchoredAt, &b.TreeAlg, &b.Policy, &b.Amends, &b.PrevRoot, &b.ChainSeq, &b.LogSize, &b.LogRoot, &b.LeafOrder, &b.AnchoredBlock, &b.AnchoredBlockHash, &b.AnchorProof)
 	return b, err
 }
 
@@ -145,7 +313,7 @@ func (r *BucketRepo) ListByScope(ctx context.Context,
 	var out []BucketRow
 	for rows.Next() {
 		var b BucketRow
-		if err := rows.Scan(&b.EntityKind, &b.EntityKey, &b.BucketKey, &b.RootHash, &b.LeafCount, &b.Status, &b.CID, &b.ClosedAt, &b.AnchoredTx, &b.AnchoredAt); err != nil { return nil, err }
+		if err := rows.Scan(&b.EntityKind, &b.EntityKey, &b.BucketKey, &b.RootHash, &b.LeafCount, &b.Status, &b.CID, &b.ClosedAt, &b.AnchoredTx, &b.AnchoredAt, &b.TreeAlg, &b.Policy, &b.Amends, &b.PrevRoot, &b.ChainSeq, &b.LogSize, &b.LogRoot, &b.LeafOrder, &b.AnchoredBlock, &b.AnchoredBlockHash, &b.AnchorProof); err != nil { return nil, err }
 		out = append(out, b)
 	}
 	return out, rows.Err()
@@ -160,27 +328,76 @@ func (r *BucketRepo) ListByStatus(ctx context.Context,
 	var out []BucketRow
 	for rows.Next() {
 		var b BucketRow
-		if err := rows.Scan(&b.EntityKind, &b.EntityKey, &b.BucketKey, &b.RootHash, &b.LeafCount, &b.Status, &b.CID, &b.ClosedAt, &b.AnchoredTx, &b.AnchoredAt); err != nil { return nil, err }
+		if err := rows.Scan(&b.EntityKind, &b.EntityKey, &b.BucketKey, &b.RootHash, &b.LeafCount, &b.Status, &b.CID, &b.ClosedAt, &b.AnchoredTx, &b.AnchoredAt, &b.TreeAlg, &b.Policy, &b.Amends, &b.PrevRoot, &b.ChainSeq, &b.LogSize, &b.LogRoot, &b.LeafOrder, &b.AnchoredBlock, &b.AnchoredBlockHash, &b.AnchorProof); err != nil { return nil, err }
 		out = append(out, b)
 	}
 	return out, rows.Err()
 }
 
-func (r *BucketRepo) MarkClosed(ctx context.Context,
-	entityKind, entityKey, bucketKey string,
+// ListAll pages through every bucket (or one scope's, when entityKind is
+// set) in key order, starting after the given bucket.
+func (r *BucketRepo) ListAll(ctx context.Context,
+	entityKind, entityKey string, after BucketKeyRow, limit int32,
+) ([]BucketRow, error) {
+	rows, err := r.db.Query(ctx, r.q["tl_list_all_buckets.sql"], entityKind, entityKey, after.EntityKind, after.EntityKey, after.BucketKey, limit)
+	if err != nil { return nil, err }
+	defer rows.Close()
+	var out []BucketRow
+	for rows.Next() {
+		var b BucketRow
+		if err := rows.Scan(&b.EntityKind, &b.EntityKey, &b.BucketKey, &b.RootHash, &b.LeafCount, &b.Status, &b.CID, &b.ClosedAt, &b.AnchoredTx, &b.AnchoredAt, &b.TreeAlg, &b.Policy, &b.Amends, &b.PrevRoot, &b.ChainSeq, &b.LogSize, &b.LogRoot, &b.LeafOrder, &b.AnchoredBlock, &b.AnchoredBlockHash, &b.AnchorProof); err != nil { return nil, err }
+		out = append(out, b)
+	}
+	return out, rows.Err()
+}
+
+// ListIdle lists open buckets whose window ended before the cutoff, given as
+// each windowed policy's key at the cutoff time.
+func (r *BucketRepo) ListIdle(ctx context.Context,
+	hourlyKey, dailyKey, weeklyKey string, limit int32,
+) ([]BucketRow, error) {
+	rows, err := r.db.Query(ctx, r.q["tl_list_idle_buckets.sql"], hourlyKey, dailyKey, weeklyKey, limit)
+	if err != nil { return nil, err }
+	defer rows.Close()
+	var out []BucketRow
+	for rows.Next() {
+		var b BucketRow
+		if err := rows.Scan(&b.EntityKind, &b.EntityKey, &b.BucketKey, &b.RootHash, &b.LeafCount, &b.Status, &b.CID, &b.ClosedAt, &b.AnchoredTx, &b.AnchoredAt, &b.TreeAlg, &b.Policy, &b.Amends, &b.PrevRoot, &b.ChainSeq, &b.LogSize, &b.LogRoot, &b.LeafOrder, &b.AnchoredBlock, &b.AnchoredBlockHash, &b.AnchorProof); err != nil { return nil, err }
+		out = append(out, b)
+	}
+	return out, rows.Err()
+}
+
+// MarkClosed seals an open bucket with its chain link; pgx.ErrNoRows means it
+// was not open. A nil logSize records no sequence log commitment.
+func (r *BucketRepo) MarkClosed(ctx context.Context, tx pgx.Tx,
+	entityKind, entityKey, bucketKey string, prevRoot []byte, chainSeq int32, logSize *int32, logRoot []byte, leafOrder int16,
 ) (BucketRow, error) {
 	var b BucketRow
-	err := r.db.QueryRow(ctx, r.q["tl_mark_bucket_closed.sql"], entityKind, entityKey, bucketKey).
-		Scan(&b.EntityKind, &b.EntityKey, &b.BucketKey, &b.RootHash, &b.LeafCount, &b.Status, &b.CID, &b.ClosedAt, &b.AnchoredTx, &b.AnchoredAt)
+	err := tx.QueryRow(ctx, r.q["tl_mark_bucket_closed.sql"], entityKind, entityKey, bucketKey, prevRoot, chainSeq, logSize, logRoot, leafOrder).
+		Scan(&b.EntityKind, &b.EntityKey, &b.BucketKey, &b.RootHash, &b.LeafCount, &b.Status, &b.CID, &b.ClosedAt, &b.AnchoredTx, &b.AnchoredAt, &b.TreeAlg, &b.Policy, &b.Amends, &b.PrevRoot, &b.ChainSeq, &b.LogSize, &b.LogRoot, &b.LeafOrder, &b.AnchoredBlock, &b.AnchoredBlockHash, &b.AnchorProof)
 	return b, err
 }
 
-func (r *BucketRepo) SetAnchored(ctx context.Context,
-	entityKind, entityKey, bucketKey, cid, anchoredTx string,
+// SetAnchorPending records the anchor sent for an anchoring bucket;
+// pgx.ErrNoRows means it was not anchoring.
+func (r *BucketRepo) SetAnchorPending(ctx context.Context, tx pgx.Tx,
+	entityKind, entityKey, bucketKey, cid, anchoredTx string, proof []byte,
 ) (BucketRow, error) {
 	var b BucketRow
-	err := r.db.QueryRow(ctx, r.q["tl_set_bucket_anchored.sql"], entityKind, entityKey, bucketKey, cid, anchoredTx).
-		Scan(&b.EntityKind, &b.EntityKey, &b.BucketKey, &b.RootHash, &b.LeafCount, &b.Status, &b.CID, &b.ClosedAt, &b.AnchoredTx, &b.AnchoredAt)
+	err := tx.QueryRow(ctx, r.q["tl_set_bucket_anchor_pending.sql"], entityKind, entityKey, bucketKey, cid, anchoredTx, proof).
+		Scan(&b.EntityKind, &b.EntityKey, &b.BucketKey, &b.RootHash, &b.LeafCount, &b.Status, &b.CID, &b.ClosedAt, &b.AnchoredTx, &b.AnchoredAt, &b.TreeAlg, &b.Policy, &b.Amends, &b.PrevRoot, &b.ChainSeq, &b.LogSize, &b.LogRoot, &b.LeafOrder, &b.AnchoredBlock, &b.AnchoredBlockHash, &b.AnchorProof)
+	return b, err
+}
+
+// SetAnchored marks an anchoring_pending bucket whose tx is anchoredTx
+// anchored in block; pgx.ErrNoRows means it is not pending on that tx.
+func (r *BucketRepo) SetAnchored(ctx context.Context, tx pgx.Tx,
+	entityKind, entityKey, bucketKey, anchoredTx string, block int64, blockHash string,
+) (BucketRow, error) {
+	var b BucketRow
+	err := tx.QueryRow(ctx, r.q["tl_set_bucket_anchored.sql"], entityKind, entityKey, bucketKey, anchoredTx, block, blockHash).
+		Scan(&b.EntityKind, &b.EntityKey, &b.BucketKey, &b.RootHash, &b.LeafCount, &b.Status, &b.CID, &b.ClosedAt, &b.AnchoredTx, &b.AnchoredAt, &b.TreeAlg, &b.Policy, &b.Amends, &b.PrevRoot, &b.ChainSeq, &b.LogSize, &b.LogRoot, &b.LeafOrder, &b.AnchoredBlock, &b.AnchoredBlockHash, &b.AnchorProof)
 	return b, err
 }
 
@@ -191,3 +408,185 @@ func (r *BucketRepo) GetItemForProof(ctx context.Context, providerEventID string
 	return v, err
 }
 
+// Frontier (incremental Merkle state)
+
+// EnsureBucket creates an empty open bucket row if missing; treeAlg and
+// policy only apply to a newly created row.
+func (r *BucketRepo) EnsureBucket(ctx context.Context, tx pgx.Tx,
+	entityKind, entityKey, bucketKey string, treeAlg int16, policy string,
+) error {
+	_, err := tx.Exec(ctx, r.q["tl_ensure_bucket.sql"], entityKind, entityKey, bucketKey, treeAlg, policy)
+	return err
+}
+
+// EnsureAmendment creates the open amendment bucket bucketKey of the sealed
+// bucket amends if missing.
+func (r *BucketRepo) EnsureAmendment(ctx context.Context, tx pgx.Tx,
+	entityKind, entityKey, bucketKey string, treeAlg int16, policy, amends string,
+) error {
+	_, err := tx.Exec(ctx, r.q["tl_ensure_amendment.sql"], entityKind, entityKey, bucketKey, treeAlg, policy, amends)
+	return err
+}
+
+func (r *BucketRepo) CountAmendments(ctx context.Context, tx pgx.Tx,
+	entityKind, entityKey, sealedKey string,
+) (int, error) {
+	var n int
+	err := tx.QueryRow(ctx, r.q["tl_count_amendments.sql"], entityKind, entityKey, sealedKey).Scan(&n)
+	return n, err
+}
+
+// LockFrontier row-locks the bucket for the rest of tx and returns its frontier.
+func (r *BucketRepo) LockFrontier(ctx context.Context, tx pgx.Tx,
+	entityKind, entityKey, bucketKey string,
+) (FrontierRow, error) {
+	var f FrontierRow
+	err := tx.QueryRow(ctx, r.q["tl_lock_bucket_frontier.sql"], entityKind, entityKey, bucketKey).
+		Scan(&f.LeafCount, &f.Frontier, &f.TreeAlg, &f.Policy, &f.Status)
+	return f, err
+}
+
+func (r *BucketRepo) GetFrontier(ctx context.Context,
+	entityKind, entityKey, bucketKey string,
+) (FrontierRow, error) {
+	var f FrontierRow
+	err := r.db.QueryRow(ctx, r.q["tl_get_bucket_frontier.sql"], entityKind, entityKey, bucketKey).
+		Scan(&f.LeafCount, &f.Frontier, &f.TreeAlg, &f.Policy, &f.Status)
+	return f, err
+}
+
+func (r *BucketRepo) SaveFrontier(ctx context.Context, tx pgx.Tx,
+	entityKind, entityKey, bucketKey string, root []byte, leafCount int32, frontier []byte,
+) error {
+	ct, err := tx.Exec(ctx, r.q["tl_save_bucket_frontier.sql"], entityKind, entityKey, bucketKey, root, leafCount, frontier)
+	if err == nil && ct.RowsAffected() == 0 {
+		return fmt.Errorf("%s/%s/%s: %w", entityKind, entityKey, bucketKey, ErrBucketSealed)
+	}
+	return err
+}
+
+func (r *BucketRepo) InsertNode(ctx context.Context, tx pgx.Tx,
+	entityKind, entityKey, bucketKey string, level int32, idx int64, hash []byte,
+) error {
+	_, err := tx.Exec(ctx, r.q["tl_insert_node.sql"], entityKind, entityKey, bucketKey, level, idx, hash)
+	return err
+}
+
+func (r *BucketRepo) GetLeafIndex(ctx context.Context,
+	entityKind, entityKey, bucketKey string, leafHash []byte,
+) (int32, error) {
+	var idx int32
+	err := r.db.QueryRow(ctx, r.q["tl_get_leaf_index.sql"], entityKind, entityKey, bucketKey, leafHash).Scan(&idx)
+	return idx, err
+}
+
+// SelectNodes fetches the given (level, idx) nodes; missing ones are simply absent.
+func (r *BucketRepo) SelectNodes(ctx context.Context,
+	entityKind, entityKey, bucketKey string, levels []int32, idxs []int64,
+) ([]NodeRow, error) {
+	rows, err := r.db.Query(ctx, r.q["tl_select_proof_nodes.sql"], entityKind, entityKey, bucketKey, levels, idxs)
+	if err != nil { return nil, err }
+	defer rows.Close()
+	var out []NodeRow
+	for rows.Next() {
+		var n NodeRow
+		if err := rows.Scan(&n.Level, &n.Idx, &n.Hash); err != nil { return nil, err }
+		out = append(out, n)
+	}
+	return out, rows.Err()
+}
+
+// Observed roots (for consistency proofs)
+
+func (r *BucketRepo) InsertRoot(ctx context.Context, tx pgx.Tx,
+	entityKind, entityKey, bucketKey string, leafCount int32, root []byte,
+) error {
+	_, err := tx.Exec(ctx, r.q["tl_insert_bucket_root.sql"], entityKind, entityKey, bucketKey, leafCount, root)
+	return err
+}
+
+func (r *BucketRepo) GetRoot(ctx context.Context,
+	entityKind, entityKey, bucketKey string, leafCount int32,
+) ([]byte, error) {
+	var root []byte
+	err := r.db.QueryRow(ctx, r.q["tl_get_bucket_root.sql"], entityKind, entityKey, bucketKey, leafCount).Scan(&root)
+	return root, err
+}
+
+// Checkpoints
+
+func (r *BucketRepo) InsertCheckpoint(ctx context.Context,
+	entityKind, entityKey string, c CheckpointRow,
+) error {
+	_, err := r.db.Exec(ctx, r.q["tl_insert_checkpoint.sql"],
+		entityKind, entityKey, c.BucketKey, c.LeafCount, c.RootHash, c.TreeAlg, c.KeyID, c.Signature)
+	return err
+}
+
+// LatestCheckpoints returns the newest checkpoint of each bucket key that has one.
+func (r *BucketRepo) LatestCheckpoints(ctx context.Context,
+	entityKind, entityKey string, bucketKeys []string,
+) (map[string]CheckpointRow, error) {
+	rows, err := r.db.Query(ctx, r.q["tl_latest_checkpoints.sql"], entityKind, entityKey, bucketKeys)
+	if err != nil { return nil, err }
+	defer rows.Close()
+	out := map[string]CheckpointRow{}
+	for rows.Next() {
+		var c CheckpointRow
+		if err := rows.Scan(&c.BucketKey, &c.LeafCount, &c.RootHash, &c.TreeAlg, &c.KeyID, &c.Signature, &c.SignedAt); err != nil { return nil, err }
+		out[c.BucketKey] = c
+	}
+	return out, rows.Err()
+}
+
+// Chain
+
+// ChainHead returns the latest link of a scope's bucket chain: its chain_seq
+// and final root, or (0, nil) before the first sealed bucket.
+func (r *BucketRepo) ChainHead(ctx context.Context, tx pgx.Tx,
+	entityKind, entityKey string,
+) (int32, []byte, error) {
+	var seq int32
+	var root []byte
+	err := tx.QueryRow(ctx, r.q["tl_chain_head.sql"], entityKind, entityKey).Scan(&seq, &root)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, nil, nil
+	}
+	return seq, root, err
+}
+
+// Sequence log
+
+// SeqLogRow is one entry of a scope's sequence log.
+type SeqLogRow struct {
+	Seq             int64
+	ItemHash        []byte
+	ProviderEventID string
+	BucketKey       string
+}
+
+// SelectEntityLog returns a scope's items in seq_in_entity order, up to
+// maxSeq (0 = all).
+func (r *BucketRepo) SelectEntityLog(ctx context.Context,
+	entityKind, entityKey string, maxSeq int64,
+) ([]SeqLogRow, error) {
+	rows, err := r.db.Query(ctx, r.q["tl_select_entity_log.sql"], entityKind, entityKey, maxSeq)
+	if err != nil { return nil, err }
+	defer rows.Close()
+	var out []SeqLogRow
+	for rows.Next() {
+		var e SeqLogRow
+		if err := rows.Scan(&e.Seq, &e.ItemHash, &e.ProviderEventID, &e.BucketKey); err != nil { return nil, err }
+		out = append(out, e)
+	}
+	return out, rows.Err()
+}
+
+// LogCommitment returns the sealed bucket committing the smallest sequence
+// log covering seq (0 = the largest); pgx.ErrNoRows means none does.
+func (r *BucketRepo) LogCommitment(ctx context.Context,
+	entityKind, entityKey string, seq int64,
+) (bucketKey string, logSize int32, logRoot []byte, err error) {
+	err = r.db.QueryRow(ctx, r.q["tl_log_commitment.sql"], entityKind, entityKey, seq).Scan(&bucketKey, &logSize, &logRoot)
+	return
+}
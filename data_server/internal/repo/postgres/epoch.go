@@ -0,0 +1,131 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// EpochRow is a roll-up of bucket roots anchored as one.
+type EpochRow struct {
+	ID         int64
+	Period     string
+	TreeAlg    int16
+	RootHash   []byte
+	LeafCount  int32
+	Status     string
+	CID        *string
+	AnchoredTx *string
+	CreatedAt  time.Time
+	AnchoredAt *time.Time
+	AnchorProof []byte // e.g. the RFC 3161 token of a tsa anchor
+}
+
+// EpochMemberRow is the bucket root an epoch committed to at LeafIndex.
+type EpochMemberRow struct {
+	EpochID    int64
+	LeafIndex  int32
+	EntityKind string
+	EntityKey  string
+	BucketKey  string
+	TreeAlg    int16
+	LeafCount  int32
+	RootHash   []byte
+}
+
+func scanEpoch(row pgx.Row) (EpochRow, error) {
+	var e EpochRow
+	err := row.Scan(&e.ID, &e.Period, &e.TreeAlg, &e.RootHash, &e.LeafCount, &e.Status, &e.CID, &e.AnchoredTx, &e.CreatedAt, &e.AnchoredAt, &e.AnchorProof)
+	return e, err
+}
+
+func scanEpochMember(row pgx.Row) (EpochMemberRow, error) {
+	var m EpochMemberRow
+	err := row.Scan(&m.EpochID, &m.LeafIndex, &m.EntityKind, &m.EntityKey, &m.BucketKey, &m.TreeAlg, &m.LeafCount, &m.RootHash)
+	return m, err
+}
+
+func (r *BucketRepo) SetCID(ctx context.Context,
+	entityKind, entityKey, bucketKey, cid string,
+) error {
+	_, err := r.db.Exec(ctx, r.q["tl_set_bucket_cid.sql"], entityKind, entityKey, bucketKey, cid)
+	return err
+}
+
+// InsertEpoch stores an epoch and its members.
+func (r *BucketRepo) InsertEpoch(ctx context.Context, tx pgx.Tx, e EpochRow, members []EpochMemberRow) (EpochRow, error) {
+	out, err := scanEpoch(tx.QueryRow(ctx, r.q["tl_insert_epoch.sql"], e.Period, e.TreeAlg, e.RootHash, e.LeafCount, e.CID))
+	if err != nil { return EpochRow{}, err }
+	for _, m := range members {
+		if _, err := tx.Exec(ctx, r.q["tl_insert_epoch_member.sql"],
+			out.ID, m.LeafIndex, m.EntityKind, m.EntityKey, m.BucketKey, m.TreeAlg, m.LeafCount, m.RootHash); err != nil {
+			return EpochRow{}, err
+		}
+	}
+	return out, nil
+}
+
+func (r *BucketRepo) GetEpoch(ctx context.Context, id int64) (EpochRow, error) {
+	return scanEpoch(r.db.QueryRow(ctx, r.q["tl_get_epoch.sql"], id))
+}
+
+func (r *BucketRepo) ListEpochsByStatus(ctx context.Context,
+	status string, limit, offset int32,
+) ([]EpochRow, error) {
+	rows, err := r.db.Query(ctx, r.q["tl_list_epochs_by_status.sql"], status, limit, offset)
+	if err != nil { return nil, err }
+	defer rows.Close()
+	var out []EpochRow
+	for rows.Next() {
+		e, err := scanEpoch(rows)
+		if err != nil { return nil, err }
+		out = append(out, e)
+	}
+	return out, rows.Err()
+}
+
+func (r *BucketRepo) SelectEpochMembers(ctx context.Context, epochID int64) ([]EpochMemberRow, error) {
+	rows, err := r.db.Query(ctx, r.q["tl_select_epoch_members.sql"], epochID)
+	if err != nil { return nil, err }
+	defer rows.Close()
+	var out []EpochMemberRow
+	for rows.Next() {
+		m, err := scanEpochMember(rows)
+		if err != nil { return nil, err }
+		out = append(out, m)
+	}
+	return out, rows.Err()
+}
+
+// GetEpochMember returns the bucket's latest epoch membership, or
+// pgx.ErrNoRows for buckets never in an epoch.
+func (r *BucketRepo) GetEpochMember(ctx context.Context,
+	entityKind, entityKey, bucketKey string,
+) (EpochMemberRow, error) {
+	return scanEpochMember(r.db.QueryRow(ctx, r.q["tl_get_epoch_member.sql"], entityKind, entityKey, bucketKey))
+}
+
+// SetEpochAnchorPending records the tx sent for a needs_anchoring epoch (and
+// proof, if any) on it and all its member buckets, which wait in
+// anchoring_pending; the buckets' transitions are recorded as actor's.
+// pgx.ErrNoRows means the epoch was not needs_anchoring.
+func (r *BucketRepo) SetEpochAnchorPending(ctx context.Context, id int64, anchoredTx string, proof []byte, actor string) (EpochRow, error) {
+	return scanEpoch(r.db.QueryRow(ctx, r.q["tl_set_epoch_anchor_pending.sql"], id, anchoredTx, actor, proof))
+}
+
+// SetEpochAnchored marks the anchoring_pending epoch sent in anchoredTx, if
+// any, anchored.
+func (r *BucketRepo) SetEpochAnchored(ctx context.Context, tx pgx.Tx, anchoredTx string) error {
+	_, err := tx.Exec(ctx, r.q["tl_set_epoch_anchored.sql"], anchoredTx)
+	return err
+}
+
+// FailBucketEpoch marks the epoch still anchoring the bucket, if any,
+// failed.
+func (r *BucketRepo) FailBucketEpoch(ctx context.Context, tx pgx.Tx,
+	entityKind, entityKey, bucketKey string,
+) error {
+	_, err := tx.Exec(ctx, r.q["tl_fail_bucket_epoch.sql"], entityKind, entityKey, bucketKey)
+	return err
+}
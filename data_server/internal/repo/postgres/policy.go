@@ -0,0 +1,56 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// GetPolicy returns the policy override for a scope (or its issue's project);
+// pgx.ErrNoRows means none is set.
+func (r *BucketRepo) GetPolicy(ctx context.Context,
+	entityKind, entityKey string, ghIssueID int64,
+) (string, error) {
+	var p string
+	err := r.db.QueryRow(ctx, r.q["tl_get_bucket_policy.sql"], entityKind, entityKey, ghIssueID).Scan(&p)
+	return p, err
+}
+
+// IssueProject returns the id of the project that imported a GitHub issue
+// first; pgx.ErrNoRows means none did.
+func (r *BucketRepo) IssueProject(ctx context.Context, ghIssueID int64) (string, error) {
+	var id string
+	err := r.db.QueryRow(ctx, r.q["tl_get_issue_project.sql"], ghIssueID).Scan(&id)
+	return id, err
+}
+
+func (r *BucketRepo) SetPolicy(ctx context.Context,
+	entityKind, entityKey, policy string,
+) error {
+	_, err := r.db.Exec(ctx, r.q["tl_set_bucket_policy.sql"], entityKind, entityKey, policy)
+	return err
+}
+
+func (r *BucketRepo) DeletePolicy(ctx context.Context,
+	entityKind, entityKey string,
+) error {
+	_, err := r.db.Exec(ctx, r.q["tl_delete_bucket_policy.sql"], entityKind, entityKey)
+	return err
+}
+
+// LockScope serializes appends to one scope until tx ends.
+func (r *BucketRepo) LockScope(ctx context.Context, tx pgx.Tx,
+	entityKind, entityKey string,
+) error {
+	_, err := tx.Exec(ctx, r.q["tl_lock_scope.sql"], entityKind, entityKey)
+	return err
+}
+
+// LatestSizeBucket returns the newest size-capped bucket of a scope;
+// pgx.ErrNoRows means the scope has none yet.
+func (r *BucketRepo) LatestSizeBucket(ctx context.Context, tx pgx.Tx,
+	entityKind, entityKey string,
+) (bucketKey string, leafCount int32, status string, err error) {
+	err = tx.QueryRow(ctx, r.q["tl_latest_size_bucket.sql"], entityKind, entityKey).Scan(&bucketKey, &leafCount, &status)
+	return bucketKey, leafCount, status, err
+}
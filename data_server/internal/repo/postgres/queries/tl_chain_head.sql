@@ -0,0 +1,7 @@
+-- Latest sealed link of a scope's bucket chain
+-- Params: $1 entity_kind, $2 entity_key
+SELECT chain_seq, root_hash
+FROM timeline_buckets
+WHERE entity_kind = $1 AND entity_key = $2 AND chain_seq IS NOT NULL
+ORDER BY chain_seq DESC
+LIMIT 1;
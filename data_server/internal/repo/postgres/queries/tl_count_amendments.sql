@@ -0,0 +1,5 @@
+-- How many amendment buckets a sealed bucket has
+-- Params: $1 entity_kind, $2 entity_key, $3 sealed bucket_key
+SELECT count(*)
+FROM timeline_buckets
+WHERE entity_kind = $1 AND entity_key = $2 AND amends = $3;
@@ -0,0 +1,3 @@
+-- Check seq_in_entity uniqueness at commit, so a renumbering may pass through
+-- duplicates (no-op before migration 025)
+SET CONSTRAINTS ALL DEFERRED;
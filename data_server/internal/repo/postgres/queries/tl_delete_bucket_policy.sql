@@ -0,0 +1,3 @@
+-- Params: $1 entity_kind, $2 entity_key
+DELETE FROM timeline_bucket_policies
+WHERE entity_kind = $1 AND entity_key = $2;
@@ -0,0 +1,9 @@
+-- Drop a bucket's leaves and interior nodes before its tree is rewritten in
+-- the same tx; recorded roots stay, as they were published
+-- Params: $1 entity_kind, $2 entity_key, $3 bucket_key
+WITH leaves AS (
+  DELETE FROM timeline_bucket_leaves
+  WHERE entity_kind = $1 AND entity_key = $2 AND bucket_key = $3
+)
+DELETE FROM timeline_bucket_nodes
+WHERE entity_kind = $1 AND entity_key = $2 AND bucket_key = $3;
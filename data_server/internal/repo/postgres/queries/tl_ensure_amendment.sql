@@ -0,0 +1,5 @@
+-- Create an empty open amendment bucket for a sealed one if missing
+-- Params: $1 entity_kind, $2 entity_key, $3 bucket_key, $4 tree_alg, $5 policy, $6 amends
+INSERT INTO timeline_buckets (entity_kind, entity_key, bucket_key, root_hash, leaf_count, status, tree_alg, policy, amends)
+VALUES ($1, $2, $3, '\x'::bytea, 0, 'open', $4, $5, $6)
+ON CONFLICT (entity_kind, entity_key, bucket_key) DO NOTHING;
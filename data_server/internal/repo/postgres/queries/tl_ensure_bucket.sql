@@ -0,0 +1,5 @@
+-- Create an empty open bucket if it does not exist yet (root set on save)
+-- Params: $1 entity_kind, $2 entity_key, $3 bucket_key, $4 tree_alg, $5 policy
+INSERT INTO timeline_buckets (entity_kind, entity_key, bucket_key, root_hash, leaf_count, status, tree_alg, policy)
+VALUES ($1, $2, $3, '\x'::bytea, 0, 'open', $4, $5)
+ON CONFLICT (entity_kind, entity_key, bucket_key) DO NOTHING;
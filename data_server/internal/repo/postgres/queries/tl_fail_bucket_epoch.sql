@@ -0,0 +1,8 @@
+-- Fail the live epoch of a member bucket that left it unanchored
+-- Params: $1 entity_kind, $2 entity_key, $3 bucket_key
+UPDATE timeline_epochs e
+SET status = 'failed'
+FROM timeline_epoch_members m
+WHERE m.epoch_id = e.id
+  AND m.entity_kind = $1 AND m.entity_key = $2 AND m.bucket_key = $3
+  AND e.status IN ('needs_anchoring', 'anchoring_pending');
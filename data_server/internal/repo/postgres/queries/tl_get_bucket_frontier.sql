@@ -0,0 +1,5 @@
+-- Read a bucket's Merkle frontier (for proofs)
+-- Params: $1 entity_kind, $2 entity_key, $3 bucket_key
+SELECT leaf_count, frontier, tree_alg, policy, status
+FROM timeline_buckets
+WHERE entity_kind = $1 AND entity_key = $2 AND bucket_key = $3;
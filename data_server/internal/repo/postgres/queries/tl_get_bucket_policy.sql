@@ -0,0 +1,9 @@
+-- Effective policy override: the scope's own row, else its project's
+-- Params: $1 entity_kind, $2 entity_key, $3 gh_issue_id (0 outside issues)
+SELECT p.policy
+FROM timeline_bucket_policies p
+WHERE (p.entity_kind = $1 AND p.entity_key = $2)
+   OR (p.entity_kind = 'project' AND p.entity_key IN (
+         SELECT project_id::text FROM project_issues WHERE gh_issue_id = $3))
+ORDER BY (p.entity_kind = $1 AND p.entity_key = $2) DESC, p.updated_at DESC
+LIMIT 1;
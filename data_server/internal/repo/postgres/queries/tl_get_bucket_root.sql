@@ -0,0 +1,6 @@
+-- Get the root a bucket published at a given leaf count
+-- Params: $1 entity_kind, $2 entity_key, $3 bucket_key, $4 leaf_count
+SELECT root_hash
+FROM timeline_bucket_roots
+WHERE entity_kind = $1 AND entity_key = $2 AND bucket_key = $3
+  AND leaf_count = $4;
@@ -0,0 +1,4 @@
+-- Params: $1 epoch_id
+SELECT id, period, tree_alg, root_hash, leaf_count, status, cid, anchored_tx, created_at, anchored_at, anchor_proof
+FROM timeline_epochs
+WHERE id = $1;
@@ -0,0 +1,7 @@
+-- The latest epoch membership of one bucket, if any (earlier epochs failed)
+-- Params: $1 entity_kind, $2 entity_key, $3 bucket_key
+SELECT epoch_id, leaf_index, entity_kind, entity_key, bucket_key, tree_alg, leaf_count, root_hash
+FROM timeline_epoch_members
+WHERE entity_kind = $1 AND entity_key = $2 AND bucket_key = $3
+ORDER BY epoch_id DESC
+LIMIT 1;
@@ -0,0 +1,7 @@
+-- Project that imported a GitHub issue first (an issue can be in several)
+-- Params: $1 gh_issue_id
+SELECT project_id::text
+FROM project_issues
+WHERE gh_issue_id = $1
+ORDER BY created_at, id
+LIMIT 1;
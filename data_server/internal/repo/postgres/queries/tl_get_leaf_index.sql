@@ -0,0 +1,8 @@
+-- Find the position of a leaf hash inside a bucket
+-- Params: $1 entity_kind, $2 entity_key, $3 bucket_key, $4 leaf_hash
+SELECT leaf_index
+FROM timeline_bucket_leaves
+WHERE entity_kind = $1 AND entity_key = $2 AND bucket_key = $3
+  AND leaf_hash = $4
+ORDER BY leaf_index ASC
+LIMIT 1;
@@ -0,0 +1,10 @@
+-- Record the root a bucket published at a given leaf count, ignore duplicates
+-- Params:
+--   $1 entity_kind TEXT
+--   $2 entity_key  TEXT
+--   $3 bucket_key  TEXT
+--   $4 leaf_count  INT
+--   $5 root_hash   BYTEA
+INSERT INTO timeline_bucket_roots (entity_kind, entity_key, bucket_key, leaf_count, root_hash)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,19 @@
+-- Store a ledger-signed checkpoint (re-signing the same size with the same key replaces it)
+-- Params:
+--   $1 entity_kind TEXT
+--   $2 entity_key  TEXT
+--   $3 bucket_key  TEXT
+--   $4 leaf_count  INT
+--   $5 root_hash   BYTEA
+--   $6 tree_alg    SMALLINT
+--   $7 key_id      TEXT
+--   $8 signature   BYTEA
+INSERT INTO timeline_bucket_checkpoints
+  (entity_kind, entity_key, bucket_key, leaf_count, root_hash, tree_alg, key_id, signature)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT (entity_kind, entity_key, bucket_key, leaf_count, key_id)
+DO UPDATE SET
+  root_hash = EXCLUDED.root_hash,
+  tree_alg  = EXCLUDED.tree_alg,
+  signature = EXCLUDED.signature,
+  signed_at = now();
@@ -0,0 +1,4 @@
+-- Params: $1 period, $2 tree_alg, $3 root_hash, $4 leaf_count, $5 cid
+INSERT INTO timeline_epochs (period, tree_alg, root_hash, leaf_count, cid)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, period, tree_alg, root_hash, leaf_count, status, cid, anchored_tx, created_at, anchored_at, anchor_proof;
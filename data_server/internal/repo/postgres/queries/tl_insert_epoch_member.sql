@@ -0,0 +1,5 @@
+-- Params: $1 epoch_id, $2 leaf_index, $3 entity_kind, $4 entity_key, $5 bucket_key,
+--         $6 tree_alg, $7 leaf_count, $8 root_hash
+INSERT INTO timeline_epoch_members
+  (epoch_id, leaf_index, entity_kind, entity_key, bucket_key, tree_alg, leaf_count, root_hash)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
@@ -10,17 +10,14 @@
 --   $8 payload_json JSONB
 --   $9 item_hash   BYTEA
 --   $10 bucket_key TEXT
+--   $11 canon_version SMALLINT
+--   $12 seq_in_entity BIGINT (from the scope's locked counter)
 INSERT INTO timeline_items (
   entity_kind, entity_key, provider, provider_event_id,
-  type, actor, created_at, payload_json, item_hash, bucket_key, seq_in_entity
+  type, actor, created_at, payload_json, item_hash, bucket_key, canon_version, seq_in_entity
 )
 VALUES (
   $1, $2, $3, $4,
-  $5, $6, $7, $8, $9, $10,
-  COALESCE((
-    SELECT MAX(seq_in_entity)+1
-    FROM timeline_items
-    WHERE entity_kind = $1 AND entity_key = $2
-  ), 1)
+  $5, $6, $7, $8, $9, $10, $11, $12
 )
 ON CONFLICT (provider_event_id) DO NOTHING;
@@ -1,4 +1,5 @@
--- Insert a leaf row (precomputed leaf_index), ignore duplicates
+-- Insert a leaf row (precomputed leaf_index) into an OPEN bucket only;
+-- no row is written once the bucket is sealed
 -- Params:
 --   $1 entity_kind TEXT
 --   $2 entity_key  TEXT
@@ -6,6 +7,7 @@
 --   $4 leaf_index  INT
 --   $5 leaf_hash   BYTEA
 INSERT INTO timeline_bucket_leaves (entity_kind, entity_key, bucket_key, leaf_index, leaf_hash)
-VALUES ($1, $2, $3, $4, $5)
-ON CONFLICT DO NOTHING;
-
+SELECT $1, $2, $3, $4, $5
+FROM timeline_buckets
+WHERE entity_kind = $1 AND entity_key = $2 AND bucket_key = $3
+  AND status = 'open';
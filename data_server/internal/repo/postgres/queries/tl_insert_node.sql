@@ -0,0 +1,11 @@
+-- Insert a completed interior Merkle node, ignore duplicates
+-- Params:
+--   $1 entity_kind TEXT
+--   $2 entity_key  TEXT
+--   $3 bucket_key  TEXT
+--   $4 level       INT
+--   $5 idx         BIGINT
+--   $6 hash        BYTEA
+INSERT INTO timeline_bucket_nodes (entity_kind, entity_key, bucket_key, level, idx, hash)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,6 @@
+-- Record a bucket status transition
+-- Params: $1 entity_kind, $2 entity_key, $3 bucket_key, $4 from_status, $5 to_status,
+--         $6 actor, $7 reason
+INSERT INTO bucket_status_events
+  (entity_kind, entity_key, bucket_key, from_status, to_status, actor, reason)
+VALUES ($1, $2, $3, $4, $5, $6, $7);
@@ -0,0 +1,7 @@
+-- Latest checkpoint per bucket for a scope and a set of bucket keys
+-- Params: $1 entity_kind, $2 entity_key, $3 bucket_keys TEXT[]
+SELECT DISTINCT ON (bucket_key)
+       bucket_key, leaf_count, root_hash, tree_alg, key_id, signature, signed_at
+FROM timeline_bucket_checkpoints
+WHERE entity_kind = $1 AND entity_key = $2 AND bucket_key = ANY($3::TEXT[])
+ORDER BY bucket_key, leaf_count DESC, signed_at DESC;
@@ -0,0 +1,9 @@
+-- Newest size-capped bucket of a scope. Keys are seq-<n> padded to six
+-- digits, so past seq-999999 a longer key is a later bucket.
+-- Params: $1 entity_kind, $2 entity_key
+SELECT bucket_key, leaf_count, status
+FROM timeline_buckets
+WHERE entity_kind = $1 AND entity_key = $2 AND policy LIKE 'size:%'
+  AND amends IS NULL
+ORDER BY length(bucket_key) DESC, bucket_key DESC
+LIMIT 1;
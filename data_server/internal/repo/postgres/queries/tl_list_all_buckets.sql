@@ -0,0 +1,10 @@
+-- Every bucket, or every bucket of one scope, after a keyset cursor
+-- Params: $1 entity_kind ('' = all scopes), $2 entity_key,
+--         $3 after entity_kind, $4 after entity_key, $5 after bucket_key, $6 limit
+SELECT entity_kind, entity_key, bucket_key,
+       root_hash, leaf_count, status, cid, closed_at, anchored_tx, anchored_at, tree_alg, policy, amends, prev_root, chain_seq, log_size, log_root, leaf_order, anchored_block, anchored_block_hash, anchor_proof
+FROM timeline_buckets
+WHERE ($1 = '' OR (entity_kind = $1 AND entity_key = $2))
+  AND (entity_kind, entity_key, bucket_key) > ($3, $4, $5)
+ORDER BY entity_kind, entity_key, bucket_key
+LIMIT $6;
@@ -0,0 +1,9 @@
+-- Newest first; empty scope = all scopes
+-- Params: $1 entity_kind, $2 entity_key, $3 include_resolved BOOL, $4 limit, $5 offset
+SELECT id, entity_kind, entity_key, bucket_key, check_name, detail,
+       first_seen_at, last_seen_at, resolved_at
+FROM timeline_audit_findings
+WHERE ($1 = '' OR (entity_kind = $1 AND entity_key = $2))
+  AND ($3 OR resolved_at IS NULL)
+ORDER BY last_seen_at DESC, id DESC
+LIMIT $4 OFFSET $5;
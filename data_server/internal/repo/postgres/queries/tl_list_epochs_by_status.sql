@@ -0,0 +1,7 @@
+-- Oldest first, so epochs are anchored in the order they were sealed
+-- Params: $1 status, $2 limit, $3 offset
+SELECT id, period, tree_alg, root_hash, leaf_count, status, cid, anchored_tx, created_at, anchored_at, anchor_proof
+FROM timeline_epochs
+WHERE status = $1
+ORDER BY id
+LIMIT $2 OFFSET $3;
@@ -0,0 +1,13 @@
+-- Open windowed buckets whose window ended before the cutoff: bucket keys of
+-- one policy sort in time order, so a key below the policy's key at the
+-- cutoff time is a window that has passed. Size-capped buckets never match.
+-- Params: $1 hourly cutoff key, $2 daily cutoff key, $3 weekly cutoff key, $4 limit
+SELECT entity_kind, entity_key, bucket_key,
+       root_hash, leaf_count, status, cid, closed_at, anchored_tx, anchored_at, tree_alg, policy, amends, prev_root, chain_seq, log_size, log_root, leaf_order, anchored_block, anchored_block_hash, anchor_proof
+FROM timeline_buckets
+WHERE status = 'open'
+  AND (   (policy = 'hourly' AND bucket_key < $1)
+       OR (policy = 'daily'  AND bucket_key < $2)
+       OR (policy = 'weekly' AND bucket_key < $3))
+ORDER BY bucket_key, entity_kind, entity_key
+LIMIT $4;
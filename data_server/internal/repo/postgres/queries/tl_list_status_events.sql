@@ -0,0 +1,6 @@
+-- A bucket's status transitions, oldest first
+-- Params: $1 entity_kind, $2 entity_key, $3 bucket_key
+SELECT from_status, to_status, actor, reason, at
+FROM bucket_status_events
+WHERE entity_kind = $1 AND entity_key = $2 AND bucket_key = $3
+ORDER BY id;
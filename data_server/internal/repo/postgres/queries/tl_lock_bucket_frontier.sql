@@ -0,0 +1,6 @@
+-- Lock a bucket row for append and read its Merkle frontier
+-- Params: $1 entity_kind, $2 entity_key, $3 bucket_key
+SELECT leaf_count, frontier, tree_alg, policy, status
+FROM timeline_buckets
+WHERE entity_kind = $1 AND entity_key = $2 AND bucket_key = $3
+FOR UPDATE;
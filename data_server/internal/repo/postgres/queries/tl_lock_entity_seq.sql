@@ -0,0 +1,12 @@
+-- Lock a scope's sequence counter for the rest of the tx and return its last
+-- seq_in_entity (a new scope starts from its items, normally none)
+-- Params: $1 entity_kind, $2 entity_key
+INSERT INTO timeline_entity_seq (entity_kind, entity_key, last_seq)
+VALUES ($1, $2, (
+  SELECT COALESCE(MAX(seq_in_entity), 0)
+  FROM timeline_items
+  WHERE entity_kind = $1 AND entity_key = $2
+))
+ON CONFLICT (entity_kind, entity_key)
+  DO UPDATE SET last_seq = timeline_entity_seq.last_seq
+RETURNING last_seq;
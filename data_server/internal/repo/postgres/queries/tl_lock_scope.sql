@@ -0,0 +1,3 @@
+-- Serialize appends to one scope for the rest of the tx (size-capped buckets)
+-- Params: $1 entity_kind, $2 entity_key
+SELECT pg_advisory_xact_lock(hashtextextended($1 || '/' || $2, 0));
@@ -0,0 +1,9 @@
+-- The sealed bucket committing the smallest sequence log that covers seq $3
+-- (0 = the largest committed log)
+-- Params: $1 entity_kind, $2 entity_key, $3 seq
+SELECT bucket_key, log_size, log_root
+FROM timeline_buckets
+WHERE entity_kind = $1 AND entity_key = $2
+  AND log_size IS NOT NULL AND log_size >= $3
+ORDER BY CASE WHEN $3 = 0 THEN -log_size ELSE log_size END, chain_seq
+LIMIT 1;
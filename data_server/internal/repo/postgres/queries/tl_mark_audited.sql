@@ -0,0 +1,4 @@
+-- Params: $1 entity_kind, $2 entity_key, $3 bucket_key
+UPDATE timeline_buckets
+SET audited_at = now()
+WHERE entity_kind = $1 AND entity_key = $2 AND bucket_key = $3;
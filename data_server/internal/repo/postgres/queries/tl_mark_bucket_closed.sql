@@ -1,9 +1,17 @@
--- Transition open -> needs_anchoring and stamp closed_at once
--- Params: $1 entity_kind, $2 entity_key, $3 bucket_key
+-- Transition open -> needs_anchoring, stamp closed_at once and record the
+-- bucket's chain link (its chain leaf is appended in the same tx) and the
+-- order its item leaves were left in
+-- Params: $1 entity_kind, $2 entity_key, $3 bucket_key, $4 prev_root, $5 chain_seq,
+--         $6 log_size (NULL = no log committed), $7 log_root, $8 leaf_order
 UPDATE timeline_buckets
 SET status = 'needs_anchoring',
-    closed_at = COALESCE(closed_at, now())
+    closed_at = COALESCE(closed_at, now()),
+    prev_root = $4,
+    chain_seq = $5,
+    log_size = $6,
+    log_root = $7,
+    leaf_order = $8
 WHERE entity_kind = $1 AND entity_key = $2 AND bucket_key = $3
   AND status = 'open'
 RETURNING entity_kind, entity_key, bucket_key,
-          root_hash, leaf_count, status, cid, closed_at, anchored_tx, anchored_at;
+          root_hash, leaf_count, status, cid, closed_at, anchored_tx, anchored_at, tree_alg, policy, amends, prev_root, chain_seq, log_size, log_root, leaf_order, anchored_block, anchored_block_hash, anchor_proof;
@@ -0,0 +1,5 @@
+-- Re-home just-inserted items to another bucket (late arrivals -> amendment)
+-- Params: $1 entity_kind, $2 entity_key, $3 provider_event_ids TEXT[], $4 bucket_key
+UPDATE timeline_items
+SET bucket_key = $4
+WHERE entity_kind = $1 AND entity_key = $2 AND provider_event_id = ANY($3);
@@ -0,0 +1,7 @@
+-- Give a scope's items new seq_in_entity values
+-- Params: $1 entity_kind, $2 entity_key, $3 provider_event_id TEXT[], $4 seq_in_entity BIGINT[]
+UPDATE timeline_items t
+SET seq_in_entity = v.seq
+FROM unnest($3::text[], $4::bigint[]) AS v(provider_event_id, seq)
+WHERE t.entity_kind = $1 AND t.entity_key = $2
+  AND t.provider_event_id = v.provider_event_id;
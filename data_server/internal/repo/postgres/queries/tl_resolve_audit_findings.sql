@@ -0,0 +1,7 @@
+-- Resolve open findings of a bucket whose checks now pass
+-- Params: $1 entity_kind, $2 entity_key, $3 bucket_key, $4 passing check names TEXT[]
+UPDATE timeline_audit_findings
+SET resolved_at = now()
+WHERE entity_kind = $1 AND entity_key = $2 AND bucket_key = $3
+  AND resolved_at IS NULL
+  AND check_name = ANY($4);
@@ -0,0 +1,5 @@
+-- Write a bucket's leaf rows 0..n-1 in one go (tree rewrites; any status)
+-- Params: $1 entity_kind, $2 entity_key, $3 bucket_key, $4 leaf_hash BYTEA[] in leaf order
+INSERT INTO timeline_bucket_leaves (entity_kind, entity_key, bucket_key, leaf_index, leaf_hash)
+SELECT $1, $2, $3, (l.ord - 1)::int, l.hash
+FROM unnest($4::bytea[]) WITH ORDINALITY AS l(hash, ord);
@@ -0,0 +1,14 @@
+-- Store the advanced frontier with its root and leaf count (open buckets only)
+-- Params:
+--   $1 entity_kind TEXT
+--   $2 entity_key  TEXT
+--   $3 bucket_key  TEXT
+--   $4 root_hash   BYTEA
+--   $5 leaf_count  INT
+--   $6 frontier    BYTEA
+UPDATE timeline_buckets
+SET root_hash  = $4,
+    leaf_count = $5,
+    frontier   = $6
+WHERE entity_kind = $1 AND entity_key = $2 AND bucket_key = $3
+  AND status = 'open';
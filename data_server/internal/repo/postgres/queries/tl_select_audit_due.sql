@@ -0,0 +1,7 @@
+-- Buckets with leaves, least recently audited first
+-- Params: $1 limit
+SELECT entity_kind, entity_key, bucket_key
+FROM timeline_buckets
+WHERE leaf_count > 0
+ORDER BY audited_at NULLS FIRST, entity_kind, entity_key, bucket_key
+LIMIT $1;
@@ -0,0 +1,7 @@
+-- A scope's items in sequence order (its sequence log)
+-- Params: $1 entity_kind, $2 entity_key, $3 max seq_in_entity (0 = all)
+SELECT seq_in_entity, item_hash, provider_event_id, bucket_key
+FROM timeline_items
+WHERE entity_kind = $1 AND entity_key = $2
+  AND ($3 = 0 OR seq_in_entity <= $3)
+ORDER BY seq_in_entity ASC, id ASC;
@@ -0,0 +1,5 @@
+-- Params: $1 epoch_id
+SELECT epoch_id, leaf_index, entity_kind, entity_key, bucket_key, tree_alg, leaf_count, root_hash
+FROM timeline_epoch_members
+WHERE epoch_id = $1
+ORDER BY leaf_index;
@@ -0,0 +1,6 @@
+-- Fetch the canonical items of a bucket in sequence order (for export)
+-- Params: $1 entity_kind, $2 entity_key, $3 bucket_key
+SELECT provider, provider_event_id, type, actor, created_at, payload_json, item_hash, seq_in_entity, canon_version
+FROM timeline_items
+WHERE entity_kind = $1 AND entity_key = $2 AND bucket_key = $3
+ORDER BY seq_in_entity ASC;
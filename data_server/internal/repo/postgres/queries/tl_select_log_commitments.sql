@@ -0,0 +1,7 @@
+-- Every sequence log a scope's sealed buckets committed to, smallest first
+-- Params: $1 entity_kind, $2 entity_key
+SELECT bucket_key, log_size, log_root
+FROM timeline_buckets
+WHERE entity_kind = $1 AND entity_key = $2
+  AND log_size IS NOT NULL
+ORDER BY log_size ASC;
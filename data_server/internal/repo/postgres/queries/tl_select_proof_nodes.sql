@@ -0,0 +1,13 @@
+-- Fetch the completed nodes an inclusion proof needs (level 0 = leaves)
+-- Params: $1 entity_kind, $2 entity_key, $3 bucket_key, $4 levels INT[], $5 idxs BIGINT[]
+SELECT 0 AS level, l.leaf_index::BIGINT AS idx, l.leaf_hash AS hash
+FROM timeline_bucket_leaves l
+JOIN unnest($4::INT[], $5::BIGINT[]) AS r(level, idx)
+  ON r.level = 0 AND r.idx = l.leaf_index
+WHERE l.entity_kind = $1 AND l.entity_key = $2 AND l.bucket_key = $3
+UNION ALL
+SELECT n.level, n.idx, n.hash
+FROM timeline_bucket_nodes n
+JOIN unnest($4::INT[], $5::BIGINT[]) AS r(level, idx)
+  ON r.level = n.level AND r.idx = n.idx
+WHERE n.entity_kind = $1 AND n.entity_key = $2 AND n.bucket_key = $3;
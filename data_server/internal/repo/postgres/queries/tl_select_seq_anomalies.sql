@@ -0,0 +1,8 @@
+-- Scopes whose seq_in_entity is not exactly 1..n
+SELECT entity_kind, entity_key, COUNT(*), COUNT(DISTINCT seq_in_entity), MAX(seq_in_entity)
+FROM timeline_items
+GROUP BY entity_kind, entity_key
+HAVING MIN(seq_in_entity) <> 1
+    OR MAX(seq_in_entity) <> COUNT(*)
+    OR COUNT(DISTINCT seq_in_entity) <> COUNT(*)
+ORDER BY entity_kind, entity_key;
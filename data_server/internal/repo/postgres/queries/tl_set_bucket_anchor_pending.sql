@@ -0,0 +1,12 @@
+-- Record the CID + sent anchor of a claimed bucket, anchoring -> anchoring_pending
+-- Params: $1 entity_kind, $2 entity_key, $3 bucket_key, $4 cid, $5 anchored_tx,
+--         $6 anchor_proof (NULL = the tx is the whole anchor)
+UPDATE timeline_buckets
+SET cid = $4,
+    anchored_tx = $5,
+    anchor_proof = $6,
+    status = 'anchoring_pending'
+WHERE entity_kind = $1 AND entity_key = $2 AND bucket_key = $3
+  AND status = 'anchoring'
+RETURNING entity_kind, entity_key, bucket_key,
+          root_hash, leaf_count, status, cid, closed_at, anchored_tx, anchored_at, tree_alg, policy, amends, prev_root, chain_seq, log_size, log_root, leaf_order, anchored_block, anchored_block_hash, anchor_proof;
@@ -1,10 +1,11 @@
--- Set CID + chain tx and mark as anchored
--- Params: $1 entity_kind, $2 entity_key, $3 bucket_key, $4 cid, $5 anchored_tx
+-- Mark a bucket whose tx $4 is confirmed anchoring_pending -> anchored, with the including block
+-- Params: $1 entity_kind, $2 entity_key, $3 bucket_key, $4 anchored_tx, $5 anchored_block, $6 anchored_block_hash
 UPDATE timeline_buckets
-SET cid = $4,
-    anchored_tx = $5,
+SET anchored_block = $5,
+    anchored_block_hash = $6,
     anchored_at = now(),
     status = 'anchored'
 WHERE entity_kind = $1 AND entity_key = $2 AND bucket_key = $3
+  AND status = 'anchoring_pending' AND anchored_tx = $4
 RETURNING entity_kind, entity_key, bucket_key,
-          root_hash, leaf_count, status, cid, closed_at, anchored_tx, anchored_at;
+          root_hash, leaf_count, status, cid, closed_at, anchored_tx, anchored_at, tree_alg, policy, amends, prev_root, chain_seq, log_size, log_root, leaf_order, anchored_block, anchored_block_hash, anchor_proof;
@@ -0,0 +1,5 @@
+-- Record the manifest CID of a packed bucket
+-- Params: $1 entity_kind, $2 entity_key, $3 bucket_key, $4 cid
+UPDATE timeline_buckets
+SET cid = $4
+WHERE entity_kind = $1 AND entity_key = $2 AND bucket_key = $3;
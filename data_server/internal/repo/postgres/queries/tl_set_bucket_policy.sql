@@ -0,0 +1,5 @@
+-- Params: $1 entity_kind, $2 entity_key, $3 policy
+INSERT INTO timeline_bucket_policies (entity_kind, entity_key, policy)
+VALUES ($1, $2, $3)
+ON CONFLICT (entity_kind, entity_key)
+DO UPDATE SET policy = EXCLUDED.policy, updated_at = now();
@@ -0,0 +1,11 @@
+-- Move a bucket from status $4 to $5; no row if it is no longer in $4.
+-- Leaving anchoring_pending unanchored drops the anchor that never confirmed.
+-- Params: $1 entity_kind, $2 entity_key, $3 bucket_key, $4 from_status, $5 to_status
+UPDATE timeline_buckets
+SET status = $5,
+    anchored_tx = CASE WHEN $4 = 'anchoring_pending' THEN NULL ELSE anchored_tx END,
+    anchor_proof = CASE WHEN $4 = 'anchoring_pending' THEN NULL ELSE anchor_proof END
+WHERE entity_kind = $1 AND entity_key = $2 AND bucket_key = $3
+  AND status = $4
+RETURNING entity_kind, entity_key, bucket_key,
+          root_hash, leaf_count, status, cid, closed_at, anchored_tx, anchored_at, tree_alg, policy, amends, prev_root, chain_seq, log_size, log_root, leaf_order, anchored_block, anchored_block_hash, anchor_proof;
@@ -0,0 +1,6 @@
+-- Advance (or, after a repair, reset) a scope's sequence counter
+-- Params: $1 entity_kind, $2 entity_key, $3 last_seq
+INSERT INTO timeline_entity_seq (entity_kind, entity_key, last_seq)
+VALUES ($1, $2, $3)
+ON CONFLICT (entity_kind, entity_key)
+  DO UPDATE SET last_seq = EXCLUDED.last_seq;
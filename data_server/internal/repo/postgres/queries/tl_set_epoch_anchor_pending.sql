@@ -0,0 +1,28 @@
+-- Record the epoch's sent tx and carry it to every member bucket (which the
+-- epoch claimed, anchoring -> anchoring_pending), recording each transition
+-- Params: $1 epoch_id, $2 anchored_tx, $3 actor, $4 anchor_proof (NULL = the tx is the whole anchor)
+WITH e AS (
+  UPDATE timeline_epochs
+  SET anchored_tx = $2,
+      anchor_proof = $4,
+      status = 'anchoring_pending'
+  WHERE id = $1 AND status = 'needs_anchoring'
+  RETURNING id, period, tree_alg, root_hash, leaf_count, status, cid, anchored_tx, created_at, anchored_at, anchor_proof
+), b AS (
+  UPDATE timeline_buckets t
+  SET anchored_tx = e.anchored_tx,
+      anchor_proof = e.anchor_proof,
+      status = 'anchoring_pending'
+  FROM e, timeline_epoch_members m
+  WHERE m.epoch_id = e.id
+    AND t.entity_kind = m.entity_kind AND t.entity_key = m.entity_key AND t.bucket_key = m.bucket_key
+    AND t.status = 'anchoring'
+  RETURNING t.entity_kind, t.entity_key, t.bucket_key, e.id, e.anchored_tx
+), ev AS (
+  INSERT INTO bucket_status_events
+    (entity_kind, entity_key, bucket_key, from_status, to_status, actor, reason)
+  SELECT entity_kind, entity_key, bucket_key, 'anchoring', 'anchoring_pending', $3,
+         'epoch ' || id || ' sent ' || anchored_tx
+  FROM b
+)
+SELECT * FROM e;
@@ -0,0 +1,6 @@
+-- Mark the epoch a confirmed tx anchored, once its first member is anchored
+-- Params: $1 anchored_tx
+UPDATE timeline_epochs
+SET anchored_at = now(),
+    status = 'anchored'
+WHERE anchored_tx = $1 AND status = 'anchoring_pending';
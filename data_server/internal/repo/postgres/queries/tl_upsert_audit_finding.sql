@@ -0,0 +1,6 @@
+-- Open a finding, or refresh the open one for the same bucket and check
+-- Params: $1 entity_kind, $2 entity_key, $3 bucket_key, $4 check_name, $5 detail
+INSERT INTO timeline_audit_findings (entity_kind, entity_key, bucket_key, check_name, detail)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (entity_kind, entity_key, bucket_key, check_name) WHERE resolved_at IS NULL
+DO UPDATE SET detail = EXCLUDED.detail, last_seen_at = now();
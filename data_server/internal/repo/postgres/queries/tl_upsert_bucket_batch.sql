@@ -1,4 +1,5 @@
--- Upsert/advance a bucket by N appended leaves (root precomputed)
+-- Upsert/advance a bucket by N appended leaves (root precomputed);
+-- sealed buckets are left untouched
 -- Params:
 --   $1 entity_kind TEXT
 --   $2 entity_key  TEXT
@@ -10,4 +11,5 @@ VALUES ($1, $2, $3, $4, $5, 'open')
 ON CONFLICT (entity_kind, entity_key, bucket_key)
 DO UPDATE SET
   root_hash  = EXCLUDED.root_hash,
-  leaf_count = timeline_buckets.leaf_count + EXCLUDED.leaf_count;
+  leaf_count = timeline_buckets.leaf_count + EXCLUDED.leaf_count
+WHERE timeline_buckets.status = 'open';
@@ -1,4 +1,5 @@
--- Upsert/advance a bucket by ONE appended leaf (root precomputed in app)
+-- Upsert/advance a bucket by ONE appended leaf (root precomputed in app);
+-- sealed buckets are left untouched
 -- Params:
 --   $1 entity_kind TEXT
 --   $2 entity_key  TEXT
@@ -9,4 +10,5 @@ VALUES ($1, $2, $3, $4, 1, 'open')
 ON CONFLICT (entity_kind, entity_key, bucket_key)
 DO UPDATE SET
   root_hash = EXCLUDED.root_hash,
-  leaf_count = timeline_buckets.leaf_count + 1;
+  leaf_count = timeline_buckets.leaf_count + 1
+WHERE timeline_buckets.status = 'open';
@@ -0,0 +1,81 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// SeqAnomalyRow is a scope whose seq_in_entity is not exactly 1..n.
+type SeqAnomalyRow struct {
+	EntityKind string
+	EntityKey  string
+	Items      int64
+	Distinct   int64 // distinct seq_in_entity values; < Items means duplicates
+	MaxSeq     int64 // > Items (with no duplicates) means gaps
+}
+
+// LogCommitmentRow is a sequence log a sealed bucket's chain link commits to.
+type LogCommitmentRow struct {
+	BucketKey string
+	LogSize   int32
+	LogRoot   []byte
+}
+
+// LockEntitySeq locks the scope's sequence counter until tx ends and returns
+// the last seq_in_entity handed out.
+func (r *BucketRepo) LockEntitySeq(ctx context.Context, tx pgx.Tx,
+	entityKind, entityKey string,
+) (int64, error) {
+	var last int64
+	err := tx.QueryRow(ctx, r.q["tl_lock_entity_seq.sql"], entityKind, entityKey).Scan(&last)
+	return last, err
+}
+
+func (r *BucketRepo) SetEntitySeq(ctx context.Context, tx pgx.Tx,
+	entityKind, entityKey string, last int64,
+) error {
+	_, err := tx.Exec(ctx, r.q["tl_set_entity_seq.sql"], entityKind, entityKey, last)
+	return err
+}
+
+func (r *BucketRepo) SelectSeqAnomalies(ctx context.Context) ([]SeqAnomalyRow, error) {
+	rows, err := r.db.Query(ctx, r.q["tl_select_seq_anomalies.sql"])
+	if err != nil { return nil, err }
+	defer rows.Close()
+	var out []SeqAnomalyRow
+	for rows.Next() {
+		var a SeqAnomalyRow
+		if err := rows.Scan(&a.EntityKind, &a.EntityKey, &a.Items, &a.Distinct, &a.MaxSeq); err != nil { return nil, err }
+		out = append(out, a)
+	}
+	return out, rows.Err()
+}
+
+func (r *BucketRepo) SelectLogCommitments(ctx context.Context,
+	entityKind, entityKey string,
+) ([]LogCommitmentRow, error) {
+	rows, err := r.db.Query(ctx, r.q["tl_select_log_commitments.sql"], entityKind, entityKey)
+	if err != nil { return nil, err }
+	defer rows.Close()
+	var out []LogCommitmentRow
+	for rows.Next() {
+		var c LogCommitmentRow
+		if err := rows.Scan(&c.BucketKey, &c.LogSize, &c.LogRoot); err != nil { return nil, err }
+		out = append(out, c)
+	}
+	return out, rows.Err()
+}
+
+// RenumberItems sets seq_in_entity of the scope's items, matched by
+// provider_event_id. Uniqueness is checked at commit, so the new numbers may
+// swap with old ones.
+func (r *BucketRepo) RenumberItems(ctx context.Context, tx pgx.Tx,
+	entityKind, entityKey string, providerEventIDs []string, seqs []int64,
+) error {
+	if _, err := tx.Exec(ctx, r.q["tl_defer_entity_seq.sql"]); err != nil {
+		return err
+	}
+	_, err := tx.Exec(ctx, r.q["tl_renumber_items.sql"], entityKind, entityKey, providerEventIDs, seqs)
+	return err
+}
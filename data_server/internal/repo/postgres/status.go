@@ -0,0 +1,50 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// StatusEventRow is one bucket status transition.
+type StatusEventRow struct {
+	FromStatus string
+	ToStatus   string
+	Actor      string
+	Reason     string
+	At         time.Time
+}
+
+// SetStatus moves a bucket from one status to another; pgx.ErrNoRows means
+// it was no longer in from.
+func (r *BucketRepo) SetStatus(ctx context.Context, tx pgx.Tx,
+	entityKind, entityKey, bucketKey, from, to string,
+) (BucketRow, error) {
+	var b BucketRow
+	err := tx.QueryRow(ctx, r.q["tl_set_bucket_status.sql"], entityKind, entityKey, bucketKey, from, to).
+		Scan(&b.EntityKind, &b.EntityKey, &b.BucketKey, &b.RootHash, &b.LeafCount, &b.Status, &b.CID, &b.ClosedAt, &b.AnchoredTx, &b.AnchoredAt, &b.TreeAlg, &b.Policy, &b.Amends, &b.PrevRoot, &b.ChainSeq, &b.LogSize, &b.LogRoot, &b.LeafOrder, &b.AnchoredBlock, &b.AnchoredBlockHash, &b.AnchorProof)
+	return b, err
+}
+
+func (r *BucketRepo) InsertStatusEvent(ctx context.Context, tx pgx.Tx,
+	entityKind, entityKey, bucketKey, from, to, actor, reason string,
+) error {
+	_, err := tx.Exec(ctx, r.q["tl_insert_status_event.sql"], entityKind, entityKey, bucketKey, from, to, actor, reason)
+	return err
+}
+
+func (r *BucketRepo) ListStatusEvents(ctx context.Context,
+	entityKind, entityKey, bucketKey string,
+) ([]StatusEventRow, error) {
+	rows, err := r.db.Query(ctx, r.q["tl_list_status_events.sql"], entityKind, entityKey, bucketKey)
+	if err != nil { return nil, err }
+	defer rows.Close()
+	var out []StatusEventRow
+	for rows.Next() {
+		var e StatusEventRow
+		if err := rows.Scan(&e.FromStatus, &e.ToStatus, &e.Actor, &e.Reason, &e.At); err != nil { return nil, err }
+		out = append(out, e)
+	}
+	return out, rows.Err()
+}
@@ -0,0 +1,195 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	bucketv1 "github.com/gusplusbus/trustflow/data_server/gen/bucketv1"
+	"github.com/gusplusbus/trustflow/data_server/internal/evidence"
+	"github.com/gusplusbus/trustflow/data_server/internal/repo/postgres"
+	"github.com/gusplusbus/trustflow/data_server/internal/service/crypto"
+)
+
+// AuditBucket re-derives a bucket's evidence from the database: every item is
+// re-canonicalized and re-hashed, leaves are matched to items, the root is
+// rebuilt from the leaves and compared with the stored root, frontier and
+// recorded root. Failing checks are recorded as findings; findings for checks
+// that pass again are resolved.
+func (s *BucketService) AuditBucket(ctx context.Context, ref bucketv1.BucketRef) (*bucketv1.AuditBucketResponse, error) {
+	kind, key, bkey := ref.GetScope().GetEntityKind(), ref.GetScope().GetEntityKey(), ref.GetBucketKey()
+
+	bundle, err := s.ExportBucket(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	fr, err := s.repo.GetFrontier(ctx, kind, key, bkey)
+	if err != nil {
+		return nil, err
+	}
+	results := auditChecks(ctx, s.repo, bundle, fr, s.tsaRoots)
+
+	// An open bucket may have grown while we read it; its leaves and root
+	// then disagree for no bad reason, so leave it for the next pass.
+	if !evidence.OK(results) {
+		b, err := s.repo.GetBucket(ctx, kind, key, bkey)
+		if err != nil {
+			return nil, err
+		}
+		if uint32(b.LeafCount) != bundle.LeafCount {
+			return nil, fmt.Errorf("bucket changed during audit (leaf_count %d -> %d)", bundle.LeafCount, b.LeafCount)
+		}
+	}
+
+	out := &bucketv1.AuditBucketResponse{Ok: true}
+	var passed []string
+	for _, r := range results {
+		c := &bucketv1.AuditCheck{Check: r.Check, Ok: r.Err == nil, Detail: r.Note}
+		if r.Err != nil {
+			c.Detail = r.Err.Error()
+			out.Ok = false
+			if err := s.repo.UpsertAuditFinding(ctx, kind, key, bkey, r.Check, c.Detail); err != nil {
+				return nil, err
+			}
+		} else {
+			passed = append(passed, r.Check)
+		}
+		out.Checks = append(out.Checks, c)
+	}
+	if err := s.repo.ResolveAuditFindings(ctx, kind, key, bkey, passed); err != nil {
+		return nil, err
+	}
+	if err := s.repo.MarkAudited(ctx, kind, key, bkey); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// auditChecks checks an exported bundle on its own, then against the
+// bucket's stored frontier, nodes and recorded root.
+func auditChecks(ctx context.Context, repo nodeReader, b *evidence.Bundle, fr postgres.FrontierRow, tsaRoots *x509.CertPool) []evidence.Result {
+	results := evidence.Verify(b, tsaRoots)
+	return append(results, checkFrontier(b, fr), checkRecordedRoot(ctx, repo, b, fr))
+}
+
+// checkFrontier compares the root folded from the stored frontier with the
+// stored root.
+func checkFrontier(b *evidence.Bundle, fr postgres.FrontierRow) evidence.Result {
+	r := evidence.Result{Check: "frontier"}
+	if fr.Frontier == nil {
+		r.Note = "skipped: bucket predates frontiers"
+		return r
+	}
+	f, err := crypto.DecodeFrontier(crypto.TreeAlg(fr.TreeAlg), uint64(fr.LeafCount), fr.Frontier)
+	if err != nil {
+		r.Err = err
+		return r
+	}
+	if !bytes.Equal(f.Root(), b.RootHash) {
+		r.Err = fmt.Errorf("frontier root %x != root_hash %x", f.Root(), []byte(b.RootHash))
+	}
+	return r
+}
+
+// checkRecordedRoot compares the root recorded at the current leaf count
+// (itself checked against the stored nodes) with the stored root.
+func checkRecordedRoot(ctx context.Context, repo nodeReader, b *evidence.Bundle, fr postgres.FrontierRow) evidence.Result {
+	r := evidence.Result{Check: "recorded_root"}
+	if fr.Frontier == nil {
+		r.Note = "skipped: bucket predates recorded roots"
+		return r
+	}
+	root, err := observedRoot(ctx, repo, b.EntityKind, b.EntityKey, b.BucketKey, crypto.TreeAlg(b.TreeAlg), b.LeafCount)
+	if err != nil {
+		r.Err = err
+		return r
+	}
+	if !bytes.Equal(root, b.RootHash) {
+		r.Err = fmt.Errorf("recorded root %x != root_hash %x", root, []byte(b.RootHash))
+	}
+	return r
+}
+
+// ListAuditFindings pages like ListByStatus (page_token is an offset).
+func (s *BucketService) ListAuditFindings(ctx context.Context, scope bucketv1.Scope, includeResolved bool, limit int32, pageToken string) (*bucketv1.ListAuditFindingsResponse, error) {
+	if limit <= 0 {
+		limit = 50
+	}
+	var offset int32
+	if pageToken != "" {
+		if n, err := strconv.Atoi(pageToken); err == nil && n >= 0 {
+			offset = int32(n)
+		}
+	}
+	rows, err := s.repo.ListAuditFindings(ctx, scope.GetEntityKind(), scope.GetEntityKey(), includeResolved, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	out := &bucketv1.ListAuditFindingsResponse{}
+	for _, f := range rows {
+		out.Findings = append(out.Findings, findingToProto(f))
+	}
+	if int32(len(rows)) == limit {
+		out.NextPageToken = fmt.Sprint(offset + limit)
+	}
+	return out, nil
+}
+
+// RunAuditor audits up to batch buckets, least recently audited first, every
+// interval until ctx is done.
+func (s *BucketService) RunAuditor(ctx context.Context, interval time.Duration, batch int32) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		s.auditDue(ctx, batch)
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+func (s *BucketService) auditDue(ctx context.Context, batch int32) {
+	due, err := s.repo.SelectAuditDue(ctx, batch)
+	if err != nil {
+		log.Printf("audit: select due: %v", err)
+		return
+	}
+	for _, b := range due {
+		ref := bucketv1.BucketRef{
+			Scope:     &bucketv1.Scope{EntityKind: b.EntityKind, EntityKey: b.EntityKey},
+			BucketKey: b.BucketKey,
+		}
+		res, err := s.AuditBucket(ctx, ref)
+		if err != nil {
+			log.Printf("audit %s/%s/%s: %v", b.EntityKind, b.EntityKey, b.BucketKey, err)
+			continue
+		}
+		if !res.GetOk() {
+			log.Printf("audit %s/%s/%s: integrity findings recorded", b.EntityKind, b.EntityKey, b.BucketKey)
+		}
+	}
+}
+
+func findingToProto(f postgres.AuditFindingRow) *bucketv1.AuditFinding {
+	out := &bucketv1.AuditFinding{
+		Id: uint64(f.ID),
+		Ref: &bucketv1.BucketRef{
+			Scope:     &bucketv1.Scope{EntityKind: f.EntityKind, EntityKey: f.EntityKey},
+			BucketKey: f.BucketKey,
+		},
+		Check:       f.Check,
+		Detail:      f.Detail,
+		FirstSeenAt: f.FirstSeenAt.UTC().Format(time.RFC3339),
+		LastSeenAt:  f.LastSeenAt.UTC().Format(time.RFC3339),
+	}
+	if f.ResolvedAt != nil {
+		out.ResolvedAt = f.ResolvedAt.UTC().Format(time.RFC3339)
+	}
+	return out
+}
@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/gusplusbus/trustflow/data_server/internal/evidence"
+	"github.com/gusplusbus/trustflow/data_server/internal/repo/postgres"
+	"github.com/gusplusbus/trustflow/data_server/internal/service/crypto"
+)
+
+// memNodes keeps one bucket's leaves (level 0), nodes and recorded roots.
+type memNodes struct {
+	nodes map[crypto.NodeRef][]byte
+	roots map[int32][]byte
+}
+
+func (m *memNodes) GetRoot(_ context.Context, _, _, _ string, leafCount int32) ([]byte, error) {
+	return m.roots[leafCount], nil
+}
+
+func (m *memNodes) SelectNodes(_ context.Context, _, _, _ string, levels []int32, idxs []int64) ([]postgres.NodeRow, error) {
+	var out []postgres.NodeRow
+	for i := range levels {
+		if h, ok := m.nodes[crypto.NodeRef{Level: int(levels[i]), Index: uint64(idxs[i])}]; ok {
+			out = append(out, postgres.NodeRow{Level: levels[i], Idx: idxs[i], Hash: h})
+		}
+	}
+	return out, nil
+}
+
+// auditedBucket returns what an audit reads for a sealed three-item bucket
+// whose stored rows all agree.
+func auditedBucket(t *testing.T) (*evidence.Bundle, postgres.FrontierRow, *memNodes) {
+	t.Helper()
+	b := &evidence.Bundle{
+		Format:     evidence.Format,
+		EntityKind: "issue",
+		EntityKey:  "gh#1",
+		BucketKey:  "2025-08-22",
+		TreeAlg:    uint8(crypto.TreeAlgRFC6962),
+		Anchor:     evidence.Anchor{Status: StatusNeedsAnchoring},
+	}
+	m := &memNodes{nodes: map[crypto.NodeRef][]byte{}, roots: map[int32][]byte{}}
+	f := crypto.NewFrontier(crypto.TreeAlgRFC6962)
+	var leaves [][]byte
+	for i, typ := range []string{"IssueComment", "LabeledEvent", "ClosedEvent"} {
+		it := evidence.Item{
+			Provider:        "github",
+			ProviderEventID: typ,
+			Type:            typ,
+			CreatedAt:       time.Date(2025, 8, 22, 10, i, 0, 0, time.UTC),
+			Payload:         json.RawMessage(`{"n":1}`),
+			CanonVersion:    uint8(crypto.DefaultCanon),
+		}
+		canon, err := it.Canon()
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, h, err := crypto.HashDAGCBOR(canon)
+		if err != nil {
+			t.Fatal(err)
+		}
+		it.ItemHash = h
+		b.Items = append(b.Items, it)
+		b.Leaves = append(b.Leaves, h)
+		leaves = append(leaves, h)
+		m.nodes[crypto.NodeRef{Level: 0, Index: uint64(i)}] = h
+		for _, nd := range f.Append(h) {
+			m.nodes[crypto.NodeRef{Level: nd.Level, Index: nd.Index}] = nd.Hash
+		}
+		m.roots[int32(f.Size)] = f.Root()
+	}
+	b.LeafCount, b.RootHash = uint32(len(leaves)), f.Root()
+	for i, it := range b.Items {
+		_, path, _ := crypto.BuildProof(crypto.TreeAlgRFC6962, leaves, i)
+		p := evidence.Proof{ProviderEventID: it.ProviderEventID, LeafIndex: uint32(i)}
+		for _, st := range path {
+			p.Path = append(p.Path, evidence.Step{Sibling: st.Sibling, SiblingIsLeft: st.SiblingIsLeft})
+		}
+		b.Proofs = append(b.Proofs, p)
+	}
+	fr := postgres.FrontierRow{LeafCount: int32(f.Size), Frontier: f.Encode(), TreeAlg: int16(crypto.TreeAlgRFC6962), Status: StatusNeedsAnchoring}
+	return b, fr, m
+}
+
+func failed(rs []evidence.Result) map[string]bool {
+	out := map[string]bool{}
+	for _, r := range rs {
+		if r.Err != nil {
+			out[r.Check] = true
+		}
+	}
+	return out
+}
+
+func TestAuditChecks(t *testing.T) {
+	ctx := context.Background()
+	b, fr, m := auditedBucket(t)
+	if rs := auditChecks(ctx, m, b, fr, nil); !evidence.OK(rs) {
+		t.Fatalf("auditChecks() = %+v, want all ok", rs)
+	}
+
+	for _, tc := range []struct {
+		name    string
+		corrupt func(b *evidence.Bundle, m *memNodes)
+		want    []string
+	}{
+		{"leaf", func(b *evidence.Bundle, m *memNodes) {
+			b.Leaves[1] = append(evidence.Hex(nil), b.Leaves[1]...)
+			b.Leaves[1][0] ^= 1
+			m.nodes[crypto.NodeRef{Level: 0, Index: 1}] = b.Leaves[1]
+		}, []string{"items", "root"}},
+		{"node", func(_ *evidence.Bundle, m *memNodes) {
+			n := append([]byte(nil), m.nodes[crypto.NodeRef{Level: 1, Index: 0}]...)
+			n[0] ^= 1
+			m.nodes[crypto.NodeRef{Level: 1, Index: 0}] = n
+		}, []string{"recorded_root"}},
+		{"stored root", func(b *evidence.Bundle, _ *memNodes) {
+			b.RootHash = append(evidence.Hex(nil), b.RootHash...)
+			b.RootHash[0] ^= 1
+		}, []string{"root", "frontier", "recorded_root"}},
+		{"recorded root", func(_ *evidence.Bundle, m *memNodes) {
+			m.roots[3] = make([]byte, 32)
+		}, []string{"recorded_root"}},
+	} {
+		b, fr, m := auditedBucket(t)
+		tc.corrupt(b, m)
+		got := failed(auditChecks(ctx, m, b, fr, nil))
+		for _, c := range tc.want {
+			if !got[c] {
+				t.Errorf("corrupt %s: check %s passed, want it reported (failed: %v)", tc.name, c, got)
+			}
+		}
+	}
+}
@@ -3,14 +3,23 @@ package service
 import (
 	"bytes"
 	"context"
+	"crypto/ed25519"
+	"crypto/x509"
 	"errors"
 	"fmt"
+	"io"
 	"strconv"
+	"strings"
 	"time"
 
 	bucketv1 "github.com/gusplusbus/trustflow/data_server/gen/bucketv1"
+	"github.com/gusplusbus/trustflow/data_server/internal/blobstore"
+	"github.com/gusplusbus/trustflow/data_server/internal/car"
+	"github.com/gusplusbus/trustflow/data_server/internal/evidence"
 	"github.com/gusplusbus/trustflow/data_server/internal/repo/postgres"
+	"github.com/gusplusbus/trustflow/data_server/internal/service/bucketing"
 	"github.com/gusplusbus/trustflow/data_server/internal/service/crypto"
+	"github.com/jackc/pgx/v5"
 )
 
 // ---- DTO ----
@@ -26,10 +35,23 @@ type BucketDTO struct {
 	ClosedAt   *time.Time
 	AnchoredTx *string
 	AnchoredAt *time.Time
+	TreeAlg    int16
+	Checkpoint *postgres.CheckpointRow // latest ledger signature, if loaded
+	Policy     string
+	Amends     *string
+	PrevRoot   []byte
+	ChainSeq   *int32
+	LogSize    *int32
+	LogRoot    []byte
+	AnchoredBlock     *int64
+	AnchoredBlockHash *string
+	AnchorProof       []byte
 }
 
 func (b BucketDTO) ToProto() *bucketv1.BucketInfo {
-	var cid, tx, closed, anchored string
+	var cid, tx, closed, anchored, amends, blockHash string
+	var chainSeq, logSize uint32
+	var block uint64
 	if b.CID != nil {
 		cid = *b.CID
 	}
@@ -42,6 +64,21 @@ func (b BucketDTO) ToProto() *bucketv1.BucketInfo {
 	if b.AnchoredAt != nil {
 		anchored = b.AnchoredAt.UTC().Format(time.RFC3339)
 	}
+	if b.Amends != nil {
+		amends = *b.Amends
+	}
+	if b.ChainSeq != nil {
+		chainSeq = uint32(*b.ChainSeq)
+	}
+	if b.LogSize != nil {
+		logSize = uint32(*b.LogSize)
+	}
+	if b.AnchoredBlock != nil {
+		block = uint64(*b.AnchoredBlock)
+	}
+	if b.AnchoredBlockHash != nil {
+		blockHash = *b.AnchoredBlockHash
+	}
 
 	return &bucketv1.BucketInfo{
 		Ref: &bucketv1.BucketRef{
@@ -55,16 +92,37 @@ func (b BucketDTO) ToProto() *bucketv1.BucketInfo {
 		AnchoredTx: tx,
 		AnchoredAt: anchored,
 		ClosedAt:   closed,
+		TreeAlg:    uint32(b.TreeAlg),
+		Checkpoint: checkpointToProto(b.Checkpoint),
+		Policy:     b.Policy,
+		Amends:     amends,
+		PrevRoot:   b.PrevRoot,
+		ChainSeq:   chainSeq,
+		LogSize:    logSize,
+		LogRoot:    b.LogRoot,
+		AnchoredBlock:     block,
+		AnchoredBlockHash: blockHash,
+		AnchorProof:       b.AnchorProof,
 	}
 }
 
 // ---- Service ----
 
 type BucketService struct {
-	repo *postgres.BucketRepo
+	repo     *postgres.BucketRepo
+	blobs    *blobstore.Dir // CAR exports
+	tsaRoots *x509.CertPool // audits of tsa anchors; nil => system roots
 }
 
-func NewBucketService(r *postgres.BucketRepo) *BucketService { return &BucketService{repo: r} }
+func NewBucketService(r *postgres.BucketRepo, blobs *blobstore.Dir) *BucketService {
+	return &BucketService{repo: r, blobs: blobs}
+}
+
+// WithTSARoots sets the CAs the auditor checks tsa anchor tokens against.
+func (s *BucketService) WithTSARoots(roots *x509.CertPool) *BucketService {
+	s.tsaRoots = roots
+	return s
+}
 
 func (s *BucketService) ListBuckets(ctx context.Context, scope bucketv1.Scope, limit, offset int32) ([]BucketDTO, error) {
 	rows, err := s.repo.ListByScope(ctx, scope.GetEntityKind(), scope.GetEntityKey(), limit, offset)
@@ -75,6 +133,9 @@ func (s *BucketService) ListBuckets(ctx context.Context, scope bucketv1.Scope, l
 	for _, r := range rows {
 		out = append(out, rowToDTO(r))
 	}
+	if err := s.attachCheckpoints(ctx, scope.GetEntityKind(), scope.GetEntityKey(), out); err != nil {
+		return nil, err
+	}
 	return out, nil
 }
 
@@ -83,23 +144,178 @@ func (s *BucketService) GetBucket(ctx context.Context, ref bucketv1.BucketRef) (
 	if err != nil {
 		return BucketDTO{}, err
 	}
-	return rowToDTO(r), nil
+	out := []BucketDTO{rowToDTO(r)}
+	if err := s.attachCheckpoints(ctx, r.EntityKind, r.EntityKey, out); err != nil {
+		return BucketDTO{}, err
+	}
+	return out[0], nil
 }
 
-func (s *BucketService) MarkBucketClosed(ctx context.Context, ref bucketv1.BucketRef) (BucketDTO, error) {
-	r, err := s.repo.MarkClosed(ctx, ref.GetScope().GetEntityKind(), ref.GetScope().GetEntityKey(), ref.GetBucketKey())
+// AddCheckpoint stores a ledger signature after checking it covers a root the
+// bucket actually published. The signature itself is checked by verifiers
+// against the ledger's published keys.
+func (s *BucketService) AddCheckpoint(ctx context.Context, ref bucketv1.BucketRef, cp *bucketv1.Checkpoint) error {
+	kind, key, bkey := ref.GetScope().GetEntityKind(), ref.GetScope().GetEntityKey(), ref.GetBucketKey()
+	if cp.GetKeyId() == "" || len(cp.GetSignature()) != ed25519.SignatureSize {
+		return errors.New("checkpoint needs key_id and an Ed25519 signature")
+	}
+	b, err := s.repo.GetBucket(ctx, kind, key, bkey)
+	if err != nil {
+		return err
+	}
+	if uint32(b.TreeAlg) != cp.GetTreeAlg() {
+		return fmt.Errorf("checkpoint tree_alg %d, bucket uses %d", cp.GetTreeAlg(), b.TreeAlg)
+	}
+	root, err := s.repo.GetRoot(ctx, kind, key, bkey, int32(cp.GetLeafCount()))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("no root was published at leaf_count %d", cp.GetLeafCount())
+	}
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(root, cp.GetRootHash()) {
+		return errors.New("checkpoint root does not match the published root")
+	}
+	return s.repo.InsertCheckpoint(ctx, kind, key, postgres.CheckpointRow{
+		BucketKey: bkey,
+		LeafCount: int32(cp.GetLeafCount()),
+		RootHash:  root,
+		TreeAlg:   b.TreeAlg,
+		KeyID:     cp.GetKeyId(),
+		Signature: cp.GetSignature(),
+	})
+}
+
+func (s *BucketService) attachCheckpoints(ctx context.Context, entityKind, entityKey string, dtos []BucketDTO) error {
+	if len(dtos) == 0 {
+		return nil
+	}
+	keys := make([]string, len(dtos))
+	for i, d := range dtos {
+		keys[i] = d.BucketKey
+	}
+	cps, err := s.repo.LatestCheckpoints(ctx, entityKind, entityKey, keys)
+	if err != nil {
+		return err
+	}
+	for i := range dtos {
+		if c, ok := cps[dtos[i].BucketKey]; ok {
+			dtos[i].Checkpoint = &c
+		}
+	}
+	return nil
+}
+
+// SetPolicy validates and stores a scope's bucketing policy; "" removes it.
+// Existing buckets keep the policy they were created with.
+func (s *BucketService) SetPolicy(ctx context.Context, scope bucketv1.Scope, policy string) (string, error) {
+	kind, key := scope.GetEntityKind(), scope.GetEntityKey()
+	if kind == "" || key == "" {
+		return "", errors.New("scope required")
+	}
+	if policy == "" {
+		return "", s.repo.DeletePolicy(ctx, kind, key)
+	}
+	p, err := bucketing.Parse(policy)
+	if err != nil {
+		return "", err
+	}
+	return p.String(), s.repo.SetPolicy(ctx, kind, key, p.String())
+}
+
+// ScopeProject returns the project a scope belongs to, or "" when none: a
+// project scope is its own, an issue scope (gh#<id>) belongs to the project
+// that imported the issue first.
+func (s *BucketService) ScopeProject(ctx context.Context, scope bucketv1.Scope) (string, error) {
+	kind, key := scope.GetEntityKind(), scope.GetEntityKey()
+	switch kind {
+	case "project":
+		return key, nil
+	case "issue":
+		id, err := strconv.ParseInt(strings.TrimPrefix(key, "gh#"), 10, 64)
+		if err != nil || !strings.HasPrefix(key, "gh#") {
+			return "", nil
+		}
+		p, err := s.repo.IssueProject(ctx, id)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", nil
+		}
+		return p, err
+	}
+	return "", nil
+}
+
+// MarkBucketClosed seals an open bucket (see sealBucket).
+func (s *BucketService) MarkBucketClosed(ctx context.Context, ref bucketv1.BucketRef, actor, reason string) (BucketDTO, error) {
+	if actor == "" {
+		return BucketDTO{}, errors.New("actor required")
+	}
+	r, err := sealBucket(ctx, s.repo, ref.GetScope().GetEntityKind(), ref.GetScope().GetEntityKey(), ref.GetBucketKey(), actor, reason)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return BucketDTO{}, errors.New("bucket is not open")
+	}
 	if err != nil {
 		return BucketDTO{}, err
 	}
 	return rowToDTO(r), nil
 }
 
-func (s *BucketService) SetBucketAnchored(ctx context.Context, ref bucketv1.BucketRef, cid, anchoredTx string) (BucketDTO, error) {
-	r, err := s.repo.SetAnchored(ctx, ref.GetScope().GetEntityKind(), ref.GetScope().GetEntityKey(), ref.GetBucketKey(), cid, anchoredTx)
+// SetBucketAnchorPending records the tx sent to anchor a bucket the caller
+// claimed with SetBucketStatus(anchoring), plus proof when the anchor is a
+// self-contained token. The bucket waits in anchoring_pending until
+// SetBucketAnchored confirms it.
+func (s *BucketService) SetBucketAnchorPending(ctx context.Context, ref bucketv1.BucketRef, cid, anchoredTx string, proof []byte, actor string) (BucketDTO, error) {
+	kind, key, bkey := ref.GetScope().GetEntityKind(), ref.GetScope().GetEntityKey(), ref.GetBucketKey()
+	if anchoredTx == "" || actor == "" {
+		return BucketDTO{}, errors.New("anchored_tx and actor required")
+	}
+	tx, err := s.repo.Begin(ctx)
 	if err != nil {
 		return BucketDTO{}, err
 	}
-	return rowToDTO(r), nil
+	defer tx.Rollback(ctx)
+	r, err := s.repo.SetAnchorPending(ctx, tx, kind, key, bkey, cid, anchoredTx, proof)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return BucketDTO{}, fmt.Errorf("bucket is not %s; claim it before sending its anchor", StatusAnchoring)
+	}
+	if err != nil {
+		return BucketDTO{}, err
+	}
+	if err := s.repo.InsertStatusEvent(ctx, tx, kind, key, bkey, StatusAnchoring, StatusAnchorPending, actor, "sent "+anchoredTx); err != nil {
+		return BucketDTO{}, err
+	}
+	return rowToDTO(r), tx.Commit(ctx)
+}
+
+// SetBucketAnchored marks an anchoring_pending bucket anchored once its tx
+// anchoredTx is confirmed in the given block, and the epoch sent in that tx
+// too if the bucket is an epoch member.
+func (s *BucketService) SetBucketAnchored(ctx context.Context, ref bucketv1.BucketRef, anchoredTx string, block uint64, blockHash, actor string) (BucketDTO, error) {
+	kind, key, bkey := ref.GetScope().GetEntityKind(), ref.GetScope().GetEntityKey(), ref.GetBucketKey()
+	if anchoredTx == "" || actor == "" {
+		return BucketDTO{}, errors.New("anchored_tx and actor required")
+	}
+	tx, err := s.repo.Begin(ctx)
+	if err != nil {
+		return BucketDTO{}, err
+	}
+	defer tx.Rollback(ctx)
+	r, err := s.repo.SetAnchored(ctx, tx, kind, key, bkey, anchoredTx, int64(block), blockHash)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return BucketDTO{}, fmt.Errorf("bucket is not %s with tx %s", StatusAnchorPending, anchoredTx)
+	}
+	if err != nil {
+		return BucketDTO{}, err
+	}
+	reason := fmt.Sprintf("%s confirmed in block %d", anchoredTx, block)
+	if err := s.repo.InsertStatusEvent(ctx, tx, kind, key, bkey, StatusAnchorPending, StatusAnchored, actor, reason); err != nil {
+		return BucketDTO{}, err
+	}
+	// the tx of an epoch confirms it with its first member
+	if err := s.repo.SetEpochAnchored(ctx, tx, anchoredTx); err != nil {
+		return BucketDTO{}, err
+	}
+	return rowToDTO(r), tx.Commit(ctx)
 }
 
 func (s *BucketService) InclusionProof(ctx context.Context, ref bucketv1.BucketRef, providerEventID string) (*bucketv1.InclusionProofResponse, error) {
@@ -115,29 +331,17 @@ func (s *BucketService) InclusionProof(ctx context.Context, ref bucketv1.BucketR
 		return nil, errors.New("item not in requested bucket")
 	}
 
-	// Fetch leaves in order, find index by matching hash (robust vs 0/1-based seq)
-	lrows, err := s.repo.SelectLeaves(ctx, loc.EntityKind, loc.EntityKey, loc.BucketKey)
+	fr, err := s.repo.GetFrontier(ctx, loc.EntityKind, loc.EntityKey, loc.BucketKey)
 	if err != nil {
 		return nil, err
 	}
-	if len(lrows) == 0 {
-		return nil, errors.New("no leaves in bucket")
+	alg := crypto.TreeAlg(fr.TreeAlg)
+	if !alg.Valid() {
+		return nil, fmt.Errorf("bucket has unknown tree_alg %d", fr.TreeAlg)
 	}
-	leaves := make([][]byte, len(lrows))
-	idx := -1
-	for i, v := range lrows {
-		leaves[i] = v.LeafHash
-		if bytes.Equal(v.LeafHash, loc.ItemHash) {
-			idx = i
-		}
-	}
-	if idx < 0 {
-		return nil, errors.New("leaf for item not found in bucket")
-	}
-
-	leaf, path, root := crypto.BuildProof(leaves, idx)
-	if leaf == nil {
-		return nil, errors.New("failed to build proof")
+	leaf, path, root, err := s.buildProof(ctx, loc, fr)
+	if err != nil {
+		return nil, err
 	}
 
 	steps := make([]*bucketv1.InclusionProofResponse_Step, 0, len(path))
@@ -147,13 +351,316 @@ func (s *BucketService) InclusionProof(ctx context.Context, ref bucketv1.BucketR
 			SiblingIsLeft: st.SiblingIsLeft,
 		})
 	}
+	ep, err := s.proveInEpoch(ctx, loc.EntityKind, loc.EntityKey, loc.BucketKey, fr.LeafCount, root)
+	if err != nil {
+		return nil, err
+	}
 	return &bucketv1.InclusionProofResponse{
 		LeafHash: leaf,
 		Path:     steps,
 		RootHash: root,
+		TreeAlg:  uint32(alg),
+		Epoch:    ep.toProto(),
+		Policy:   fr.Policy,
 	}, nil
 }
 
+// ConsistencyProof proves the bucket grew append-only between two published
+// roots. newSize == 0 means the current leaf count.
+func (s *BucketService) ConsistencyProof(ctx context.Context, ref bucketv1.BucketRef, oldSize, newSize uint32) (*bucketv1.ConsistencyProofResponse, error) {
+	kind, key, bkey := ref.GetScope().GetEntityKind(), ref.GetScope().GetEntityKey(), ref.GetBucketKey()
+
+	fr, err := s.repo.GetFrontier(ctx, kind, key, bkey)
+	if err != nil {
+		return nil, err
+	}
+	alg := crypto.TreeAlg(fr.TreeAlg)
+	if alg != crypto.TreeAlgRFC6962 {
+		return nil, crypto.ErrConsistencyAlg
+	}
+	if newSize == 0 {
+		newSize = uint32(fr.LeafCount)
+	}
+	if oldSize == 0 || oldSize > newSize || newSize > uint32(fr.LeafCount) {
+		return nil, fmt.Errorf("need 0 < old_size <= new_size <= %d", fr.LeafCount)
+	}
+
+	oldRoot, err := observedRoot(ctx, s.repo, kind, key, bkey, alg, oldSize)
+	if errors.Is(err, errRootMismatch) {
+		// roots published while the bucket was open were over arrival order
+		if b, gerr := s.repo.GetBucket(ctx, kind, key, bkey); gerr == nil && crypto.LeafOrder(b.LeafOrder) == crypto.LeafCanonical {
+			return nil, fmt.Errorf("the root published at leaf_count %d was over arrival order; the bucket's leaves were put in canonical order when it was sealed, so no consistency proof links it to later roots", oldSize)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	newRoot, err := observedRoot(ctx, s.repo, kind, key, bkey, alg, newSize)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes, err := loadNodes(ctx, s.repo, kind, key, bkey, crypto.ConsistencyRefs(uint64(oldSize), uint64(newSize)))
+	if err != nil {
+		return nil, err
+	}
+	proof, err := crypto.ConsistencyProof(alg, uint64(oldSize), uint64(newSize), nodes)
+	if err != nil {
+		return nil, err
+	}
+	return &bucketv1.ConsistencyProofResponse{
+		OldSize: oldSize,
+		NewSize: newSize,
+		OldRoot: oldRoot,
+		NewRoot: newRoot,
+		Proof:   proof,
+		TreeAlg: uint32(alg),
+	}, nil
+}
+
+// errRootMismatch means a recorded root is not the root of the stored nodes.
+var errRootMismatch = errors.New("does not match stored nodes")
+
+// nodeReader is the part of *postgres.BucketRepo that reads a bucket's
+// stored nodes and recorded roots.
+type nodeReader interface {
+	GetRoot(ctx context.Context, entityKind, entityKey, bucketKey string, leafCount int32) ([]byte, error)
+	SelectNodes(ctx context.Context, entityKind, entityKey, bucketKey string, levels []int32, idxs []int64) ([]postgres.NodeRow, error)
+}
+
+// observedRoot returns the root recorded at size leaves, after checking it
+// still matches the stored nodes.
+func observedRoot(ctx context.Context, repo nodeReader, kind, key, bkey string, alg crypto.TreeAlg, size uint32) ([]byte, error) {
+	root, err := repo.GetRoot(ctx, kind, key, bkey, int32(size))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("no root was published at leaf_count %d", size)
+	}
+	if err != nil {
+		return nil, err
+	}
+	nodes, err := loadNodes(ctx, repo, kind, key, bkey, crypto.RootRefs(uint64(size)))
+	if err != nil {
+		return nil, err
+	}
+	got, err := crypto.RootAt(alg, uint64(size), nodes)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(got, root) {
+		return nil, fmt.Errorf("recorded root at leaf_count %d: %w", size, errRootMismatch)
+	}
+	return root, nil
+}
+
+// ExportBucket gathers a bucket's items, leaves, per-item proofs and anchor
+// record (with the epoch proof, if the bucket was rolled up) into a bundle that trustflow-verify can check offline.
+func (s *BucketService) ExportBucket(ctx context.Context, ref bucketv1.BucketRef) (*evidence.Bundle, error) {
+	kind, key, bkey := ref.GetScope().GetEntityKind(), ref.GetScope().GetEntityKey(), ref.GetBucketKey()
+
+	b, err := s.repo.GetBucket(ctx, kind, key, bkey)
+	if err != nil {
+		return nil, err
+	}
+	alg := crypto.TreeAlg(b.TreeAlg)
+	if !alg.Valid() {
+		return nil, fmt.Errorf("bucket has unknown tree_alg %d", b.TreeAlg)
+	}
+	lrows, err := s.repo.SelectLeaves(ctx, kind, key, bkey)
+	if err != nil {
+		return nil, err
+	}
+	irows, err := s.repo.SelectItems(ctx, kind, key, bkey)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &evidence.Bundle{
+		Format:     evidence.Format,
+		EntityKind: kind,
+		EntityKey:  key,
+		BucketKey:  bkey,
+		TreeAlg:    uint8(alg),
+		Policy:     b.Policy,
+		RootHash:   b.RootHash,
+		LeafCount:  uint32(b.LeafCount),
+		Anchor: evidence.Anchor{
+			Status:     b.Status,
+			ClosedAt:   b.ClosedAt,
+			AnchoredAt: b.AnchoredAt,
+		},
+	}
+	if b.CID != nil {
+		out.Anchor.CID = *b.CID
+	}
+	if b.AnchoredTx != nil {
+		out.Anchor.AnchoredTx = *b.AnchoredTx
+	}
+	if b.AnchoredBlock != nil {
+		out.Anchor.Block = uint64(*b.AnchoredBlock)
+	}
+	if b.AnchoredBlockHash != nil {
+		out.Anchor.BlockHash = *b.AnchoredBlockHash
+	}
+	out.Anchor.Proof = b.AnchorProof
+	if b.Amends != nil {
+		out.Amends = *b.Amends
+	}
+	if link := chainLink(b); link != nil {
+		out.ChainSeq, out.PrevRoot = link.Seq, link.PrevRoot
+		out.LogSize, out.LogRoot = link.LogSize, link.LogRoot
+	}
+	out.LeafOrder = uint8(b.LeafOrder)
+
+	leaves := make([][]byte, len(lrows))
+	index := make(map[string]int, len(lrows))
+	for i, l := range lrows {
+		leaves[i] = l.LeafHash
+		out.Leaves = append(out.Leaves, l.LeafHash)
+		if _, seen := index[string(l.LeafHash)]; !seen {
+			index[string(l.LeafHash)] = i
+		}
+	}
+	for _, it := range irows {
+		out.Items = append(out.Items, evidence.Item{
+			Provider:        it.Provider,
+			ProviderEventID: it.ProviderEventID,
+			Type:            it.Type,
+			Actor:           it.Actor,
+			CreatedAt:       it.CreatedAt.UTC(),
+			Payload:         it.PayloadJSON,
+			ItemHash:        it.ItemHash,
+			CanonVersion:    uint8(it.CanonVersion),
+		})
+		i, ok := index[string(it.ItemHash)]
+		if !ok {
+			continue // the verifier reports items without a leaf
+		}
+		_, path, _ := crypto.BuildProof(alg, leaves, i)
+		p := evidence.Proof{ProviderEventID: it.ProviderEventID, LeafIndex: uint32(i)}
+		for _, st := range path {
+			p.Path = append(p.Path, evidence.Step{Sibling: st.Sibling, SiblingIsLeft: st.SiblingIsLeft})
+		}
+		out.Proofs = append(out.Proofs, p)
+	}
+
+	ep, err := s.proveInEpoch(ctx, kind, key, bkey, b.LeafCount, b.RootHash)
+	if err != nil {
+		return nil, err
+	}
+	if ep != nil {
+		out.Anchor.Epoch = &evidence.Epoch{
+			ID:         ep.Epoch.ID,
+			Period:     ep.Epoch.Period,
+			RootHash:   ep.Epoch.RootHash,
+			LeafIndex:  uint32(ep.Member.LeafIndex),
+			Status:     ep.Epoch.Status,
+			AnchoredAt: ep.Epoch.AnchoredAt,
+			Proof:      ep.Epoch.AnchorProof,
+		}
+		if ep.Epoch.CID != nil {
+			out.Anchor.Epoch.CID = *ep.Epoch.CID
+		}
+		if ep.Epoch.AnchoredTx != nil {
+			out.Anchor.Epoch.AnchoredTx = *ep.Epoch.AnchoredTx
+		}
+		for _, st := range ep.Path {
+			out.Anchor.Epoch.Path = append(out.Anchor.Epoch.Path, evidence.Step{Sibling: st.Sibling, SiblingIsLeft: st.SiblingIsLeft})
+		}
+	}
+
+	return out, nil
+}
+
+// PackBucket encodes the bucket as DAG-CBOR (a manifest block linking every
+// item block), writes it as a CARv1 named <cid>.car to the blob directory and
+// records and returns the manifest CID. Items are re-encoded and checked against their
+// stored hashes first, so the CAR only ever contains what the root commits to.
+func (s *BucketService) PackBucket(ctx context.Context, ref bucketv1.BucketRef) (*bucketv1.PackBucketResponse, error) {
+	kind, key, bkey := ref.GetScope().GetEntityKind(), ref.GetScope().GetEntityKey(), ref.GetBucketKey()
+
+	b, err := s.repo.GetBucket(ctx, kind, key, bkey)
+	if err != nil {
+		return nil, err
+	}
+	alg := crypto.TreeAlg(b.TreeAlg)
+	if !alg.Valid() {
+		return nil, fmt.Errorf("bucket has unknown tree_alg %d", b.TreeAlg)
+	}
+	lrows, err := s.repo.SelectLeaves(ctx, kind, key, bkey)
+	if err != nil {
+		return nil, err
+	}
+	irows, err := s.repo.SelectItems(ctx, kind, key, bkey)
+	if err != nil {
+		return nil, err
+	}
+
+	itemBlocks := make(map[string][]byte, len(irows))
+	for _, it := range irows {
+		canon, err := crypto.CanonicalItem(crypto.CanonVersion(it.CanonVersion), it.Provider, it.ProviderEventID, it.Type, it.Actor, it.CreatedAt, it.PayloadJSON)
+		if err != nil {
+			return nil, err
+		}
+		block, h, err := crypto.HashDAGCBOR(canon)
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(h, it.ItemHash) {
+			return nil, fmt.Errorf("item %s no longer matches its stored hash", it.ProviderEventID)
+		}
+		itemBlocks[string(h)] = block
+	}
+
+	leaves := make([][]byte, len(lrows))
+	for i, l := range lrows {
+		leaves[i] = l.LeafHash
+	}
+	link := chainLink(b)
+	m, err := crypto.NewBucketManifest(kind, key, bkey, alg, b.RootHash, leaves, link, crypto.LeafOrder(b.LeafOrder))
+	if err != nil {
+		return nil, err
+	}
+	mblock, root, err := crypto.EncodeManifest(m)
+	if err != nil {
+		return nil, err
+	}
+
+	itemLeaves := leaves
+	if link != nil && len(leaves) > 0 {
+		if !bytes.Equal(leaves[len(leaves)-1], crypto.ChainLeaf(kind, key, *link)) {
+			return nil, errors.New("last leaf is not the bucket's chain link")
+		}
+		itemLeaves = leaves[:len(leaves)-1]
+	}
+	blocks := []car.Block{{CID: root, Data: mblock}}
+	seen := map[string]bool{}
+	for _, l := range itemLeaves {
+		data, ok := itemBlocks[string(l)]
+		if !ok {
+			return nil, fmt.Errorf("leaf %x has no item", l)
+		}
+		if seen[string(l)] {
+			continue
+		}
+		seen[string(l)] = true
+		c, err := crypto.ItemCID(l)
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, car.Block{CID: c, Data: data})
+	}
+
+	path, size, err := s.blobs.Put(root.String()+".car", func(w io.Writer) error {
+		return car.WriteV1(w, root, blocks)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.SetCID(ctx, kind, key, bkey, root.String()); err != nil {
+		return nil, err
+	}
+	return &bucketv1.PackBucketResponse{Cid: root.String(), CarPath: path, CarSize: uint64(size)}, nil
+}
 
 func (s *BucketService) ListByStatus(
 	ctx context.Context,
@@ -192,6 +699,100 @@ func (s *BucketService) ListByStatus(
 
 // ---- helpers ----
 
+// buildProof reads only the O(log n) nodes the proof needs from the stored
+// frontier; buckets without one fall back to loading every leaf.
+func (s *BucketService) buildProof(ctx context.Context, loc postgres.ItemLoc, fr postgres.FrontierRow) ([]byte, []crypto.ProofStep, []byte, error) {
+	alg := crypto.TreeAlg(fr.TreeAlg)
+	if fr.LeafCount == 0 {
+		return nil, nil, nil, errors.New("no leaves in bucket")
+	}
+	if fr.Frontier == nil {
+		return s.buildProofFromLeaves(ctx, loc, alg)
+	}
+	f, err := crypto.DecodeFrontier(alg, uint64(fr.LeafCount), fr.Frontier)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	idx, err := s.repo.GetLeafIndex(ctx, loc.EntityKind, loc.EntityKey, loc.BucketKey, loc.ItemHash)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil, nil, errors.New("leaf for item not found in bucket")
+	}
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	nodes, err := loadNodes(ctx, s.repo, loc.EntityKind, loc.EntityKey, loc.BucketKey, f.ProofRefs(uint64(idx)))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	path, root, err := f.Proof(uint64(idx), nodes)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return loc.ItemHash, path, root, nil
+}
+
+func loadNodes(ctx context.Context, repo nodeReader, entityKind, entityKey, bucketKey string, refs []crypto.NodeRef) (map[crypto.NodeRef][]byte, error) {
+	levels := make([]int32, len(refs))
+	idxs := make([]int64, len(refs))
+	for i, r := range refs {
+		levels[i], idxs[i] = int32(r.Level), int64(r.Index)
+	}
+	rows, err := repo.SelectNodes(ctx, entityKind, entityKey, bucketKey, levels, idxs)
+	if err != nil {
+		return nil, err
+	}
+	nodes := make(map[crypto.NodeRef][]byte, len(rows))
+	for _, r := range rows {
+		nodes[crypto.NodeRef{Level: int(r.Level), Index: uint64(r.Idx)}] = r.Hash
+	}
+	return nodes, nil
+}
+
+func (s *BucketService) buildProofFromLeaves(ctx context.Context, loc postgres.ItemLoc, alg crypto.TreeAlg) ([]byte, []crypto.ProofStep, []byte, error) {
+	// Fetch leaves in order, find index by matching hash (robust vs 0/1-based seq)
+	lrows, err := s.repo.SelectLeaves(ctx, loc.EntityKind, loc.EntityKey, loc.BucketKey)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if len(lrows) == 0 {
+		return nil, nil, nil, errors.New("no leaves in bucket")
+	}
+	leaves := make([][]byte, len(lrows))
+	idx := -1
+	for i, v := range lrows {
+		leaves[i] = v.LeafHash
+		if idx < 0 && bytes.Equal(v.LeafHash, loc.ItemHash) {
+			idx = i
+		}
+	}
+	if idx < 0 {
+		return nil, nil, nil, errors.New("leaf for item not found in bucket")
+	}
+
+	leaf, path, root := crypto.BuildProof(alg, leaves, idx)
+	if leaf == nil {
+		return nil, nil, nil, errors.New("failed to build proof")
+	}
+	return leaf, path, root, nil
+}
+
+func checkpointToProto(c *postgres.CheckpointRow) *bucketv1.Checkpoint {
+	if c == nil {
+		return nil
+	}
+	return &bucketv1.Checkpoint{
+		LeafCount: uint32(c.LeafCount),
+		RootHash:  c.RootHash,
+		TreeAlg:   uint32(c.TreeAlg),
+		KeyId:     c.KeyID,
+		Signature: c.Signature,
+		SignedAt:  c.SignedAt.UTC().Format(time.RFC3339),
+	}
+}
+
 func rowToDTO(r postgres.BucketRow) BucketDTO {
 	return BucketDTO{
 		EntityKind: r.EntityKind,
@@ -204,5 +805,15 @@ func rowToDTO(r postgres.BucketRow) BucketDTO {
 		ClosedAt:   r.ClosedAt,
 		AnchoredTx: r.AnchoredTx,
 		AnchoredAt: r.AnchoredAt,
+		TreeAlg:    r.TreeAlg,
+		Policy:     r.Policy,
+		Amends:     r.Amends,
+		PrevRoot:   r.PrevRoot,
+		ChainSeq:   r.ChainSeq,
+		LogSize:    r.LogSize,
+		LogRoot:    r.LogRoot,
+		AnchoredBlock:     r.AnchoredBlock,
+		AnchoredBlockHash: r.AnchoredBlockHash,
+		AnchorProof:       r.AnchorProof,
 	}
 }
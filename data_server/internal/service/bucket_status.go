@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	bucketv1 "github.com/gusplusbus/trustflow/data_server/gen/bucketv1"
+	"github.com/gusplusbus/trustflow/data_server/internal/repo/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+// Bucket statuses. A bucket is sealed open -> needs_anchoring, claimed by
+// the ledger (anchoring), alone or for an epoch, and ends anchored, or failed. Its
+// anchor tx, or its epoch's, first waits in anchoring_pending for
+// confirmations, and goes back to needs_anchoring if it is dropped or
+// reorged out. A bucket leaving anchoring or anchoring_pending unanchored
+// fails the epoch it is in, and joins a new one when it is retried. A
+// failed bucket goes back to needs_anchoring to be retried, or to
+// anchor_failed once the ledger gives up on it, where it waits for an
+// operator to re-drive it.
+const (
+	StatusOpen           = "open"
+	StatusNeedsAnchoring = "needs_anchoring"
+	StatusAnchoring      = "anchoring"
+	StatusAnchorPending  = "anchoring_pending"
+	StatusAnchored       = "anchored"
+	StatusFailed         = "failed"
+	StatusAnchorFailed   = "anchor_failed"
+)
+
+// actorDataServer records transitions the data server makes on its own
+// (auto-seal).
+const actorDataServer = "data_server"
+
+var bucketTransitions = map[string][]string{
+	StatusOpen:           {StatusNeedsAnchoring},
+	StatusNeedsAnchoring: {StatusAnchoring},
+	StatusAnchoring:      {StatusAnchorPending, StatusFailed},
+	StatusAnchorPending:  {StatusAnchored, StatusNeedsAnchoring, StatusFailed},
+	StatusFailed:         {StatusNeedsAnchoring, StatusAnchorFailed},
+	StatusAnchorFailed:   {StatusNeedsAnchoring},
+}
+
+func checkTransition(from, to string) error {
+	for _, s := range bucketTransitions[from] {
+		if s == to {
+			return nil
+		}
+	}
+	return fmt.Errorf("bucket status cannot go from %s to %s", from, to)
+}
+
+// transition moves a locked-in-tx bucket from one status to another and
+// records it. The bucket must still be in from.
+func transition(ctx context.Context, repo *postgres.BucketRepo, tx pgx.Tx, entityKind, entityKey, bKey, from, to, actor, reason string) (postgres.BucketRow, error) {
+	if err := checkTransition(from, to); err != nil {
+		return postgres.BucketRow{}, err
+	}
+	b, err := repo.SetStatus(ctx, tx, entityKind, entityKey, bKey, from, to)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return b, fmt.Errorf("bucket %s/%s/%s is no longer %s", entityKind, entityKey, bKey, from)
+	}
+	if err != nil {
+		return b, err
+	}
+	if (from == StatusAnchoring || from == StatusAnchorPending) && to != StatusAnchorPending {
+		if err := repo.FailBucketEpoch(ctx, tx, entityKind, entityKey, bKey); err != nil {
+			return b, err
+		}
+	}
+	return b, repo.InsertStatusEvent(ctx, tx, entityKind, entityKey, bKey, from, to, actor, reason)
+}
+
+// SetBucketStatus claims a needs_anchoring bucket for anchoring, marks an
+// anchoring or anchoring_pending bucket failed, gives a failed one up
+// (anchor_failed) or sends a failed, given up or unconfirmed one back to
+// needs_anchoring.
+// Sealing and anchoring have their own calls (MarkBucketClosed,
+// SetBucketAnchorPending, SetBucketAnchored) since they record more than
+// the status.
+func (s *BucketService) SetBucketStatus(ctx context.Context, ref bucketv1.BucketRef, status, actor, reason string) (BucketDTO, error) {
+	kind, key, bkey := ref.GetScope().GetEntityKind(), ref.GetScope().GetEntityKey(), ref.GetBucketKey()
+	if actor == "" {
+		return BucketDTO{}, errors.New("actor required")
+	}
+	switch status {
+	case StatusNeedsAnchoring, StatusAnchoring, StatusFailed, StatusAnchorFailed:
+	case StatusAnchorPending:
+		return BucketDTO{}, errors.New("use SetBucketAnchorPending to record a sent anchor tx")
+	case StatusAnchored:
+		return BucketDTO{}, errors.New("use SetBucketAnchored to mark a bucket anchored")
+	default:
+		return BucketDTO{}, fmt.Errorf("unknown bucket status %q", status)
+	}
+	cur, err := s.repo.GetBucket(ctx, kind, key, bkey)
+	if err != nil {
+		return BucketDTO{}, err
+	}
+	if cur.Status == StatusOpen {
+		return BucketDTO{}, errors.New("bucket is open; use MarkBucketClosed to seal it")
+	}
+
+	tx, err := s.repo.Begin(ctx)
+	if err != nil {
+		return BucketDTO{}, err
+	}
+	defer tx.Rollback(ctx)
+	b, err := transition(ctx, s.repo, tx, kind, key, bkey, cur.Status, status, actor, reason)
+	if err != nil {
+		return BucketDTO{}, err
+	}
+	return rowToDTO(b), tx.Commit(ctx)
+}
+
+// ListBucketHistory returns a bucket's status transitions, oldest first.
+func (s *BucketService) ListBucketHistory(ctx context.Context, ref bucketv1.BucketRef) ([]*bucketv1.BucketStatusEvent, error) {
+	rows, err := s.repo.ListStatusEvents(ctx, ref.GetScope().GetEntityKind(), ref.GetScope().GetEntityKey(), ref.GetBucketKey())
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*bucketv1.BucketStatusEvent, 0, len(rows))
+	for _, e := range rows {
+		out = append(out, &bucketv1.BucketStatusEvent{
+			FromStatus: e.FromStatus,
+			ToStatus:   e.ToStatus,
+			Actor:      e.Actor,
+			Reason:     e.Reason,
+			At:         e.At.UTC().Format(time.RFC3339),
+		})
+	}
+	return out, nil
+}
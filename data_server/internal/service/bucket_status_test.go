@@ -0,0 +1,33 @@
+package service
+
+import "testing"
+
+func TestCheckTransition(t *testing.T) {
+	statuses := []string{
+		StatusOpen, StatusNeedsAnchoring, StatusAnchoring, StatusAnchorPending,
+		StatusAnchored, StatusFailed, StatusAnchorFailed,
+	}
+	allowed := map[[2]string]bool{
+		{StatusOpen, StatusNeedsAnchoring}:          true,
+		{StatusNeedsAnchoring, StatusAnchoring}:     true,
+		{StatusAnchoring, StatusAnchorPending}:      true,
+		{StatusAnchoring, StatusFailed}:             true,
+		{StatusAnchorPending, StatusAnchored}:       true,
+		{StatusAnchorPending, StatusNeedsAnchoring}: true,
+		{StatusAnchorPending, StatusFailed}:         true,
+		{StatusFailed, StatusNeedsAnchoring}:        true,
+		{StatusFailed, StatusAnchorFailed}:          true,
+		{StatusAnchorFailed, StatusNeedsAnchoring}:  true,
+	}
+	for _, from := range statuses {
+		for _, to := range statuses {
+			err := checkTransition(from, to)
+			if want := allowed[[2]string{from, to}]; want != (err == nil) {
+				t.Errorf("checkTransition(%s, %s) error = %v, want allowed=%v", from, to, err, want)
+			}
+		}
+	}
+	if err := checkTransition("closed", StatusNeedsAnchoring); err == nil {
+		t.Error("checkTransition from an unknown status succeeded")
+	}
+}
@@ -0,0 +1,100 @@
+// Package bucketing decides which bucket a timeline item lands in: a UTC
+// hour, day or ISO week window, or a size-capped bucket that rolls over after
+// a fixed number of leaves.
+package bucketing
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Kind string
+
+const (
+	Hourly Kind = "hourly"
+	Daily  Kind = "daily"
+	Weekly Kind = "weekly"
+	Size   Kind = "size"
+)
+
+// Policy is recorded on every bucket as its String() form, e.g. "daily" or
+// "size:500".
+type Policy struct {
+	Kind      Kind
+	MaxLeaves int // Size only
+}
+
+// Default is what buckets used before policies existed.
+var Default = Policy{Kind: Daily}
+
+// Parse reads "hourly", "daily", "weekly" or "size:N" (N > 0).
+func Parse(s string) (Policy, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	switch Kind(s) {
+	case Hourly, Daily, Weekly:
+		return Policy{Kind: Kind(s)}, nil
+	}
+	if n, ok := strings.CutPrefix(s, string(Size)+":"); ok {
+		max, err := strconv.Atoi(n)
+		if err != nil || max <= 0 {
+			return Policy{}, fmt.Errorf("bucket policy %q: size needs a positive leaf cap", s)
+		}
+		return Policy{Kind: Size, MaxLeaves: max}, nil
+	}
+	return Policy{}, fmt.Errorf("unknown bucket policy %q (want hourly|daily|weekly|size:N)", s)
+}
+
+func (p Policy) String() string {
+	if p.Kind == Size {
+		return fmt.Sprintf("%s:%d", Size, p.MaxLeaves)
+	}
+	return string(p.Kind)
+}
+
+// Windowed reports whether bucket keys come from the item's created_at.
+func (p Policy) Windowed() bool { return p.Kind != Size }
+
+// Key is the bucket key of the window holding t:
+//
+//	hourly  2006-01-02T15
+//	daily   2006-01-02
+//	weekly  2006-W01 (ISO week)
+//
+// Keys of one policy sort in time order.
+func (p Policy) Key(t time.Time) string {
+	t = t.UTC()
+	switch p.Kind {
+	case Hourly:
+		return t.Format("2006-01-02T15")
+	case Weekly:
+		y, w := t.ISOWeek()
+		return fmt.Sprintf("%04d-W%02d", y, w)
+	default:
+		return t.Format("2006-01-02")
+	}
+}
+
+// SizeKey is the key of the n-th size-capped bucket of a scope (n from 1).
+// Keys sort in order only up to n = 999999; compare them by length first.
+func SizeKey(n int) string { return fmt.Sprintf("seq-%06d", n) }
+
+// ParseSizeKey returns n for a SizeKey.
+func ParseSizeKey(key string) (int, bool) {
+	s, ok := strings.CutPrefix(key, "seq-")
+	if !ok {
+		return 0, false
+	}
+	n, err := strconv.Atoi(s)
+	return n, err == nil && n > 0
+}
+
+// Closed reports whether a bucket with this key and size takes no more
+// leaves at now: its window has passed, or it reached the cap.
+func (p Policy) Closed(key string, leafCount int, now time.Time) bool {
+	if p.Kind == Size {
+		return leafCount >= p.MaxLeaves
+	}
+	return key < p.Key(now)
+}
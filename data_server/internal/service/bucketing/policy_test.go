@@ -0,0 +1,67 @@
+package bucketing
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	for in, want := range map[string]string{
+		"daily": "daily", " Hourly ": "hourly", "weekly": "weekly", "size:500": "size:500",
+	} {
+		p, err := Parse(in)
+		if err != nil || p.String() != want {
+			t.Errorf("Parse(%q) = %v, %v; want %s", in, p, err, want)
+		}
+	}
+	for _, in := range []string{"", "monthly", "size", "size:0", "size:x"} {
+		if _, err := Parse(in); err == nil {
+			t.Errorf("Parse(%q) succeeded", in)
+		}
+	}
+}
+
+func TestKeys(t *testing.T) {
+	at := time.Date(2025, 12, 29, 23, 30, 0, 0, time.FixedZone("X", -2*3600)) // 2025-12-30T01:30Z
+	for p, want := range map[Policy]string{
+		{Kind: Hourly}: "2025-12-30T01",
+		{Kind: Daily}:  "2025-12-30",
+		{Kind: Weekly}: "2026-W01", // ISO year
+	} {
+		if got := p.Key(at); got != want {
+			t.Errorf("%s.Key = %s, want %s", p, got, want)
+		}
+	}
+	if n, ok := ParseSizeKey(SizeKey(42)); !ok || n != 42 {
+		t.Errorf("ParseSizeKey(SizeKey(42)) = %d, %v", n, ok)
+	}
+	if n, ok := ParseSizeKey(SizeKey(1000000)); !ok || n != 1000000 {
+		t.Errorf("ParseSizeKey(SizeKey(1000000)) = %d, %v", n, ok)
+	}
+	// the query for a scope's latest size bucket orders by length, then key
+	last, next := SizeKey(999999), SizeKey(1000000)
+	if len(next) <= len(last) {
+		t.Errorf("%s is not longer than %s", next, last)
+	}
+	for _, key := range []string{"2025-08-22", "seq-", "seq-0", "seq--1", "seq-x", "seq-000003+amend-1"} {
+		if n, ok := ParseSizeKey(key); ok {
+			t.Errorf("ParseSizeKey(%q) = %d, want not a size key", key, n)
+		}
+	}
+}
+
+func TestClosed(t *testing.T) {
+	now := time.Date(2025, 8, 22, 10, 0, 0, 0, time.UTC)
+	daily := Policy{Kind: Daily}
+	if !daily.Closed("2025-08-21", 1, now) || daily.Closed("2025-08-22", 1, now) {
+		t.Error("daily: want only past days closed")
+	}
+	hourly := Policy{Kind: Hourly}
+	if !hourly.Closed("2025-08-22T09", 1, now) || hourly.Closed("2025-08-22T10", 1, now) {
+		t.Error("hourly: want only past hours closed")
+	}
+	size := Policy{Kind: Size, MaxLeaves: 3}
+	if size.Closed(SizeKey(1), 2, now) || !size.Closed(SizeKey(1), 3, now) {
+		t.Error("size: want closed exactly at the cap")
+	}
+}
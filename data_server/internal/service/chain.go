@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"log"
+
+	"github.com/gusplusbus/trustflow/data_server/internal/repo/postgres"
+	"github.com/gusplusbus/trustflow/data_server/internal/service/crypto"
+	"github.com/jackc/pgx/v5"
+)
+
+// sealBucket moves an open bucket to needs_anchoring as the next link of its
+// scope's chain: it puts the item leaves in canonical order, appends the
+// chain leaf committing to the previous sealed bucket's final root and to
+// the scope's current sequence log, so the bucket's own final root covers
+// both, and records the link and the transition as actor's. pgx.ErrNoRows
+// means the bucket was not open.
+func sealBucket(ctx context.Context, repo *postgres.BucketRepo, entityKind, entityKey, bKey, actor, reason string) (postgres.BucketRow, error) {
+	tx, err := repo.Begin(ctx)
+	if err != nil {
+		return postgres.BucketRow{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	// one seal per scope at a time keeps chain_seq gapless
+	if err := repo.LockScope(ctx, tx, entityKind, entityKey); err != nil {
+		return postgres.BucketRow{}, err
+	}
+	f, _, status, err := lockFrontier(ctx, repo, tx, entityKind, entityKey, bKey)
+	if err != nil {
+		return postgres.BucketRow{}, err
+	}
+	if status != StatusOpen {
+		return postgres.BucketRow{}, pgx.ErrNoRows
+	}
+	seq, prev, err := repo.ChainHead(ctx, tx, entityKind, entityKey)
+	if err != nil {
+		return postgres.BucketRow{}, err
+	}
+	_, entries, err := seqLog(ctx, repo, entityKind, entityKey, 0)
+	if err != nil {
+		return postgres.BucketRow{}, err
+	}
+	link := crypto.ChainLink{Seq: uint32(seq + 1), PrevRoot: prev}
+	var logSize *int32
+	switch {
+	case len(entries) > 0:
+		n := int32(len(entries))
+		logSize = &n
+		link.LogSize, link.LogRoot = uint32(n), crypto.BuildMerkleRoot(crypto.SeqLogAlg, entries)
+	case entries == nil:
+		log.Printf("seal %s/%s/%s: seq_in_entity has gaps or duplicates; no sequence log committed", entityKind, entityKey, bKey)
+	}
+	f, order, err := orderForSeal(ctx, repo, tx, entityKind, entityKey, bKey, f)
+	if err != nil {
+		return postgres.BucketRow{}, err
+	}
+	if err := appendLeaves(ctx, repo, tx, entityKind, entityKey, bKey, f, [][]byte{crypto.ChainLeaf(entityKind, entityKey, link)}); err != nil {
+		return postgres.BucketRow{}, err
+	}
+	b, err := repo.MarkClosed(ctx, tx, entityKind, entityKey, bKey, prev, seq+1, logSize, link.LogRoot, int16(order))
+	if err != nil {
+		return postgres.BucketRow{}, err
+	}
+	if err := repo.InsertStatusEvent(ctx, tx, entityKind, entityKey, bKey, StatusOpen, StatusNeedsAnchoring, actor, reason); err != nil {
+		return postgres.BucketRow{}, err
+	}
+	return b, tx.Commit(ctx)
+}
+
+// chainLink returns the chain link a bucket was sealed with, or nil.
+func chainLink(b postgres.BucketRow) *crypto.ChainLink {
+	if b.ChainSeq == nil {
+		return nil
+	}
+	link := &crypto.ChainLink{Seq: uint32(*b.ChainSeq), PrevRoot: b.PrevRoot}
+	if b.LogSize != nil {
+		link.LogSize, link.LogRoot = uint32(*b.LogSize), b.LogRoot
+	}
+	return link
+}
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	bucketv1 "github.com/gusplusbus/trustflow/data_server/gen/bucketv1"
+	"github.com/gusplusbus/trustflow/data_server/internal/evidence"
+	"github.com/gusplusbus/trustflow/data_server/internal/repo/postgres"
+	"github.com/gusplusbus/trustflow/data_server/internal/service/crypto"
+	"github.com/jackc/pgx/v5"
+)
+
+// CompletenessProof proves seq from..to of a scope are all present: a range
+// proof against the smallest sequence log a sealed bucket's chain link
+// commits to that covers to. from 0 means 1, to 0 the latest committed seq.
+func (s *BucketService) CompletenessProof(ctx context.Context, scope bucketv1.Scope, from, to uint64) (*evidence.Completeness, error) {
+	kind, key := scope.GetEntityKind(), scope.GetEntityKey()
+	if from == 0 {
+		from = 1
+	}
+	if to != 0 && to < from {
+		return nil, fmt.Errorf("to_seq %d is before from_seq %d", to, from)
+	}
+	bkey, size, root, err := s.repo.LogCommitment(ctx, kind, key, int64(to))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("no sealed bucket of %s/%s commits seq %d yet", kind, key, to)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if to == 0 {
+		to = uint64(size)
+	}
+	if from > to {
+		return nil, fmt.Errorf("from_seq %d is past the committed log (%d entries)", from, size)
+	}
+
+	rows, entries, err := seqLog(ctx, s.repo, kind, key, int64(size))
+	if err != nil {
+		return nil, err
+	}
+	if entries == nil || len(entries) != int(size) {
+		return nil, fmt.Errorf("sequence log of %s/%s no longer has %d contiguous entries", kind, key, size)
+	}
+	proof, got := crypto.RangeProof(entries, int(from-1), int(to))
+	if !bytes.Equal(got, root) {
+		return nil, fmt.Errorf("sequence log of %s/%s no longer matches the root committed by %s", kind, key, bkey)
+	}
+
+	out := &evidence.Completeness{
+		Format:      evidence.CompletenessFormat,
+		EntityKind:  kind,
+		EntityKey:   key,
+		FromSeq:     from,
+		ToSeq:       to,
+		LogSize:     uint32(size),
+		LogRoot:     root,
+		CommittedBy: bkey,
+	}
+	for _, r := range rows[from-1 : to] {
+		out.Entries = append(out.Entries, evidence.SeqEntry{
+			Seq:             uint64(r.Seq),
+			ProviderEventID: r.ProviderEventID,
+			ItemHash:        r.ItemHash,
+			BucketKey:       r.BucketKey,
+		})
+	}
+	for _, p := range proof {
+		out.Proof = append(out.Proof, p)
+	}
+	return out, nil
+}
+
+// seqLog loads a scope's sequence log up to maxSeq (0 = all). entries is nil
+// when seq_in_entity is not exactly 1, 2, 3, ...: such a history has no
+// sequence log.
+func seqLog(ctx context.Context, repo *postgres.BucketRepo, entityKind, entityKey string, maxSeq int64) (rows []postgres.SeqLogRow, entries [][]byte, err error) {
+	rows, err = repo.SelectEntityLog(ctx, entityKind, entityKey, maxSeq)
+	if err != nil {
+		return nil, nil, err
+	}
+	return rows, seqEntries(entityKind, entityKey, rows), nil
+}
+
+// seqEntries returns the sequence log leaves of rows in seq order, or nil
+// if their seqs have gaps or duplicates.
+func seqEntries(entityKind, entityKey string, rows []postgres.SeqLogRow) [][]byte {
+	entries := make([][]byte, 0, len(rows))
+	for i, r := range rows {
+		if r.Seq != int64(i+1) {
+			return nil
+		}
+		entries = append(entries, crypto.SeqLeaf(entityKind, entityKey, uint64(r.Seq), r.ItemHash))
+	}
+	return entries
+}
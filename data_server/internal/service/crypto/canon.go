@@ -0,0 +1,159 @@
+package crypto
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CanonVersion selects how a timeline item is turned into the bytes its
+// item_hash covers. It is stored with every item (timeline_items.canon_version)
+// so items hashed under an older version stay verifiable.
+type CanonVersion uint8
+
+const (
+	// CanonLegacy decodes the payload with encoding/json into map[string]any:
+	// every number becomes a float64 and an unparsable payload hashes as null.
+	CanonLegacy CanonVersion = 1
+
+	// CanonStrict is the documented encoding (see CanonicalItem). Test vectors
+	// live in testdata/canon_vectors.json.
+	CanonStrict CanonVersion = 2
+
+	DefaultCanon = CanonStrict
+)
+
+func (v CanonVersion) Valid() bool { return v == CanonLegacy || v == CanonStrict }
+
+// CanonicalItem builds the hashed shape of a timeline item under version v;
+// HashDAGCBOR of the result gives (block, item_hash).
+//
+// CanonStrict encodes a CBOR map (keys sorted length-first, then bytewise, as
+// in DAG-CBOR) with:
+//
+//	provider           text
+//	provider_event_id  text
+//	type               text
+//	actor              text, omitted when there is no actor
+//	created_at         text, RFC 3339 in UTC with whole seconds ("...Z")
+//	payload            map, the payload JSON object
+//
+// The payload must be a single JSON object; an empty payload and JSON null
+// both encode as the empty map. Inside it, objects become maps (same key
+// order; duplicate keys are rejected), arrays become arrays, strings, booleans
+// and null map directly, and numbers must be integer literals in
+// [-2^63, 2^64-1], encoded as CBOR integers. Fractions, exponents (so also
+// NaN and infinities, which JSON cannot spell) and out-of-range integers are
+// rejected rather than rounded.
+func CanonicalItem(v CanonVersion, provider, providerEventID, typ string, actor *string, createdAt time.Time, payloadJSON []byte) (CanonItem, error) {
+	c := CanonItem{
+		Provider:        provider,
+		ProviderEventID: providerEventID,
+		Type:            typ,
+		Actor:           actor,
+		CreatedAt:       createdAt.UTC(),
+	}
+	switch v {
+	case CanonLegacy:
+		if len(payloadJSON) > 0 {
+			_ = json.Unmarshal(payloadJSON, &c.Payload)
+		}
+	case CanonStrict:
+		pm, err := strictPayload(payloadJSON)
+		if err != nil {
+			return CanonItem{}, fmt.Errorf("canonicalize %s: %w", providerEventID, err)
+		}
+		c.Payload = pm
+	default:
+		return CanonItem{}, fmt.Errorf("unknown canon_version %d", v)
+	}
+	return c, nil
+}
+
+func strictPayload(b []byte) (map[string]any, error) {
+	if len(bytes.TrimSpace(b)) == 0 {
+		return map[string]any{}, nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	v, err := strictValue(dec)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, errors.New("trailing data after payload")
+	}
+	switch m := v.(type) {
+	case nil:
+		return map[string]any{}, nil
+	case map[string]any:
+		return m, nil
+	default:
+		return nil, errors.New("payload is not a JSON object")
+	}
+}
+
+func strictValue(dec *json.Decoder) (any, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch t := tok.(type) {
+	case json.Delim:
+		if t == '{' {
+			m := map[string]any{}
+			for dec.More() {
+				kt, err := dec.Token()
+				if err != nil {
+					return nil, err
+				}
+				k := kt.(string)
+				if _, dup := m[k]; dup {
+					return nil, fmt.Errorf("duplicate key %q", k)
+				}
+				if m[k], err = strictValue(dec); err != nil {
+					return nil, err
+				}
+			}
+			_, err := dec.Token()
+			return m, err
+		}
+		a := []any{}
+		for dec.More() {
+			e, err := strictValue(dec)
+			if err != nil {
+				return nil, err
+			}
+			a = append(a, e)
+		}
+		_, err := dec.Token()
+		return a, err
+	case json.Number:
+		return strictInt(string(t))
+	default: // string, bool, nil
+		return t, nil
+	}
+}
+
+func strictInt(s string) (any, error) {
+	if strings.ContainsAny(s, ".eE") {
+		return nil, fmt.Errorf("non-integer number %s", s)
+	}
+	if strings.HasPrefix(s, "-") {
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("integer %s out of range", s)
+		}
+		return n, nil
+	}
+	n, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("integer %s out of range", s)
+	}
+	return n, nil
+}
@@ -0,0 +1,87 @@
+package crypto
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+// canonVector is one entry of testdata/canon_vectors.json. Payload is the raw
+// JSON text; Error, when set, is a substring of the expected rejection.
+type canonVector struct {
+	Name            string    `json:"name"`
+	CanonVersion    uint8     `json:"canon_version"`
+	Provider        string    `json:"provider"`
+	ProviderEventID string    `json:"provider_event_id"`
+	Type            string    `json:"type"`
+	Actor           *string   `json:"actor"`
+	CreatedAt       time.Time `json:"created_at"`
+	Payload         string    `json:"payload"`
+	CBOR            string    `json:"cbor"`
+	ItemHash        string    `json:"item_hash"`
+	Error           string    `json:"error"`
+}
+
+func TestCanonVectors(t *testing.T) {
+	raw, err := os.ReadFile("testdata/canon_vectors.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var vs []canonVector
+	if err := json.Unmarshal(raw, &vs); err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range vs {
+		c, err := CanonicalItem(CanonVersion(v.CanonVersion), v.Provider, v.ProviderEventID, v.Type, v.Actor, v.CreatedAt, []byte(v.Payload))
+		if v.Error != "" {
+			if err == nil || !strings.Contains(err.Error(), v.Error) {
+				t.Errorf("%s: error = %v, want %q", v.Name, err, v.Error)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", v.Name, err)
+			continue
+		}
+		block, h, err := HashDAGCBOR(c)
+		if err != nil {
+			t.Errorf("%s: %v", v.Name, err)
+			continue
+		}
+		if got := hex.EncodeToString(block); got != v.CBOR {
+			t.Errorf("%s: cbor = %s, want %s", v.Name, got, v.CBOR)
+		}
+		if got := hex.EncodeToString(h); got != v.ItemHash {
+			t.Errorf("%s: item_hash = %s, want %s", v.Name, got, v.ItemHash)
+		}
+	}
+}
+
+// Postgres stores payloads as JSONB, which reorders keys and reformats the
+// text; the strict encoding must not care.
+func TestCanonStrictIgnoresJSONLayout(t *testing.T) {
+	at := time.Date(2025, 8, 22, 10, 0, 0, 0, time.UTC)
+	hash := func(payload string) []byte {
+		c, err := CanonicalItem(CanonStrict, "github", "X_1", "Custom", nil, at, []byte(payload))
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, h, err := HashDAGCBOR(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return h
+	}
+	a := hash(`{"id": 12345678901234567, "tags": ["x", {"k": -1}], "ok": true}`)
+	b := hash(`{"ok":true,"id":12345678901234567,"tags":["x",{"k":-1}]}`)
+	if !bytes.Equal(a, b) {
+		t.Fatal("layout changed the item hash")
+	}
+	if bytes.Equal(a, hash(`{"ok":true,"id":12345678901234568,"tags":["x",{"k":-1}]}`)) {
+		t.Fatal("adjacent large ids hash the same")
+	}
+}
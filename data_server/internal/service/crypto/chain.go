@@ -0,0 +1,44 @@
+package crypto
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+)
+
+const chainLeafDomain = "trustflow.chain-link.v1"
+
+// ChainLink ties a sealed bucket to the scope's previous sealed bucket. Seq
+// counts sealed buckets of the scope from 1; PrevRoot is the previous
+// bucket's final root, empty for the first. LogSize and LogRoot commit the
+// scope's sequence log (see SeqLeaf) as it stood at the seal; LogSize 0
+// means no log was committed.
+type ChainLink struct {
+	Seq      uint32
+	PrevRoot []byte
+	LogSize  uint32
+	LogRoot  []byte
+}
+
+// ChainLeaf is the leaf appended to a bucket as it is sealed, so its final
+// root commits to the link:
+//
+//	sha256("trustflow.chain-link.v1" || lp(entity_kind) || lp(entity_key) ||
+//	       seq (uint32 BE) || lp(prev_root) || log_size (uint32 BE) || lp(log_root))
+//
+// with lp as in EpochLeaf. Dropping, reordering or splicing buckets of a
+// scope then breaks the prev_root of the next one.
+func ChainLeaf(entityKind, entityKey string, link ChainLink) []byte {
+	h := sha256.New()
+	h.Write([]byte(chainLeafDomain))
+	lp := func(b []byte) {
+		h.Write(binary.AppendUvarint(nil, uint64(len(b))))
+		h.Write(b)
+	}
+	lp([]byte(entityKind))
+	lp([]byte(entityKey))
+	h.Write(binary.BigEndian.AppendUint32(nil, link.Seq))
+	lp(link.PrevRoot)
+	h.Write(binary.BigEndian.AppendUint32(nil, link.LogSize))
+	lp(link.LogRoot)
+	return h.Sum(nil)
+}
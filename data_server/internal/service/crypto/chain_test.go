@@ -0,0 +1,24 @@
+package crypto
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestChainLeafBindsFields(t *testing.T) {
+	prev := bytes.Repeat([]byte{7}, 32)
+	base := ChainLeaf("issue", "gh#1", ChainLink{Seq: 2, PrevRoot: prev})
+	for name, other := range map[string][]byte{
+		"kind":  ChainLeaf("project", "gh#1", ChainLink{Seq: 2, PrevRoot: prev}),
+		"key":   ChainLeaf("issue", "gh#2", ChainLink{Seq: 2, PrevRoot: prev}),
+		"split": ChainLeaf("issueg", "h#1", ChainLink{Seq: 2, PrevRoot: prev}),
+		"seq":   ChainLeaf("issue", "gh#1", ChainLink{Seq: 3, PrevRoot: prev}),
+		"prev":  ChainLeaf("issue", "gh#1", ChainLink{Seq: 2, PrevRoot: bytes.Repeat([]byte{8}, 32)}),
+		"first": ChainLeaf("issue", "gh#1", ChainLink{Seq: 2}),
+		"log":   ChainLeaf("issue", "gh#1", ChainLink{Seq: 2, PrevRoot: prev, LogSize: 1, LogRoot: prev}),
+	} {
+		if bytes.Equal(base, other) {
+			t.Errorf("%s: chain leaf unchanged", name)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package crypto
+
+import (
+	cbor "github.com/fxamacker/cbor/v2"
+	cid "github.com/ipfs/go-cid"
+	mh "github.com/multiformats/go-multihash"
+)
+
+// cidLinkTag is the CBOR tag DAG-CBOR uses for CID links.
+const cidLinkTag = 42
+
+// ItemCID is the CIDv1 (dag-cbor, sha2-256) of a timeline item. The item hash
+// already is the sha2-256 of its DAG-CBOR encoding, so no re-encoding is needed.
+func ItemCID(itemHash []byte) (cid.Cid, error) {
+	m, err := mh.Encode(itemHash, mh.SHA2_256)
+	if err != nil {
+		return cid.Undef, err
+	}
+	return cid.NewCidV1(cid.DagCBOR, m), nil
+}
+
+// BlockCID is the CIDv1 (dag-cbor, sha2-256) of an encoded DAG-CBOR block.
+func BlockCID(block []byte) (cid.Cid, error) {
+	m, err := mh.Sum(block, mh.SHA2_256, -1)
+	if err != nil {
+		return cid.Undef, err
+	}
+	return cid.NewCidV1(cid.DagCBOR, m), nil
+}
+
+// Link encodes c as a DAG-CBOR link (tag 42, 0x00-prefixed binary CID).
+func Link(c cid.Cid) cbor.Tag {
+	return cbor.Tag{Number: cidLinkTag, Content: append([]byte{0x00}, c.Bytes()...)}
+}
+
+// BucketManifest is the DAG-CBOR root block of an anchored bucket: the tree
+// parameters plus links to every leaf item, in leaf order (leaf_order says
+// which; absent = arrival). A chained bucket's last leaf is its chain link,
+// carried by value (chain_seq, prev_root and the sequence log commitment)
+// instead of as a link.
+type BucketManifest struct {
+	EntityKind string     `cbor:"entity_kind"`
+	EntityKey  string     `cbor:"entity_key"`
+	BucketKey  string     `cbor:"bucket_key"`
+	TreeAlg    uint8      `cbor:"tree_alg"`
+	RootHash   []byte     `cbor:"root_hash"`
+	LeafCount  uint32     `cbor:"leaf_count"`
+	Leaves     []cbor.Tag `cbor:"leaves"`
+	ChainSeq   uint32     `cbor:"chain_seq,omitempty"`
+	PrevRoot   []byte     `cbor:"prev_root,omitempty"`
+	LogSize    uint32     `cbor:"log_size,omitempty"`
+	LogRoot    []byte     `cbor:"log_root,omitempty"`
+	LeafOrder  uint8      `cbor:"leaf_order,omitempty"`
+}
+
+// NewBucketManifest links each leaf (an item hash) as an item CID. With a
+// chain link, the last leaf is the link and is recorded by value.
+func NewBucketManifest(entityKind, entityKey, bucketKey string, alg TreeAlg, root []byte, leaves [][]byte, chain *ChainLink, order LeafOrder) (BucketManifest, error) {
+	m := BucketManifest{
+		EntityKind: entityKind,
+		EntityKey:  entityKey,
+		BucketKey:  bucketKey,
+		TreeAlg:    uint8(alg),
+		RootHash:   root,
+		LeafCount:  uint32(len(leaves)),
+		Leaves:     make([]cbor.Tag, 0, len(leaves)),
+		LeafOrder:  uint8(order),
+	}
+	if chain != nil && len(leaves) > 0 {
+		m.ChainSeq, m.PrevRoot = chain.Seq, chain.PrevRoot
+		m.LogSize, m.LogRoot = chain.LogSize, chain.LogRoot
+		leaves = leaves[:len(leaves)-1]
+	}
+	for _, l := range leaves {
+		c, err := ItemCID(l)
+		if err != nil {
+			return BucketManifest{}, err
+		}
+		m.Leaves = append(m.Leaves, Link(c))
+	}
+	return m, nil
+}
+
+// EncodeManifest returns the manifest block and its CID.
+func EncodeManifest(m BucketManifest) ([]byte, cid.Cid, error) {
+	if m.Leaves == nil {
+		m.Leaves = []cbor.Tag{} // encode as [] rather than null
+	}
+	b, err := enc.Marshal(m)
+	if err != nil {
+		return nil, cid.Undef, err
+	}
+	c, err := BlockCID(b)
+	return b, c, err
+}
+
+// EpochManifest is the DAG-CBOR root block of an anchored epoch: the epoch
+// root plus links to the manifest of every member bucket, in epoch leaf order.
+type EpochManifest struct {
+	Period    string     `cbor:"period"`
+	TreeAlg   uint8      `cbor:"tree_alg"`
+	RootHash  []byte     `cbor:"root_hash"`
+	LeafCount uint32     `cbor:"leaf_count"`
+	Buckets   []cbor.Tag `cbor:"buckets"`
+}
+
+// EncodeEpochManifest links each bucket manifest CID and returns the block
+// and its CID.
+func EncodeEpochManifest(period string, root []byte, buckets []cid.Cid) ([]byte, cid.Cid, error) {
+	m := EpochManifest{
+		Period:    period,
+		TreeAlg:   uint8(EpochTreeAlg),
+		RootHash:  root,
+		LeafCount: uint32(len(buckets)),
+		Buckets:   make([]cbor.Tag, 0, len(buckets)),
+	}
+	for _, c := range buckets {
+		m.Buckets = append(m.Buckets, Link(c))
+	}
+	b, err := enc.Marshal(m)
+	if err != nil {
+		return nil, cid.Undef, err
+	}
+	c, err := BlockCID(b)
+	return b, c, err
+}
@@ -0,0 +1,34 @@
+package crypto
+
+import (
+	"testing"
+	"time"
+)
+
+// An item's CID must address the exact DAG-CBOR bytes its item_hash covers.
+func TestItemCIDMatchesBlock(t *testing.T) {
+	actor := "octocat"
+	canon, err := CanonicalItem(DefaultCanon, "github", "IC_1", "IssueComment", &actor,
+		time.Date(2025, 8, 22, 10, 0, 0, 0, time.UTC), []byte(`{"body":"hi"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, hash, err := HashDAGCBOR(canon)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fromHash, err := ItemCID(hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fromBlock, err := BlockCID(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !fromHash.Equals(fromBlock) {
+		t.Fatalf("ItemCID = %s, BlockCID = %s", fromHash, fromBlock)
+	}
+	if got := fromHash.Prefix().Codec; got != 0x71 {
+		t.Errorf("codec = %#x, want dag-cbor (0x71)", got)
+	}
+}
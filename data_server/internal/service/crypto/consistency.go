@@ -0,0 +1,163 @@
+package crypto
+
+import (
+	"bytes"
+	"errors"
+	"math/bits"
+)
+
+// ErrConsistencyAlg is returned for trees that have no consistency proofs:
+// the legacy tree duplicates odd nodes, so an older root is not built from
+// subtrees of the newer one.
+var ErrConsistencyAlg = errors.New("consistency proofs require tree_alg 2 (rfc6962)")
+
+// span is the leaf range [lo, hi) of one proof node.
+type span struct{ lo, hi uint64 }
+
+// consistencySpans lists the subtrees whose hashes make up PROOF(m, D[n])
+// (RFC 6962 §2.1.2), in proof order.
+func consistencySpans(m, n uint64) []span {
+	var out []span
+	var sub func(m, lo, hi uint64, complete bool)
+	sub = func(m, lo, hi uint64, complete bool) {
+		size := hi - lo
+		if m == size {
+			if !complete {
+				out = append(out, span{lo, hi})
+			}
+			return
+		}
+		k := uint64(1) << (bits.Len64(size-1) - 1)
+		if m <= k {
+			sub(m, lo, lo+k, complete)
+			out = append(out, span{lo + k, hi})
+		} else {
+			sub(m-k, lo+k, hi, false)
+			out = append(out, span{lo, lo + k})
+		}
+	}
+	sub(m, 0, n, true)
+	return out
+}
+
+// refs splits a proof span into the aligned perfect subtrees it is made of,
+// largest first. Every span produced by the RFC recursion is aligned this way.
+func (s span) refs() []NodeRef {
+	var out []NodeRef
+	lo := s.lo
+	for h := bits.Len64(s.hi-s.lo) - 1; h >= 0; h-- {
+		if (s.hi-s.lo)>>h&1 == 1 {
+			out = append(out, NodeRef{Level: h, Index: lo >> h})
+			lo += 1 << h
+		}
+	}
+	return out
+}
+
+// ConsistencyRefs lists the completed nodes ConsistencyProof needs to show
+// that the tree of size m is a prefix of the tree of size n.
+func ConsistencyRefs(m, n uint64) []NodeRef {
+	var out []NodeRef
+	for _, s := range consistencySpans(m, n) {
+		out = append(out, s.refs()...)
+	}
+	return out
+}
+
+// ConsistencyProof builds the RFC 6962 consistency proof from m to n leaves
+// out of completed nodes (as listed by ConsistencyRefs; level 0 holds leaf
+// entries). m == n yields an empty proof.
+func ConsistencyProof(alg TreeAlg, m, n uint64, nodes map[NodeRef][]byte) ([][]byte, error) {
+	if alg != TreeAlgRFC6962 {
+		return nil, ErrConsistencyAlg
+	}
+	if m == 0 || m > n {
+		return nil, errors.New("consistency proof needs 0 < old_size <= new_size")
+	}
+	var proof [][]byte
+	for _, s := range consistencySpans(m, n) {
+		h, err := subtreeHash(alg, s.refs(), nodes)
+		if err != nil {
+			return nil, err
+		}
+		proof = append(proof, h)
+	}
+	return proof, nil
+}
+
+// RootAt recomputes the root of the first size leaves from completed nodes
+// (see RootRefs).
+func RootAt(alg TreeAlg, size uint64, nodes map[NodeRef][]byte) ([]byte, error) {
+	if alg != TreeAlgRFC6962 {
+		return nil, ErrConsistencyAlg
+	}
+	return subtreeHash(alg, RootRefs(size), nodes)
+}
+
+// RootRefs lists the completed nodes RootAt needs.
+func RootRefs(size uint64) []NodeRef {
+	return span{0, size}.refs()
+}
+
+// subtreeHash folds perfect subtrees (largest first) into H(N0, H(N1, ...)).
+func subtreeHash(alg TreeAlg, refs []NodeRef, nodes map[NodeRef][]byte) ([]byte, error) {
+	var acc []byte
+	for i := len(refs) - 1; i >= 0; i-- {
+		v := nodes[refs[i]]
+		if v == nil {
+			return nil, errors.New("missing node for proof")
+		}
+		if refs[i].Level == 0 {
+			v = alg.HashLeaf(v)
+		}
+		if acc == nil {
+			acc = v
+		} else {
+			acc = alg.HashNode(v, acc)
+		}
+	}
+	if acc == nil {
+		return nil, errors.New("empty tree")
+	}
+	return acc, nil
+}
+
+// VerifyConsistency checks an RFC 6962 consistency proof (RFC 9162 §2.1.4.2).
+func VerifyConsistency(alg TreeAlg, m, n uint64, oldRoot, newRoot []byte, proof [][]byte) bool {
+	if alg != TreeAlgRFC6962 || m == 0 || m > n {
+		return false
+	}
+	if m == n {
+		return len(proof) == 0 && bytes.Equal(oldRoot, newRoot)
+	}
+	if m&(m-1) == 0 { // old tree is a complete subtree: its root is the first node
+		proof = append([][]byte{oldRoot}, proof...)
+	}
+	if len(proof) == 0 {
+		return false
+	}
+	fn, sn := m-1, n-1
+	for fn&1 == 1 {
+		fn >>= 1
+		sn >>= 1
+	}
+	fr, sr := proof[0], proof[0]
+	for _, c := range proof[1:] {
+		if sn == 0 {
+			return false
+		}
+		if fn&1 == 1 || fn == sn {
+			fr = alg.HashNode(c, fr)
+			sr = alg.HashNode(c, sr)
+			for fn&1 == 0 && fn != 0 {
+				fn >>= 1
+				sn >>= 1
+			}
+		} else {
+			sr = alg.HashNode(sr, c)
+		}
+		fn >>= 1
+		sn >>= 1
+	}
+	return sn == 0 && bytes.Equal(fr, oldRoot) && bytes.Equal(sr, newRoot)
+}
@@ -0,0 +1,91 @@
+package crypto
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+// allNodes appends leaves to a frontier and records every completed node.
+func allNodes(alg TreeAlg, leaves [][]byte) map[NodeRef][]byte {
+	f := NewFrontier(alg)
+	nodes := map[NodeRef][]byte{}
+	for i, l := range leaves {
+		nodes[NodeRef{Level: 0, Index: uint64(i)}] = l
+		for _, nd := range f.Append(l) {
+			nodes[NodeRef{Level: nd.Level, Index: nd.Index}] = nd.Hash
+		}
+	}
+	return nodes
+}
+
+func TestConsistencyProofVectors(t *testing.T) {
+	var leaves [][]byte
+	for _, l := range rfc6962Leaves {
+		b, _ := hex.DecodeString(l)
+		leaves = append(leaves, b)
+	}
+	nodes := allNodes(TreeAlgRFC6962, leaves)
+
+	cases := []struct {
+		m, n uint64
+		want []string
+	}{
+		{1, 1, nil},
+		{1, 8, []string{
+			"96a296d224f285c67bee93c30f8a309157f0daa35dc5b87e410b78630a09cfc7",
+			"5f083f0a1a33ca076a95279832580db3e0ef4584bdff1f54c8a360f50de3031e",
+			"6b47aaf29ee3c2af9af889bc1fb9254dabd31177f16232dd6aab035ca39bf6e4",
+		}},
+		{6, 8, []string{
+			"0ebc5d3437fbe2db158b9f126a1d118e308181031d0a949f8dededebc558ef6a",
+			"ca854ea128ed050b41b35ffc1b87b8eb2bde461e9e3b5596ece6b9d5975a0ae0",
+			"d37ee418976dd95753c1c73862b9398fa2a2cf9b4ff0fdfe8b30cd95209614b7",
+		}},
+		{2, 5, []string{
+			"5f083f0a1a33ca076a95279832580db3e0ef4584bdff1f54c8a360f50de3031e",
+			"bc1a0643b12e4d2d7c77918f44e0f4f79a838b6cf9ec5b5c283e1f4d88599e6b",
+		}},
+	}
+	for _, c := range cases {
+		proof, err := ConsistencyProof(TreeAlgRFC6962, c.m, c.n, nodes)
+		if err != nil {
+			t.Fatalf("(%d,%d): ConsistencyProof() error = %v", c.m, c.n, err)
+		}
+		if len(proof) != len(c.want) {
+			t.Fatalf("(%d,%d): %d proof nodes, want %d", c.m, c.n, len(proof), len(c.want))
+		}
+		for i := range proof {
+			if got := hex.EncodeToString(proof[i]); got != c.want[i] {
+				t.Errorf("(%d,%d)[%d] = %s, want %s", c.m, c.n, i, got, c.want[i])
+			}
+		}
+	}
+}
+
+func TestConsistencyProofRoundTrip(t *testing.T) {
+	leaves := testLeaves(40)
+	nodes := allNodes(TreeAlgRFC6962, leaves)
+	for n := uint64(1); n <= 40; n++ {
+		newRoot := BuildMerkleRoot(TreeAlgRFC6962, leaves[:n])
+		if got, err := RootAt(TreeAlgRFC6962, n, nodes); err != nil || string(got) != string(newRoot) {
+			t.Fatalf("RootAt(%d) = %x, %v; want %x", n, got, err, newRoot)
+		}
+		for m := uint64(1); m <= n; m++ {
+			oldRoot := BuildMerkleRoot(TreeAlgRFC6962, leaves[:m])
+			proof, err := ConsistencyProof(TreeAlgRFC6962, m, n, nodes)
+			if err != nil {
+				t.Fatalf("(%d,%d): ConsistencyProof() error = %v", m, n, err)
+			}
+			if !VerifyConsistency(TreeAlgRFC6962, m, n, oldRoot, newRoot, proof) {
+				t.Fatalf("(%d,%d): valid proof rejected", m, n)
+			}
+			if m < n && VerifyConsistency(TreeAlgRFC6962, m, n, newRoot, newRoot, proof) {
+				t.Fatalf("(%d,%d): proof accepted for wrong old root", m, n)
+			}
+		}
+	}
+
+	if _, err := ConsistencyProof(TreeAlgLegacy, 1, 2, nodes); err != ErrConsistencyAlg {
+		t.Errorf("legacy ConsistencyProof() error = %v, want ErrConsistencyAlg", err)
+	}
+}
@@ -0,0 +1,42 @@
+package crypto
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"time"
+)
+
+// EpochTreeAlg is the tree every epoch is built with, whatever the tree_alg
+// of its buckets.
+const EpochTreeAlg = TreeAlgRFC6962
+
+const epochLeafDomain = "trustflow.epoch-leaf.v1"
+
+// EpochPeriod is the UTC day an epoch covers; buckets join the epoch of the
+// day they were closed.
+func EpochPeriod(closedAt time.Time) string {
+	return closedAt.UTC().Format("2006-01-02")
+}
+
+// EpochLeaf is the epoch tree entry committing to one bucket root:
+//
+//	sha256("trustflow.epoch-leaf.v1" || lp(entity_kind) || lp(entity_key) ||
+//	       lp(bucket_key) || tree_alg (1 byte) || leaf_count (uint32 BE) || lp(root))
+//
+// where lp(x) is x prefixed with its length as a uvarint. Binding the scope
+// and size stops a root being replayed for another bucket.
+func EpochLeaf(entityKind, entityKey, bucketKey string, alg TreeAlg, leafCount uint32, root []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte(epochLeafDomain))
+	lp := func(b []byte) {
+		h.Write(binary.AppendUvarint(nil, uint64(len(b))))
+		h.Write(b)
+	}
+	lp([]byte(entityKind))
+	lp([]byte(entityKey))
+	lp([]byte(bucketKey))
+	h.Write([]byte{byte(alg)})
+	h.Write(binary.BigEndian.AppendUint32(nil, leafCount))
+	lp(root)
+	return h.Sum(nil)
+}
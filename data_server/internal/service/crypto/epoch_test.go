@@ -0,0 +1,54 @@
+package crypto
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+)
+
+// An item proof chains into an epoch proof: item -> bucket root -> epoch root.
+func TestTwoLevelProof(t *testing.T) {
+	var buckets [][]byte // epoch entries
+	var items [][]byte
+	for b := 0; b < 5; b++ {
+		var leaves [][]byte
+		for i := 0; i <= b; i++ {
+			leaves = append(leaves, bytes.Repeat([]byte{byte(16*b + i)}, 32))
+		}
+		root := BuildMerkleRoot(DefaultTreeAlg, leaves)
+		buckets = append(buckets, EpochLeaf("issue", "42", fmt.Sprintf("2025-08-%02d", b+1), DefaultTreeAlg, uint32(len(leaves)), root))
+		if b == 3 {
+			items = leaves
+		}
+	}
+	epochRoot := BuildMerkleRoot(EpochTreeAlg, buckets)
+
+	leaf, path, bucketRoot := BuildProof(DefaultTreeAlg, items, 2)
+	if !VerifyProof(DefaultTreeAlg, leaf, path, bucketRoot) {
+		t.Fatal("item proof does not reach the bucket root")
+	}
+	entry := EpochLeaf("issue", "42", "2025-08-04", DefaultTreeAlg, uint32(len(items)), bucketRoot)
+	got, epath, _ := BuildProof(EpochTreeAlg, buckets, 3)
+	if !bytes.Equal(got, entry) {
+		t.Fatal("epoch entry not rebuilt from the bucket root")
+	}
+	if !VerifyProof(EpochTreeAlg, entry, epath, epochRoot) {
+		t.Fatal("epoch proof does not reach the epoch root")
+	}
+}
+
+func TestEpochLeafBindsFields(t *testing.T) {
+	root := bytes.Repeat([]byte{1}, 32)
+	base := EpochLeaf("issue", "42", "2025-08-04", TreeAlgRFC6962, 3, root)
+	for name, other := range map[string][]byte{
+		"kind":  EpochLeaf("project", "42", "2025-08-04", TreeAlgRFC6962, 3, root),
+		"key":   EpochLeaf("issue", "43", "2025-08-04", TreeAlgRFC6962, 3, root),
+		"split": EpochLeaf("issue", "422", "025-08-04", TreeAlgRFC6962, 3, root),
+		"alg":   EpochLeaf("issue", "42", "2025-08-04", TreeAlgLegacy, 3, root),
+		"size":  EpochLeaf("issue", "42", "2025-08-04", TreeAlgRFC6962, 4, root),
+	} {
+		if bytes.Equal(base, other) {
+			t.Errorf("%s: epoch leaf unchanged", name)
+		}
+	}
+}
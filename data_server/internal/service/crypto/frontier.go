@@ -0,0 +1,237 @@
+package crypto
+
+import (
+	"crypto/sha256"
+	"errors"
+	"math/bits"
+)
+
+// Frontier is the right edge of a Merkle tree over Size leaves: Nodes[h] holds
+// the root of the perfect 2^h-leaf subtree that ends the tree when bit h of
+// Size is set (nil otherwise). It is all we need to append leaves and to
+// compute the root without reloading the bucket.
+type Frontier struct {
+	Alg   TreeAlg
+	Size  uint64
+	Nodes [][]byte
+}
+
+func NewFrontier(alg TreeAlg) *Frontier { return &Frontier{Alg: alg} }
+
+// Node is a completed perfect subtree covering leaves [Index<<Level, (Index+1)<<Level).
+type Node struct {
+	Level int
+	Index uint64
+	Hash  []byte
+}
+
+// NodeRef addresses a completed node without its hash.
+type NodeRef struct {
+	Level int
+	Index uint64
+}
+
+// Append adds one leaf entry and returns the interior nodes (level >= 1) it
+// completed. Level 0 is the entry itself, stored as the bucket leaf.
+func (f *Frontier) Append(leaf []byte) []Node {
+	var done []Node
+	node := f.Alg.HashLeaf(leaf)
+	pos := f.Size
+	h := 0
+	for ; pos>>h&1 == 1; h++ {
+		node = f.Alg.HashNode(f.Nodes[h], node)
+		f.Nodes[h] = nil
+		done = append(done, Node{Level: h + 1, Index: pos >> (h + 1), Hash: node})
+	}
+	for len(f.Nodes) <= h {
+		f.Nodes = append(f.Nodes, nil)
+	}
+	f.Nodes[h] = node
+	f.Size++
+	return done
+}
+
+// Root returns the same root BuildMerkleRoot gives for the appended leaves.
+func (f *Frontier) Root() []byte {
+	if f.Alg == TreeAlgRFC6962 {
+		root, _ := f.foldBelow(bits.Len64(f.Size))
+		return root
+	}
+	edge := f.rightEdge()
+	if len(edge) == 0 {
+		return nil
+	}
+	return edge[len(edge)-1]
+}
+
+// rightEdge returns, per level, the last node of that level (nil when it is
+// an inner node of a larger perfect subtree). The last entry is the root.
+// Odd levels duplicate their last node, as BuildMerkleRoot does.
+func (f *Frontier) rightEdge() [][]byte {
+	n := f.Size
+	if n == 0 {
+		return nil
+	}
+	var edge [][]byte
+	var cur []byte
+	for h := 0; ; h++ {
+		if cur == nil && n>>h&1 == 1 {
+			cur = f.Nodes[h]
+		}
+		edge = append(edge, cur)
+		count := levelCount(n, h)
+		if count == 1 {
+			return edge
+		}
+		if cur == nil {
+			continue
+		}
+		if count%2 == 1 {
+			cur = f.Alg.HashNode(cur, cur)
+		} else {
+			cur = f.Alg.HashNode(f.Nodes[h], cur)
+		}
+	}
+}
+
+// foldBelow combines the frontier nodes under level k into the RFC 6962 right
+// edge: H(N_hi, H(..., N_lo)). ok is false when no bit below k is set.
+func (f *Frontier) foldBelow(k int) (root []byte, ok bool) {
+	for h := 0; h < k; h++ {
+		if f.Size>>h&1 == 0 {
+			continue
+		}
+		if root == nil {
+			root = f.Nodes[h]
+		} else {
+			root = f.Alg.HashNode(f.Nodes[h], root)
+		}
+	}
+	return root, root != nil
+}
+
+// subtreeLevel is the height of the perfect frontier subtree holding index
+// (RFC 6962 only: the tree never pairs nodes across those subtrees).
+func (f *Frontier) subtreeLevel(index uint64) int {
+	h := 0
+	for ((index>>(h+1))+1)<<(h+1) <= f.Size {
+		h++
+	}
+	return h
+}
+
+// ProofRefs lists the completed nodes Proof needs for leaf index.
+func (f *Frontier) ProofRefs(index uint64) []NodeRef {
+	var refs []NodeRef
+	n := f.Size
+	if f.Alg == TreeAlgRFC6962 {
+		for h := 0; h < f.subtreeLevel(index); h++ {
+			refs = append(refs, NodeRef{Level: h, Index: (index >> h) ^ 1})
+		}
+		return refs
+	}
+	for h := 0; levelCount(n, h) > 1; h++ {
+		sib := (index >> h) ^ 1
+		if sib < levelCount(n, h) && (sib+1)<<h <= n {
+			refs = append(refs, NodeRef{Level: h, Index: sib})
+		}
+	}
+	return refs
+}
+
+// Proof builds the BuildProof path for leaf index from completed nodes
+// (as listed by ProofRefs; level 0 holds leaf entries) plus the frontier.
+// It also returns the root.
+func (f *Frontier) Proof(index uint64, nodes map[NodeRef][]byte) ([]ProofStep, []byte, error) {
+	n := f.Size
+	if index >= n {
+		return nil, nil, errors.New("leaf index out of range")
+	}
+	lookup := func(ref NodeRef) []byte {
+		v := nodes[ref]
+		if v != nil && ref.Level == 0 {
+			v = f.Alg.HashLeaf(v)
+		}
+		return v
+	}
+	if f.Alg == TreeAlgRFC6962 {
+		return f.rfcProof(index, lookup)
+	}
+
+	edge := f.rightEdge()
+	var path []ProofStep
+	for h := 0; levelCount(n, h) > 1; h++ {
+		self := index >> h
+		sib := self ^ 1
+		var sibling []byte
+		switch {
+		case sib >= levelCount(n, h):
+			sibling = edge[h] // odd level: last node is paired with itself
+		case (sib+1)<<h <= n:
+			sibling = lookup(NodeRef{Level: h, Index: sib})
+		default:
+			sibling = edge[h] // partial right-edge node
+		}
+		if sibling == nil {
+			return nil, nil, errors.New("missing node for proof")
+		}
+		path = append(path, ProofStep{Sibling: sibling, SiblingIsLeft: sib < self})
+	}
+	return path, edge[len(edge)-1], nil
+}
+
+func (f *Frontier) rfcProof(index uint64, lookup func(NodeRef) []byte) ([]ProofStep, []byte, error) {
+	var path []ProofStep
+	k := f.subtreeLevel(index)
+	for h := 0; h < k; h++ {
+		self := index >> h
+		sibling := lookup(NodeRef{Level: h, Index: self ^ 1})
+		if sibling == nil {
+			return nil, nil, errors.New("missing node for proof")
+		}
+		path = append(path, ProofStep{Sibling: sibling, SiblingIsLeft: self&1 == 1})
+	}
+	if right, ok := f.foldBelow(k); ok {
+		path = append(path, ProofStep{Sibling: right, SiblingIsLeft: false})
+	}
+	for h := k + 1; h < len(f.Nodes); h++ {
+		if f.Size>>h&1 == 1 {
+			path = append(path, ProofStep{Sibling: f.Nodes[h], SiblingIsLeft: true})
+		}
+	}
+	return path, f.Root(), nil
+}
+
+// Encode packs the set nodes (low level first) for storage; Size is stored separately.
+func (f *Frontier) Encode() []byte {
+	var out []byte
+	for h, nd := range f.Nodes {
+		if f.Size>>h&1 == 1 {
+			out = append(out, nd...)
+		}
+	}
+	return out
+}
+
+// DecodeFrontier reverses Encode for a tree of size leaves.
+func DecodeFrontier(alg TreeAlg, size uint64, b []byte) (*Frontier, error) {
+	if !alg.Valid() {
+		return nil, errors.New("unknown tree algorithm")
+	}
+	if len(b) != bits.OnesCount64(size)*sha256.Size {
+		return nil, errors.New("frontier does not match leaf count")
+	}
+	f := &Frontier{Alg: alg, Size: size, Nodes: make([][]byte, bits.Len64(size))}
+	for h := range f.Nodes {
+		if size>>h&1 == 1 {
+			f.Nodes[h] = append([]byte(nil), b[:sha256.Size]...)
+			b = b[sha256.Size:]
+		}
+	}
+	return f, nil
+}
+
+// levelCount is the number of nodes at level h of a tree over n leaves.
+func levelCount(n uint64, h int) uint64 {
+	return (n + (1 << h) - 1) >> h
+}
@@ -0,0 +1,71 @@
+package crypto
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"testing"
+)
+
+func testLeaves(n int) [][]byte {
+	out := make([][]byte, n)
+	for i := range out {
+		h := sha256.Sum256([]byte(fmt.Sprintf("leaf-%d", i)))
+		out[i] = h[:]
+	}
+	return out
+}
+
+// The frontier must reproduce BuildMerkleRoot/BuildProof exactly.
+func TestFrontierMatchesFullTree(t *testing.T) {
+	for _, alg := range []TreeAlg{TreeAlgLegacy, TreeAlgRFC6962} {
+		for n := 1; n <= 70; n++ {
+			checkFrontier(t, alg, testLeaves(n))
+		}
+	}
+}
+
+func checkFrontier(t *testing.T, alg TreeAlg, leaves [][]byte) {
+	t.Helper()
+	n := len(leaves)
+	f := NewFrontier(alg)
+	nodes := map[NodeRef][]byte{}
+	for i, l := range leaves {
+		nodes[NodeRef{Level: 0, Index: uint64(i)}] = l
+		for _, nd := range f.Append(l) {
+			nodes[NodeRef{Level: nd.Level, Index: nd.Index}] = nd.Hash
+		}
+	}
+	if got, want := f.Root(), BuildMerkleRoot(alg, leaves); !bytes.Equal(got, want) {
+		t.Fatalf("alg=%d n=%d: Root() = %x, want %x", alg, n, got, want)
+	}
+
+	// round-trip through storage
+	g, err := DecodeFrontier(alg, f.Size, f.Encode())
+	if err != nil {
+		t.Fatalf("alg=%d n=%d: DecodeFrontier() error = %v", alg, n, err)
+	}
+	if !bytes.Equal(g.Root(), f.Root()) {
+		t.Fatalf("alg=%d n=%d: decoded frontier root differs", alg, n)
+	}
+
+	for i := 0; i < n; i++ {
+		sub := map[NodeRef][]byte{}
+		for _, ref := range f.ProofRefs(uint64(i)) {
+			sub[ref] = nodes[ref]
+		}
+		path, root, err := f.Proof(uint64(i), sub)
+		if err != nil {
+			t.Fatalf("alg=%d n=%d i=%d: Proof() error = %v", alg, n, i, err)
+		}
+		_, wantPath, wantRoot := BuildProof(alg, leaves, i)
+		if !bytes.Equal(root, wantRoot) || len(path) != len(wantPath) {
+			t.Fatalf("alg=%d n=%d i=%d: proof shape differs", alg, n, i)
+		}
+		for k := range path {
+			if !bytes.Equal(path[k].Sibling, wantPath[k].Sibling) || path[k].SiblingIsLeft != wantPath[k].SiblingIsLeft {
+				t.Fatalf("alg=%d n=%d i=%d: step %d differs", alg, n, i, k)
+			}
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package crypto
+
+import (
+	"sort"
+	"time"
+)
+
+// LeafOrder is the order of a bucket's item leaves (timeline_buckets.leaf_order).
+type LeafOrder uint8
+
+const (
+	// LeafArrival is append order: every open bucket, and buckets sealed
+	// before canonical ordering.
+	LeafArrival LeafOrder = 0
+
+	// LeafCanonical sorts items by created_at as hashed (UTC, whole seconds),
+	// then by provider_event_id bytewise, so the same items give the same
+	// root in whatever order they were ingested. Applied when a bucket is
+	// sealed; a chain leaf stays last.
+	LeafCanonical LeafOrder = 1
+)
+
+func (o LeafOrder) Valid() bool { return o == LeafArrival || o == LeafCanonical }
+
+// OrderedItem is what LeafCanonical sorts by, plus the item hash it yields.
+type OrderedItem struct {
+	CreatedAt       time.Time
+	ProviderEventID string
+	ItemHash        []byte
+}
+
+// OrderLeaves returns the item hashes of items (given in arrival order) in
+// order o.
+func OrderLeaves(o LeafOrder, items []OrderedItem) [][]byte {
+	sorted := append([]OrderedItem(nil), items...)
+	if o == LeafCanonical {
+		sort.SliceStable(sorted, func(i, j int) bool {
+			a, b := sorted[i].CreatedAt.UTC().Truncate(time.Second), sorted[j].CreatedAt.UTC().Truncate(time.Second)
+			if !a.Equal(b) {
+				return a.Before(b)
+			}
+			return sorted[i].ProviderEventID < sorted[j].ProviderEventID
+		})
+	}
+	leaves := make([][]byte, len(sorted))
+	for i, it := range sorted {
+		leaves[i] = it.ItemHash
+	}
+	return leaves
+}
@@ -0,0 +1,32 @@
+package crypto
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestOrderLeavesCanonical(t *testing.T) {
+	at := time.Date(2025, 8, 22, 10, 0, 0, 0, time.UTC)
+	items := []OrderedItem{
+		{CreatedAt: at.Add(time.Minute), ProviderEventID: "a", ItemHash: []byte{3}},
+		{CreatedAt: at.Add(500 * time.Millisecond), ProviderEventID: "c", ItemHash: []byte{2}}, // same second as "b"
+		{CreatedAt: at.In(time.FixedZone("", 3600)), ProviderEventID: "b", ItemHash: []byte{1}},
+	}
+	want := [][]byte{{1}, {2}, {3}}
+	for _, perm := range [][]int{{0, 1, 2}, {2, 1, 0}, {1, 0, 2}} {
+		var in []OrderedItem
+		for _, i := range perm {
+			in = append(in, items[i])
+		}
+		got := OrderLeaves(LeafCanonical, in)
+		for i := range want {
+			if !bytes.Equal(got[i], want[i]) {
+				t.Fatalf("perm %v: got %v, want %v", perm, got, want)
+			}
+		}
+	}
+	if got := OrderLeaves(LeafArrival, items); !bytes.Equal(got[0], []byte{3}) {
+		t.Fatalf("arrival order changed: %v", got)
+	}
+}
@@ -1,65 +1,174 @@
 package crypto
 
-import "crypto/sha256"
-
-// BuildMerkleRoot builds a binary Merkle root (dup last if odd).
-func BuildMerkleRoot(leaves [][]byte) []byte {
-  if len(leaves) == 0 { return nil }
-  level := make([][]byte, len(leaves))
-  copy(level, leaves)
-  for len(level) > 1 {
-    next := make([][]byte, 0, (len(level)+1)/2)
-    for i := 0; i < len(level); i += 2 {
-      if i+1 == len(level) {
-        h := sha256.Sum256(append(level[i], level[i]...))
-        next = append(next, h[:])
-      } else {
-        h := sha256.Sum256(append(level[i], level[i+1]...))
-        next = append(next, h[:])
-      }
-    }
-    level = next
-  }
-  return level[0]
+import (
+	"bytes"
+	"crypto/sha256"
+	"math/bits"
+)
+
+// TreeAlg versions the Merkle tree a bucket's root was built with. It is
+// stored per bucket (timeline_buckets.tree_alg) so old roots stay verifiable.
+type TreeAlg uint8
+
+const (
+	// TreeAlgLegacy: sha256(l||r), no leaf/node prefixes, last odd node duplicated.
+	TreeAlgLegacy TreeAlg = 1
+	// TreeAlgRFC6962: sha256(0x00||leaf), sha256(0x01||l||r), unbalanced right
+	// edge instead of duplication (RFC 6962 §2.1).
+	TreeAlgRFC6962 TreeAlg = 2
+
+	// DefaultTreeAlg is used for newly created buckets.
+	DefaultTreeAlg = TreeAlgRFC6962
+)
+
+const (
+	leafPrefix = 0x00
+	nodePrefix = 0x01
+)
+
+func (a TreeAlg) Valid() bool { return a == TreeAlgLegacy || a == TreeAlgRFC6962 }
+
+// HashLeaf turns a leaf entry (an item hash) into the tree's level-0 node.
+func (a TreeAlg) HashLeaf(entry []byte) []byte {
+	if a != TreeAlgRFC6962 {
+		return entry
+	}
+	h := sha256.New()
+	h.Write([]byte{leafPrefix})
+	h.Write(entry)
+	return h.Sum(nil)
+}
+
+// HashNode combines two child nodes.
+func (a TreeAlg) HashNode(l, r []byte) []byte {
+	h := sha256.New()
+	if a == TreeAlgRFC6962 {
+		h.Write([]byte{nodePrefix})
+	}
+	h.Write(l)
+	h.Write(r)
+	return h.Sum(nil)
+}
+
+// BuildMerkleRoot builds the root over leaf entries with the given algorithm.
+func BuildMerkleRoot(alg TreeAlg, leaves [][]byte) []byte {
+	if len(leaves) == 0 {
+		return nil
+	}
+	if alg == TreeAlgRFC6962 {
+		return rfcRoot(hashLeaves(alg, leaves))
+	}
+	return legacyRoot(leaves)
 }
 
 type ProofStep struct {
-  Sibling       []byte
-  SiblingIsLeft bool
+	Sibling       []byte
+	SiblingIsLeft bool
+}
+
+// BuildProof returns the path for `index` against leaves (same tree rules).
+// The returned leaf is the entry itself, before HashLeaf.
+func BuildProof(alg TreeAlg, leaves [][]byte, index int) (leaf []byte, path []ProofStep, root []byte) {
+	if len(leaves) == 0 || index < 0 || index >= len(leaves) {
+		return nil, nil, nil
+	}
+	if alg == TreeAlgRFC6962 {
+		nodes := hashLeaves(alg, leaves)
+		return leaves[index], rfcPath(nodes, index), rfcRoot(nodes)
+	}
+	path, root = legacyProof(leaves, index)
+	return leaves[index], path, root
+}
+
+// VerifyProof recomputes the root from a leaf entry and its path.
+func VerifyProof(alg TreeAlg, leaf []byte, path []ProofStep, root []byte) bool {
+	if !alg.Valid() || len(leaf) == 0 {
+		return false
+	}
+	cur := alg.HashLeaf(leaf)
+	for _, st := range path {
+		if st.SiblingIsLeft {
+			cur = alg.HashNode(st.Sibling, cur)
+		} else {
+			cur = alg.HashNode(cur, st.Sibling)
+		}
+	}
+	return bytes.Equal(cur, root)
+}
+
+func hashLeaves(alg TreeAlg, leaves [][]byte) [][]byte {
+	out := make([][]byte, len(leaves))
+	for i, l := range leaves {
+		out[i] = alg.HashLeaf(l)
+	}
+	return out
+}
+
+// splitPoint is the largest power of two smaller than n (n > 1).
+func splitPoint(n int) int {
+	return 1 << (bits.Len(uint(n-1)) - 1)
+}
+
+func rfcRoot(nodes [][]byte) []byte {
+	if len(nodes) == 1 {
+		return nodes[0]
+	}
+	k := splitPoint(len(nodes))
+	return TreeAlgRFC6962.HashNode(rfcRoot(nodes[:k]), rfcRoot(nodes[k:]))
+}
+
+// rfcPath is PATH(m, D[n]) from RFC 6962 §2.1.1, ordered leaf to root.
+func rfcPath(nodes [][]byte, m int) []ProofStep {
+	if len(nodes) == 1 {
+		return nil
+	}
+	k := splitPoint(len(nodes))
+	if m < k {
+		return append(rfcPath(nodes[:k], m), ProofStep{Sibling: rfcRoot(nodes[k:]), SiblingIsLeft: false})
+	}
+	return append(rfcPath(nodes[k:], m-k), ProofStep{Sibling: rfcRoot(nodes[:k]), SiblingIsLeft: true})
+}
+
+func legacyRoot(leaves [][]byte) []byte {
+	level := make([][]byte, len(leaves))
+	copy(level, leaves)
+	for len(level) > 1 {
+		level = legacyNext(level)
+	}
+	return level[0]
+}
+
+func legacyProof(leaves [][]byte, index int) (path []ProofStep, root []byte) {
+	nodes := make([][]byte, len(leaves))
+	copy(nodes, leaves)
+	idx := index
+	for len(nodes) > 1 {
+		// collect sibling
+		if idx%2 == 0 { // left
+			sibIdx := idx + 1
+			if sibIdx >= len(nodes) { // duplicate
+				path = append(path, ProofStep{Sibling: nodes[idx], SiblingIsLeft: false})
+			} else {
+				path = append(path, ProofStep{Sibling: nodes[sibIdx], SiblingIsLeft: false})
+			}
+		} else {
+			path = append(path, ProofStep{Sibling: nodes[idx-1], SiblingIsLeft: true})
+		}
+		idx = idx / 2
+		nodes = legacyNext(nodes)
+	}
+	return path, nodes[0]
 }
 
-// BuildProof returns path for `index` against leaves (same tree rules).
-func BuildProof(leaves [][]byte, index int) (leaf []byte, path []ProofStep, root []byte) {
-  if len(leaves) == 0 || index < 0 || index >= len(leaves) { return nil, nil, nil }
-  nodes := make([][]byte, len(leaves))
-  copy(nodes, leaves)
-  idx := index
-  for len(nodes) > 1 {
-    // collect sibling
-    if idx%2 == 0 { // left
-      sibIdx := idx + 1
-      if sibIdx >= len(nodes) { // duplicate
-        path = append(path, ProofStep{Sibling: nodes[idx], SiblingIsLeft: false})
-      } else {
-        path = append(path, ProofStep{Sibling: nodes[sibIdx], SiblingIsLeft: false})
-      }
-    } else {
-      path = append(path, ProofStep{Sibling: nodes[idx-1], SiblingIsLeft: true})
-    }
-
-    // build next level
-    next := make([][]byte, 0, (len(nodes)+1)/2)
-    for i := 0; i < len(nodes); i += 2 {
-      if i+1 == len(nodes) {
-        h := sha256.Sum256(append(nodes[i], nodes[i]...))
-        next = append(next, h[:])
-      } else {
-        h := sha256.Sum256(append(nodes[i], nodes[i+1]...))
-        next = append(next, h[:])
-      }
-    }
-    idx = idx / 2
-    nodes = next
-  }
-  return leaves[index], path, nodes[0]
+// legacyNext builds the next level, duplicating the last node if odd.
+func legacyNext(level [][]byte) [][]byte {
+	next := make([][]byte, 0, (len(level)+1)/2)
+	for i := 0; i < len(level); i += 2 {
+		if i+1 == len(level) {
+			next = append(next, TreeAlgLegacy.HashNode(level[i], level[i]))
+		} else {
+			next = append(next, TreeAlgLegacy.HashNode(level[i], level[i+1]))
+		}
+	}
+	return next
 }
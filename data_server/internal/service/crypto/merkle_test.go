@@ -0,0 +1,62 @@
+package crypto
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+// RFC 6962 reference roots (certificate-transparency merkle_tree_test.cc).
+var rfc6962Leaves = []string{
+	"", "00", "10", "2021", "3031", "40414243",
+	"5051525354555657", "606162636465666768696a6b6c6d6e6f",
+}
+
+var rfc6962Roots = []string{
+	"6e340b9cffb37a989ca544e6bb780a2c78901d3fb33738768511a30617afa01d",
+	"fac54203e7cc696cf0dfcb42c92a1d9dbaf70ad9e621f4bd8d98662f00e3c125",
+	"aeb6bcfe274b70a14fb067a5e5578264db0fa9b51af5e0ba159158f329e06e77",
+	"d37ee418976dd95753c1c73862b9398fa2a2cf9b4ff0fdfe8b30cd95209614b7",
+	"4e3bbb1f7b478dcfe71fb631631519a3bca12c9aefca1612bfce4c13a86264d4",
+	"76e67dadbcdf1e10e1b74ddc608abd2f98dfb16fbce75277b5232a127f2087ef",
+	"ddb89be403809e325750d3d263cd78929c2942b7942a34b77e122c9594a74c8c",
+	"5dc9da79a70659a9ad559cb701ded9a2ab9d823aad2f4960cfe370eff4604328",
+}
+
+func TestRFC6962Roots(t *testing.T) {
+	var leaves [][]byte
+	for i, l := range rfc6962Leaves {
+		b, _ := hex.DecodeString(l)
+		leaves = append(leaves, b)
+		if got := hex.EncodeToString(BuildMerkleRoot(TreeAlgRFC6962, leaves)); got != rfc6962Roots[i] {
+			t.Errorf("n=%d: root = %s, want %s", i+1, got, rfc6962Roots[i])
+		}
+	}
+}
+
+func TestVerifyProofRespectsAlg(t *testing.T) {
+	leaves := testLeaves(7)
+	for _, alg := range []TreeAlg{TreeAlgLegacy, TreeAlgRFC6962} {
+		for i := range leaves {
+			leaf, path, root := BuildProof(alg, leaves, i)
+			if !VerifyProof(alg, leaf, path, root) {
+				t.Fatalf("alg=%d i=%d: valid proof rejected", alg, i)
+			}
+		}
+	}
+
+	// A legacy proof must not verify under RFC 6962 and vice versa.
+	leaf, path, root := BuildProof(TreeAlgLegacy, leaves, 3)
+	if VerifyProof(TreeAlgRFC6962, leaf, path, root) {
+		t.Error("legacy proof verified as rfc6962")
+	}
+	leaf, path, root = BuildProof(TreeAlgRFC6962, leaves, 3)
+	if VerifyProof(TreeAlgLegacy, leaf, path, root) {
+		t.Error("rfc6962 proof verified as legacy")
+	}
+
+	// Duplicating the last odd node no longer yields the same root.
+	dup := append(append([][]byte{}, leaves...), leaves[6])
+	if string(BuildMerkleRoot(TreeAlgRFC6962, dup)) == string(BuildMerkleRoot(TreeAlgRFC6962, leaves)) {
+		t.Error("rfc6962 root ambiguous under last-leaf duplication")
+	}
+}
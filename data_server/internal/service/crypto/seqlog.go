@@ -0,0 +1,92 @@
+package crypto
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+)
+
+// SeqLogAlg is the tree of every entity sequence log: one entry per item of
+// the entity, in seq_in_entity order, so a contiguous run of entries proves a
+// contiguous run of the entity's history.
+const SeqLogAlg = TreeAlgRFC6962
+
+const seqLeafDomain = "trustflow.seq-leaf.v1"
+
+// SeqLeaf is entry seq (from 1) of an entity's sequence log:
+//
+//	sha256("trustflow.seq-leaf.v1" || lp(entity_kind) || lp(entity_key) ||
+//	       seq (uint64 BE) || lp(item_hash))
+//
+// with lp as in EpochLeaf.
+func SeqLeaf(entityKind, entityKey string, seq uint64, itemHash []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte(seqLeafDomain))
+	lp := func(b []byte) {
+		h.Write(binary.AppendUvarint(nil, uint64(len(b))))
+		h.Write(b)
+	}
+	lp([]byte(entityKind))
+	lp([]byte(entityKey))
+	h.Write(binary.BigEndian.AppendUint64(nil, seq))
+	lp(itemHash)
+	return h.Sum(nil)
+}
+
+// RangeProof proves entries [lo, hi) are, in order and with nothing between
+// them, leaves lo..hi-1 of the RFC 6962 tree over entries. The proof is the
+// root of every maximal subtree outside the range, left to right; it returns
+// the tree root too.
+func RangeProof(entries [][]byte, lo, hi int) (proof [][]byte, root []byte) {
+	nodes := hashLeaves(SeqLogAlg, entries)
+	var walk func(off, n int)
+	walk = func(off, n int) {
+		switch {
+		case off+n <= lo || off >= hi:
+			proof = append(proof, rfcRoot(nodes[off:off+n]))
+		case lo <= off && off+n <= hi:
+		default:
+			k := splitPoint(n)
+			walk(off, k)
+			walk(off+k, n-k)
+		}
+	}
+	walk(0, len(nodes))
+	return proof, rfcRoot(nodes)
+}
+
+// VerifyRange checks a RangeProof: entries are leaves lo, lo+1, ... of the
+// size-n tree with the given root.
+func VerifyRange(n, lo uint64, entries, proof [][]byte, root []byte) bool {
+	hi := lo + uint64(len(entries))
+	if len(entries) == 0 || hi > n {
+		return false
+	}
+	nodes := hashLeaves(SeqLogAlg, entries)
+	var walk func(off, n uint64) ([]byte, bool)
+	walk = func(off, n uint64) ([]byte, bool) {
+		switch {
+		case off+n <= lo || off >= hi:
+			if len(proof) == 0 {
+				return nil, false
+			}
+			h := proof[0]
+			proof = proof[1:]
+			return h, true
+		case lo <= off && off+n <= hi:
+			return rfcRoot(nodes[off-lo : off-lo+n]), true
+		}
+		k := uint64(splitPoint(int(n)))
+		l, ok := walk(off, k)
+		if !ok {
+			return nil, false
+		}
+		r, ok := walk(off+k, n-k)
+		if !ok {
+			return nil, false
+		}
+		return SeqLogAlg.HashNode(l, r), true
+	}
+	got, ok := walk(0, n)
+	return ok && len(proof) == 0 && bytes.Equal(got, root)
+}
@@ -0,0 +1,53 @@
+package crypto
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestRangeProof(t *testing.T) {
+	for n := 1; n <= 17; n++ {
+		var entries [][]byte
+		for i := 0; i < n; i++ {
+			entries = append(entries, SeqLeaf("issue", "gh#1", uint64(i+1), []byte{byte(i)}))
+		}
+		want := BuildMerkleRoot(SeqLogAlg, entries)
+		for lo := 0; lo < n; lo++ {
+			for hi := lo + 1; hi <= n; hi++ {
+				proof, root := RangeProof(entries, lo, hi)
+				if !bytes.Equal(root, want) {
+					t.Fatalf("n=%d: RangeProof root differs from BuildMerkleRoot", n)
+				}
+				if !VerifyRange(uint64(n), uint64(lo), entries[lo:hi], proof, root) {
+					t.Fatalf("n=%d [%d,%d): proof rejected", n, lo, hi)
+				}
+				if hi-lo > 1 {
+					// an entry left out of the middle or the end
+					gap := append(append([][]byte{}, entries[lo:hi-2]...), entries[hi-1])
+					if VerifyRange(uint64(n), uint64(lo), gap, proof, root) {
+						t.Fatalf("n=%d [%d,%d): proof accepted with an entry missing", n, lo, hi)
+					}
+				}
+				if lo > 0 && VerifyRange(uint64(n), uint64(lo-1), entries[lo:hi], proof, root) {
+					t.Fatalf("n=%d [%d,%d): proof accepted at the wrong offset", n, lo, hi)
+				}
+			}
+		}
+	}
+}
+
+func TestSeqLeafBindsFields(t *testing.T) {
+	h := bytes.Repeat([]byte{1}, 32)
+	base := SeqLeaf("issue", "gh#1", 4, h)
+	for name, other := range map[string][]byte{
+		"kind":  SeqLeaf("project", "gh#1", 4, h),
+		"key":   SeqLeaf("issue", "gh#2", 4, h),
+		"split": SeqLeaf("issueg", "h#1", 4, h),
+		"seq":   SeqLeaf("issue", "gh#1", 5, h),
+		"item":  SeqLeaf("issue", "gh#1", 4, bytes.Repeat([]byte{2}, 32)),
+	} {
+		if bytes.Equal(base, other) {
+			t.Errorf("%s: seq leaf unchanged", name)
+		}
+	}
+}
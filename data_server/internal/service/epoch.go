@@ -0,0 +1,290 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	bucketv1 "github.com/gusplusbus/trustflow/data_server/gen/bucketv1"
+	"github.com/gusplusbus/trustflow/data_server/internal/car"
+	"github.com/gusplusbus/trustflow/data_server/internal/repo/postgres"
+	"github.com/gusplusbus/trustflow/data_server/internal/service/crypto"
+	cid "github.com/ipfs/go-cid"
+	"github.com/jackc/pgx/v5"
+)
+
+// epochProof is the bucket root -> epoch root step of a two-level proof.
+type epochProof struct {
+	Epoch  postgres.EpochRow
+	Member postgres.EpochMemberRow
+	Leaf   []byte
+	Path   []crypto.ProofStep
+}
+
+// SealEpoch rolls buckets the caller claimed (anchoring) and packed into one
+// new epoch over period, the UTC day it is sealed when empty: each bucket's
+// root becomes an epoch leaf, and the epoch manifest linking the bucket
+// manifests (by their recorded CIDs) is written as a CAR next to them. The
+// caller then anchors it with SetEpochAnchorPending.
+func (s *BucketService) SealEpoch(ctx context.Context, period string, refs []*bucketv1.BucketRef) (*bucketv1.EpochInfo, error) {
+	if len(refs) == 0 {
+		return nil, errors.New("refs required")
+	}
+	if period == "" {
+		period = crypto.EpochPeriod(time.Now())
+	} else if _, err := time.Parse("2006-01-02", period); err != nil {
+		return nil, fmt.Errorf("period %q: want a UTC day (YYYY-MM-DD)", period)
+	}
+	rows := make([]postgres.BucketRow, len(refs))
+	seen := make(map[string]bool, len(refs))
+	for i, ref := range refs {
+		kind, key, bkey := ref.GetScope().GetEntityKind(), ref.GetScope().GetEntityKey(), ref.GetBucketKey()
+		name := kind + "/" + key + "/" + bkey
+		if seen[name] {
+			return nil, fmt.Errorf("bucket %s listed twice", name)
+		}
+		seen[name] = true
+		b, err := s.repo.GetBucket(ctx, kind, key, bkey)
+		if err != nil {
+			return nil, fmt.Errorf("bucket %s: %w", name, err)
+		}
+		if b.Status != StatusAnchoring {
+			return nil, fmt.Errorf("bucket %s is %s; claim it before sealing it into an epoch", name, b.Status)
+		}
+		if b.CID == nil {
+			return nil, fmt.Errorf("bucket %s has no CID; pack it before sealing it into an epoch", name)
+		}
+		rows[i] = b
+	}
+	e, members, err := s.sealEpoch(ctx, period, rows)
+	if err != nil {
+		return nil, err
+	}
+	return epochToProto(e, members), nil
+}
+
+// sealEpoch writes the epoch manifest over packed buckets and records the
+// epoch.
+func (s *BucketService) sealEpoch(ctx context.Context, period string, buckets []postgres.BucketRow) (postgres.EpochRow, []postgres.EpochMemberRow, error) {
+	members := make([]postgres.EpochMemberRow, 0, len(buckets))
+	entries := make([][]byte, 0, len(buckets))
+	cids := make([]cid.Cid, 0, len(buckets))
+	for i, b := range buckets {
+		c, err := cid.Decode(*b.CID)
+		if err != nil {
+			return postgres.EpochRow{}, nil, fmt.Errorf("bucket %s/%s/%s cid: %w", b.EntityKind, b.EntityKey, b.BucketKey, err)
+		}
+		cids = append(cids, c)
+		m := postgres.EpochMemberRow{
+			LeafIndex:  int32(i),
+			EntityKind: b.EntityKind,
+			EntityKey:  b.EntityKey,
+			BucketKey:  b.BucketKey,
+			TreeAlg:    b.TreeAlg,
+			LeafCount:  b.LeafCount,
+			RootHash:   b.RootHash,
+		}
+		members = append(members, m)
+		entries = append(entries, memberLeaf(m))
+	}
+
+	root := crypto.BuildMerkleRoot(crypto.EpochTreeAlg, entries)
+	block, mc, err := crypto.EncodeEpochManifest(period, root, cids)
+	if err != nil {
+		return postgres.EpochRow{}, nil, err
+	}
+	if _, _, err := s.blobs.Put(mc.String()+".car", func(w io.Writer) error {
+		return car.WriteV1(w, mc, []car.Block{{CID: mc, Data: block}})
+	}); err != nil {
+		return postgres.EpochRow{}, nil, err
+	}
+
+	tx, err := s.repo.Begin(ctx)
+	if err != nil {
+		return postgres.EpochRow{}, nil, err
+	}
+	defer tx.Rollback(ctx)
+	cs := mc.String()
+	e, err := s.repo.InsertEpoch(ctx, tx, postgres.EpochRow{
+		Period:    period,
+		TreeAlg:   int16(crypto.EpochTreeAlg),
+		RootHash:  root,
+		LeafCount: int32(len(members)),
+		CID:       &cs,
+	}, members)
+	if err != nil {
+		return postgres.EpochRow{}, nil, err
+	}
+	for i := range members {
+		members[i].EpochID = e.ID
+	}
+	return e, members, tx.Commit(ctx)
+}
+
+func (s *BucketService) GetEpoch(ctx context.Context, id int64) (*bucketv1.EpochInfo, error) {
+	e, err := s.repo.GetEpoch(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	members, err := s.repo.SelectEpochMembers(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return epochToProto(e, members), nil
+}
+
+// ListEpochsByStatus pages like ListByStatus (page_token is an offset).
+func (s *BucketService) ListEpochsByStatus(ctx context.Context, status string, limit int32, pageToken string) (*bucketv1.ListEpochsByStatusResponse, error) {
+	if limit <= 0 {
+		limit = 50
+	}
+	var offset int32
+	if pageToken != "" {
+		if n, err := strconv.Atoi(pageToken); err == nil && n >= 0 {
+			offset = int32(n)
+		}
+	}
+	rows, err := s.repo.ListEpochsByStatus(ctx, status, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	out := &bucketv1.ListEpochsByStatusResponse{}
+	for _, e := range rows {
+		members, err := s.repo.SelectEpochMembers(ctx, e.ID)
+		if err != nil {
+			return nil, err
+		}
+		out.Epochs = append(out.Epochs, epochToProto(e, members))
+	}
+	if int32(len(rows)) == limit {
+		out.NextPageToken = fmt.Sprint(offset + limit)
+	}
+	return out, nil
+}
+
+// SetEpochAnchorPending records the tx sent to anchor a needs_anchoring
+// epoch, plus proof when the anchor is a self-contained token. The epoch's
+// members move anchoring -> anchoring_pending with that tx and are confirmed
+// one by one with SetBucketAnchored.
+func (s *BucketService) SetEpochAnchorPending(ctx context.Context, id int64, anchoredTx string, proof []byte, actor string) (*bucketv1.EpochInfo, error) {
+	if anchoredTx == "" || actor == "" {
+		return nil, errors.New("anchored_tx and actor required")
+	}
+	e, err := s.repo.SetEpochAnchorPending(ctx, id, anchoredTx, proof, actor)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("epoch %d is not %s", id, StatusNeedsAnchoring)
+	}
+	if err != nil {
+		return nil, err
+	}
+	members, err := s.repo.SelectEpochMembers(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return epochToProto(e, members), nil
+}
+
+// proveInEpoch returns the epoch step for a bucket whose current root is the
+// one its latest epoch committed to, or nil when the bucket is in no live
+// epoch (or has changed since it was sealed).
+func (s *BucketService) proveInEpoch(ctx context.Context, kind, key, bkey string, leafCount int32, root []byte) (*epochProof, error) {
+	m, err := s.repo.GetEpochMember(ctx, kind, key, bkey)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if m.LeafCount != leafCount || !bytes.Equal(m.RootHash, root) {
+		return nil, nil
+	}
+	e, err := s.repo.GetEpoch(ctx, m.EpochID)
+	if err != nil {
+		return nil, err
+	}
+	if e.Status == StatusFailed {
+		return nil, nil
+	}
+	members, err := s.repo.SelectEpochMembers(ctx, m.EpochID)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([][]byte, len(members))
+	for i, mm := range members {
+		entries[i] = memberLeaf(mm)
+	}
+	leaf, path, eroot := crypto.BuildProof(crypto.EpochTreeAlg, entries, int(m.LeafIndex))
+	if leaf == nil || !bytes.Equal(eroot, e.RootHash) {
+		return nil, fmt.Errorf("epoch %d members do not rebuild its root", e.ID)
+	}
+	return &epochProof{Epoch: e, Member: m, Leaf: leaf, Path: path}, nil
+}
+
+// BucketEpochProof returns the epoch step of a bucket (see proveInEpoch),
+// or nil.
+func (s *BucketService) BucketEpochProof(ctx context.Context, b BucketDTO) (*bucketv1.EpochProof, error) {
+	p, err := s.proveInEpoch(ctx, b.EntityKind, b.EntityKey, b.BucketKey, b.LeafCount, b.RootHash)
+	if err != nil {
+		return nil, err
+	}
+	return p.toProto(), nil
+}
+
+func (p *epochProof) toProto() *bucketv1.EpochProof {
+	if p == nil {
+		return nil
+	}
+	out := &bucketv1.EpochProof{
+		Epoch:           epochToProto(p.Epoch, nil),
+		LeafIndex:       uint32(p.Member.LeafIndex),
+		Leaf:            p.Leaf,
+		BucketLeafCount: uint32(p.Member.LeafCount),
+	}
+	for _, st := range p.Path {
+		out.Path = append(out.Path, &bucketv1.InclusionProofResponse_Step{Sibling: st.Sibling, SiblingIsLeft: st.SiblingIsLeft})
+	}
+	return out
+}
+
+func memberLeaf(m postgres.EpochMemberRow) []byte {
+	return crypto.EpochLeaf(m.EntityKind, m.EntityKey, m.BucketKey, crypto.TreeAlg(m.TreeAlg), uint32(m.LeafCount), m.RootHash)
+}
+
+func epochToProto(e postgres.EpochRow, members []postgres.EpochMemberRow) *bucketv1.EpochInfo {
+	out := &bucketv1.EpochInfo{
+		Id:          uint64(e.ID),
+		Period:      e.Period,
+		RootHash:    e.RootHash,
+		LeafCount:   uint32(e.LeafCount),
+		TreeAlg:     uint32(e.TreeAlg),
+		Status:      e.Status,
+		CreatedAt:   e.CreatedAt.UTC().Format(time.RFC3339),
+		AnchorProof: e.AnchorProof,
+	}
+	if e.CID != nil {
+		out.Cid = *e.CID
+	}
+	if e.AnchoredTx != nil {
+		out.AnchoredTx = *e.AnchoredTx
+	}
+	if e.AnchoredAt != nil {
+		out.AnchoredAt = e.AnchoredAt.UTC().Format(time.RFC3339)
+	}
+	for _, m := range members {
+		out.Members = append(out.Members, &bucketv1.EpochMember{
+			Ref: &bucketv1.BucketRef{
+				Scope:     &bucketv1.Scope{EntityKind: m.EntityKind, EntityKey: m.EntityKey},
+				BucketKey: m.BucketKey,
+			},
+			LeafIndex: uint32(m.LeafIndex),
+			RootHash:  m.RootHash,
+			LeafCount: uint32(m.LeafCount),
+			TreeAlg:   uint32(m.TreeAlg),
+		})
+	}
+	return out
+}
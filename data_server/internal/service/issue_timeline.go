@@ -5,11 +5,14 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"time"
 
 	pb "github.com/gusplusbus/trustflow/data_server/gen/issuetimelinev1"
 	"github.com/gusplusbus/trustflow/data_server/internal/repo/postgres"
+	"github.com/gusplusbus/trustflow/data_server/internal/service/bucketing"
 	"github.com/gusplusbus/trustflow/data_server/internal/service/crypto"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -19,14 +22,15 @@ type IssuesTimelineService struct {
 	repo       *postgres.IssuesTimelinePG
 	bucketRepo *postgres.BucketRepo // nil => bucket writes disabled
 	pool       *pgxpool.Pool        // tx handle when bucket writes enabled
+	policy     bucketing.Policy     // default when no scope/project override
 }
 
 func NewIssuesTimelineService(repo *postgres.IssuesTimelinePG) *IssuesTimelineService {
 	return &IssuesTimelineService{repo: repo}
 }
 
-func NewIssuesTimelineServiceWithBuckets(repo *postgres.IssuesTimelinePG, bucket *postgres.BucketRepo, pool *pgxpool.Pool) *IssuesTimelineService {
-	return &IssuesTimelineService{repo: repo, bucketRepo: bucket, pool: pool}
+func NewIssuesTimelineServiceWithBuckets(repo *postgres.IssuesTimelinePG, bucket *postgres.BucketRepo, pool *pgxpool.Pool, policy bucketing.Policy) *IssuesTimelineService {
+	return &IssuesTimelineService{repo: repo, bucketRepo: bucket, pool: pool, policy: policy}
 }
 
 func (s *IssuesTimelineService) GetCheckpoint(ctx context.Context, req *pb.GetCheckpointRequest) (*pb.GetCheckpointResponse, error) {
@@ -120,11 +124,16 @@ func (s *IssuesTimelineService) AppendBatch(ctx context.Context, req *pb.AppendB
 
 // appendToBuckets:
 //  - canonicalize + hash each item (DAG-CBOR -> SHA-256)
-//  - group by daily bucket_key (UTC "YYYY-MM-DD")
-//  - Insert timeline_items (idempotent by provider_event_id)
+//  - pick each bucket_key with the scope's bucketing policy (UTC hour, day or
+//    ISO week of created_at, or size-capped buckets filled in arrival order)
+//  - Insert timeline_items (idempotent by provider_event_id), numbering them
+//    from the scope's locked sequence counter
 //  - Insert new leaves with proper leaf_index
-//  - Recompute Merkle root and upsert bucket row
-//  - Auto-close buckets from days before today
+//  - Advance the bucket's stored Merkle frontier (no full leaf reload)
+//  - Record the new root at its leaf count (for consistency proofs)
+//  - Route items for sealed (non-open) buckets to an amendment bucket
+//  - Auto-close buckets whose window has passed or that reached their cap,
+//    sealing each into the scope's bucket chain
 func (s *IssuesTimelineService) appendToBuckets(ctx context.Context, ghIssueID int64, items []postgres.RawItem) error {
 	tx, err := s.pool.Begin(ctx)
 	if err != nil {
@@ -135,35 +144,46 @@ func (s *IssuesTimelineService) appendToBuckets(ctx context.Context, ghIssueID i
 	entityKind := "issue"
 	entityKey := fmt.Sprintf("gh#%d", ghIssueID)
 
-	// Accumulate new leaf hashes by bucket_key
-	type bucketAcc struct{ leaves [][]byte }
+	policy, err := s.bucketPolicy(ctx, entityKind, entityKey, ghIssueID)
+	if err != nil {
+		return err
+	}
+	keys, err := s.newBucketKeyer(ctx, tx, entityKind, entityKey, policy)
+	if err != nil {
+		return err
+	}
+	// seq_in_entity comes from the scope's counter, locked until commit so
+	// concurrent batches of one issue cannot take the same numbers
+	lastSeq, err := s.bucketRepo.LockEntitySeq(ctx, tx, entityKind, entityKey)
+	if err != nil {
+		return err
+	}
+	firstSeq := lastSeq
+
+	// Accumulate new leaf hashes (and their events) by bucket_key
+	type bucketAcc struct {
+		leaves [][]byte
+		events []string
+	}
 	acc := map[string]*bucketAcc{}
 
 	for _, it := range items {
-		// Canonicalize payload map for hashing
-		var pm map[string]any
-		if len(it.PayloadJSON) > 0 {
-			_ = json.Unmarshal(it.PayloadJSON, &pm)
-		}
-		canon := crypto.CanonItem{
-			Provider:        it.Provider,
-			ProviderEventID: it.ProviderEventID,
-			Type:            it.Type,
-			Actor:           it.Actor,
-			CreatedAt:       it.CreatedAt.UTC(),
-			Payload:         pm,
+		// Canonicalize for hashing (strict: integers kept exact, floats rejected)
+		canon, err := crypto.CanonicalItem(crypto.DefaultCanon, it.Provider, it.ProviderEventID, it.Type, it.Actor, it.CreatedAt, it.PayloadJSON)
+		if err != nil {
+			return err
 		}
 		_, itemHash, err := crypto.HashDAGCBOR(canon)
 		if err != nil {
 			return err
 		}
 
-		bKey := canon.CreatedAt.Format("2006-01-02")
+		bKey := keys.key(canon.CreatedAt)
 
 		// Insert canonical item row (idempotent on provider_event_id)
 		ok, err := s.bucketRepo.InsertItem(ctx, tx,
 			entityKind, entityKey, it.Provider, it.ProviderEventID, it.Type, it.Actor,
-			canon.CreatedAt, it.PayloadJSON, itemHash, bKey)
+			canon.CreatedAt, it.PayloadJSON, itemHash, bKey, int16(crypto.DefaultCanon), lastSeq+1)
 		if err != nil {
 			return err
 		}
@@ -171,51 +191,245 @@ func (s *IssuesTimelineService) appendToBuckets(ctx context.Context, ghIssueID i
 			// duplicate event; do not add another leaf
 			continue
 		}
+		lastSeq++
+		keys.added()
 		if acc[bKey] == nil {
 			acc[bKey] = &bucketAcc{}
 		}
 		acc[bKey].leaves = append(acc[bKey].leaves, itemHash)
+		acc[bKey].events = append(acc[bKey].events, it.ProviderEventID)
 	}
 
-	// Per bucket: rebuild root and write leaves + upsert bucket row
-	for bKey, a := range acc {
-		// Load existing leaves to compute base index and new root
-		prevLeaves, err := s.bucketRepo.SelectLeaves(ctx, entityKind, entityKey, bKey)
-		if err != nil && err.Error() != "no rows in result set" {
+	if lastSeq != firstSeq {
+		if err := s.bucketRepo.SetEntitySeq(ctx, tx, entityKind, entityKey, lastSeq); err != nil {
 			return err
 		}
-		all := make([][]byte, 0, len(prevLeaves)+len(a.leaves))
-		for _, v := range prevLeaves {
-			all = append(all, v.LeafHash)
-		}
-		all = append(all, a.leaves...)
-		root := crypto.BuildMerkleRoot(all)
+	}
+
+	// Lock buckets in a stable order so concurrent batches can't deadlock
+	bKeys := make([]string, 0, len(acc))
+	for k := range acc {
+		bKeys = append(bKeys, k)
+	}
+	sort.Strings(bKeys)
 
-		// Upsert bucket (root & leaf_count increment)
-		if err := s.bucketRepo.UpsertBatch(ctx, tx, entityKind, entityKey, bKey, root, int32(len(a.leaves))); err != nil {
+	now := time.Now()
+	var toClose []struct{ key, reason string }
+	for _, bKey := range bKeys {
+		a := acc[bKey]
+		if err := s.bucketRepo.EnsureBucket(ctx, tx, entityKind, entityKey, bKey, int16(crypto.DefaultTreeAlg), policy.String()); err != nil {
 			return err
 		}
-
-		// Insert new leaves with proper leaf_index
-		base := int32(len(prevLeaves))
-		for i, leaf := range a.leaves {
-			if err := s.bucketRepo.InsertLeaf(ctx, tx, entityKind, entityKey, bKey, base+int32(i), leaf); err != nil {
+		f, bp, status, err := lockFrontier(ctx, s.bucketRepo, tx, entityKind, entityKey, bKey)
+		if err != nil {
+			return err
+		}
+		amendment := false
+		if status != "open" {
+			// Late arrivals never touch a sealed bucket: they go to an
+			// amendment bucket that references it, closed right after this batch.
+			sealed := bKey
+			if bKey, err = s.openAmendment(ctx, tx, entityKind, entityKey, sealed, bp); err != nil {
 				return err
 			}
-		}
-
-		// Auto-close any bucket before today so runners can anchor
-		if isBeforeTodayUTC(bKey) {
-			if _, err := s.bucketRepo.MarkClosed(ctx, entityKind, entityKey, bKey); err != nil {
+			if err := s.bucketRepo.MoveItems(ctx, tx, entityKind, entityKey, a.events, bKey); err != nil {
+				return err
+			}
+			if f, _, _, err = lockFrontier(ctx, s.bucketRepo, tx, entityKind, entityKey, bKey); err != nil {
 				return err
 			}
+			amendment = true
+		}
+
+		if err := appendLeaves(ctx, s.bucketRepo, tx, entityKind, entityKey, bKey, f, a.leaves); err != nil {
+			return err
+		}
+
+		// judged by the policy the bucket was created with
+		switch {
+		case amendment:
+			toClose = append(toClose, struct{ key, reason string }{bKey, "amendment"})
+		case bp.Closed(bKey, int(f.Size), now):
+			toClose = append(toClose, struct{ key, reason string }{bKey, "closed by policy " + bp.String()})
 		}
 	}
 
-	return tx.Commit(ctx)
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	// Auto-close finished buckets so runners can anchor
+	for _, c := range toClose {
+		if _, err := sealBucket(ctx, s.bucketRepo, entityKind, entityKey, c.key, actorDataServer, c.reason); err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return err
+		}
+	}
+	return nil
 }
 
-func isBeforeTodayUTC(bucketKey string) bool {
-	today := time.Now().UTC().Format("2006-01-02")
-	return bucketKey < today
+// lockFrontier locks the bucket row and returns its frontier, the policy it
+// was created with and its status. Buckets written before frontiers existed
+// are rebuilt once from their leaves.
+func lockFrontier(ctx context.Context, repo *postgres.BucketRepo, tx pgx.Tx, entityKind, entityKey, bKey string) (*crypto.Frontier, bucketing.Policy, string, error) {
+	row, err := repo.LockFrontier(ctx, tx, entityKind, entityKey, bKey)
+	if err != nil {
+		return nil, bucketing.Policy{}, "", err
+	}
+	policy, err := bucketing.Parse(row.Policy)
+	if err != nil {
+		return nil, bucketing.Policy{}, "", fmt.Errorf("bucket %s: %w", bKey, err)
+	}
+	alg := crypto.TreeAlg(row.TreeAlg)
+	if row.Frontier != nil || row.LeafCount == 0 {
+		f, err := crypto.DecodeFrontier(alg, uint64(row.LeafCount), row.Frontier)
+		return f, policy, row.Status, err
+	}
+	if !alg.Valid() {
+		return nil, bucketing.Policy{}, "", fmt.Errorf("bucket %s: unknown tree_alg %d", bKey, row.TreeAlg)
+	}
+
+	leaves, err := repo.SelectLeaves(ctx, entityKind, entityKey, bKey)
+	if err != nil {
+		return nil, bucketing.Policy{}, "", err
+	}
+	f := crypto.NewFrontier(alg)
+	for _, l := range leaves {
+		if err := insertNodes(ctx, repo, tx, entityKind, entityKey, bKey, f.Append(l.LeafHash)); err != nil {
+			return nil, bucketing.Policy{}, "", err
+		}
+	}
+	return f, policy, row.Status, nil
 }
+
+// openAmendment returns the key of the open amendment bucket for a sealed
+// one: the latest "<sealed>+amend-<n>" if it is still open, else a new one.
+// The sealed row is already locked, so amendments of one bucket are created
+// one at a time.
+func (s *IssuesTimelineService) openAmendment(ctx context.Context, tx pgx.Tx, entityKind, entityKey, sealed string, policy bucketing.Policy) (string, error) {
+	n, err := s.bucketRepo.CountAmendments(ctx, tx, entityKind, entityKey, sealed)
+	if err != nil {
+		return "", err
+	}
+	if n > 0 {
+		key := amendmentKey(sealed, n)
+		row, err := s.bucketRepo.LockFrontier(ctx, tx, entityKind, entityKey, key)
+		if err != nil {
+			return "", err
+		}
+		if row.Status == "open" {
+			return key, nil
+		}
+	}
+	key := amendmentKey(sealed, n+1)
+	err = s.bucketRepo.EnsureAmendment(ctx, tx, entityKind, entityKey, key, int16(crypto.DefaultTreeAlg), policy.String(), sealed)
+	return key, err
+}
+
+func amendmentKey(sealed string, n int) string {
+	return fmt.Sprintf("%s+amend-%d", sealed, n)
+}
+
+// bucketPolicy resolves the scope's policy: its own override, its project's,
+// then the service default.
+func (s *IssuesTimelineService) bucketPolicy(ctx context.Context, entityKind, entityKey string, ghIssueID int64) (bucketing.Policy, error) {
+	raw, err := s.bucketRepo.GetPolicy(ctx, entityKind, entityKey, ghIssueID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return s.policy, nil
+	}
+	if err != nil {
+		return bucketing.Policy{}, err
+	}
+	return bucketing.Parse(raw)
+}
+
+// bucketKeyer assigns bucket keys under a policy. Size-capped buckets fill in
+// arrival order, so it tracks the open bucket and its leaf count; the scope
+// is locked for the tx so concurrent batches cannot overfill one.
+type bucketKeyer struct {
+	policy bucketing.Policy
+	seq    int
+	count  int
+}
+
+func (s *IssuesTimelineService) newBucketKeyer(ctx context.Context, tx pgx.Tx, entityKind, entityKey string, policy bucketing.Policy) (*bucketKeyer, error) {
+	k := &bucketKeyer{policy: policy}
+	if policy.Windowed() {
+		return k, nil
+	}
+	if err := s.bucketRepo.LockScope(ctx, tx, entityKind, entityKey); err != nil {
+		return nil, err
+	}
+	bKey, n, status, err := s.bucketRepo.LatestSizeBucket(ctx, tx, entityKind, entityKey)
+	if errors.Is(err, pgx.ErrNoRows) {
+		k.seq = 1
+		return k, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	seq, ok := bucketing.ParseSizeKey(bKey)
+	if !ok {
+		return nil, fmt.Errorf("size-capped bucket %s has no sequence number", bKey)
+	}
+	k.seq, k.count = seq, int(n)
+	if status != "open" {
+		k.seq, k.count = seq+1, 0
+	}
+	return k, nil
+}
+
+// key returns the bucket the next item goes to; call added once it is stored.
+func (k *bucketKeyer) key(createdAt time.Time) string {
+	if k.policy.Windowed() {
+		return k.policy.Key(createdAt)
+	}
+	if k.count >= k.policy.MaxLeaves {
+		k.seq, k.count = k.seq+1, 0
+	}
+	return bucketing.SizeKey(k.seq)
+}
+
+func (k *bucketKeyer) added() { k.count++ }
+
+// leafAppender is the part of *postgres.BucketRepo that appends to a
+// bucket's tree.
+type leafAppender interface {
+	InsertLeaf(ctx context.Context, tx pgx.Tx, entityKind, entityKey, bucketKey string, leafIndex int32, leafHash []byte) error
+	InsertNode(ctx context.Context, tx pgx.Tx, entityKind, entityKey, bucketKey string, level int32, idx int64, hash []byte) error
+	SaveFrontier(ctx context.Context, tx pgx.Tx, entityKind, entityKey, bucketKey string, root []byte, leafCount int32, frontier []byte) error
+	InsertRoot(ctx context.Context, tx pgx.Tx, entityKind, entityKey, bucketKey string, leafCount int32, root []byte) error
+}
+
+// appendLeaves appends leaves to a locked open bucket: the leaf rows, the
+// nodes they complete, the advanced frontier and the new root at its size.
+// It returns postgres.ErrBucketSealed if the bucket is not open.
+func appendLeaves(ctx context.Context, repo leafAppender, tx pgx.Tx, entityKind, entityKey, bKey string, f *crypto.Frontier, leaves [][]byte) error {
+	base := int32(f.Size)
+	for i, leaf := range leaves {
+		if err := repo.InsertLeaf(ctx, tx, entityKind, entityKey, bKey, base+int32(i), leaf); err != nil {
+			return err
+		}
+		if err := insertNodes(ctx, repo, tx, entityKind, entityKey, bKey, f.Append(leaf)); err != nil {
+			return err
+		}
+	}
+	if err := repo.SaveFrontier(ctx, tx, entityKind, entityKey, bKey, f.Root(), int32(f.Size), f.Encode()); err != nil {
+		return err
+	}
+	return repo.InsertRoot(ctx, tx, entityKind, entityKey, bKey, int32(f.Size), f.Root())
+}
+
+// nodeWriter is the part of *postgres.BucketRepo that stores tree nodes.
+type nodeWriter interface {
+	InsertNode(ctx context.Context, tx pgx.Tx, entityKind, entityKey, bucketKey string, level int32, idx int64, hash []byte) error
+}
+
+func insertNodes(ctx context.Context, repo nodeWriter, tx pgx.Tx, entityKind, entityKey, bKey string, nodes []crypto.Node) error {
+	for _, nd := range nodes {
+		if err := repo.InsertNode(ctx, tx, entityKind, entityKey, bKey, int32(nd.Level), int64(nd.Index), nd.Hash); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"testing"
+
+	"github.com/gusplusbus/trustflow/data_server/internal/repo/postgres"
+	"github.com/gusplusbus/trustflow/data_server/internal/service/bucketing"
+	"github.com/gusplusbus/trustflow/data_server/internal/service/crypto"
+)
+
+func TestAmendmentKey(t *testing.T) {
+	for _, tc := range []struct{ sealed, want string }{
+		{"2025-08-22", "2025-08-22+amend-1"},
+		{"2025-08-22T09", "2025-08-22T09+amend-1"},
+		{bucketing.SizeKey(3), "seq-000003+amend-1"},
+	} {
+		got := amendmentKey(tc.sealed, 1)
+		if got != tc.want {
+			t.Errorf("amendmentKey(%s, 1) = %s, want %s", tc.sealed, got, tc.want)
+		}
+		// an amendment is never taken for the next size-capped bucket
+		if _, ok := bucketing.ParseSizeKey(got); ok {
+			t.Errorf("ParseSizeKey(%s) parsed an amendment key", got)
+		}
+	}
+	if a, b := amendmentKey("2025-08-22", 1), amendmentKey("2025-08-22", 2); a == b || a <= "2025-08-22" {
+		t.Errorf("amendment keys %s, %s: want distinct keys sorting after the sealed one", a, b)
+	}
+}
+
+func TestAppendLeavesSealed(t *testing.T) {
+	ctx := context.Background()
+	h := sha256.Sum256([]byte("late"))
+	for _, open := range []bool{true, false} {
+		m := &memTree{open: open, nodes: map[[2]int64][]byte{}, roots: map[int32][]byte{}}
+		f := crypto.NewFrontier(crypto.TreeAlgRFC6962)
+		err := appendLeaves(ctx, m, nil, "issue", "gh#1", "2025-08-22", f, [][]byte{h[:]})
+		if open {
+			if err != nil || len(m.leaves) != 1 || m.roots[1] == nil {
+				t.Errorf("open bucket: appendLeaves() = %v, %d leaves, root %x", err, len(m.leaves), m.roots[1])
+			}
+			continue
+		}
+		if !errors.Is(err, postgres.ErrBucketSealed) {
+			t.Errorf("sealed bucket: appendLeaves() error = %v, want ErrBucketSealed", err)
+		}
+		if len(m.leaves) != 0 || len(m.roots) != 0 {
+			t.Errorf("sealed bucket: %d leaves, %d roots written", len(m.leaves), len(m.roots))
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"log"
+
+	"github.com/gusplusbus/trustflow/data_server/internal/repo/postgres"
+	"github.com/gusplusbus/trustflow/data_server/internal/service/crypto"
+	"github.com/jackc/pgx/v5"
+)
+
+// itemLeaves returns the hashes of items (in seq order, i.e. arrival) in
+// leaf order o.
+func itemLeaves(o crypto.LeafOrder, items []postgres.ItemRow) [][]byte {
+	in := make([]crypto.OrderedItem, len(items))
+	for i, it := range items {
+		in[i] = crypto.OrderedItem{CreatedAt: it.CreatedAt, ProviderEventID: it.ProviderEventID, ItemHash: it.ItemHash}
+	}
+	return crypto.OrderLeaves(o, in)
+}
+
+// bucketLeaves is the full leaf list a bucket's items give: its item leaves
+// in its leaf order, then its chain leaf if it is chained.
+func bucketLeaves(b postgres.BucketRow, items []postgres.ItemRow) [][]byte {
+	leaves := itemLeaves(crypto.LeafOrder(b.LeafOrder), items)
+	if link := chainLink(b); link != nil {
+		leaves = append(leaves, crypto.ChainLeaf(b.EntityKind, b.EntityKey, *link))
+	}
+	return leaves
+}
+
+// orderForSeal puts the item leaves of a locked open bucket in canonical
+// order, rewriting its tree if arrival order differs, and returns the
+// frontier to append the chain leaf to with the order it ended up in.
+// Leaves that do not match the bucket's items one to one are left as they
+// are (the auditor reports them).
+func orderForSeal(ctx context.Context, repo *postgres.BucketRepo, tx pgx.Tx, entityKind, entityKey, bKey string, f *crypto.Frontier) (*crypto.Frontier, crypto.LeafOrder, error) {
+	items, err := repo.SelectItems(ctx, entityKind, entityKey, bKey)
+	if err != nil {
+		return nil, 0, err
+	}
+	stored, err := repo.SelectLeaves(ctx, entityKind, entityKey, bKey)
+	if err != nil {
+		return nil, 0, err
+	}
+	want := itemLeaves(crypto.LeafCanonical, items)
+	cur := make([][]byte, len(stored))
+	for i, l := range stored {
+		cur[i] = l.LeafHash
+	}
+	switch {
+	case sameLeaves(cur, want):
+		return f, crypto.LeafCanonical, nil
+	case !sameLeafSet(cur, want):
+		log.Printf("seal %s/%s/%s: leaves do not match items; left in arrival order", entityKind, entityKey, bKey)
+		return f, crypto.LeafArrival, nil
+	}
+	f, err = rewriteTree(ctx, repo, tx, entityKind, entityKey, bKey, f.Alg, want, true)
+	return f, crypto.LeafCanonical, err
+}
+
+// treeWriter is the part of *postgres.BucketRepo that writes a bucket's tree.
+type treeWriter interface {
+	DeleteTree(ctx context.Context, tx pgx.Tx, entityKind, entityKey, bucketKey string) error
+	RestoreLeaves(ctx context.Context, tx pgx.Tx, entityKind, entityKey, bucketKey string, leaves [][]byte) error
+	InsertNode(ctx context.Context, tx pgx.Tx, entityKind, entityKey, bucketKey string, level int32, idx int64, hash []byte) error
+	SaveFrontier(ctx context.Context, tx pgx.Tx, entityKind, entityKey, bucketKey string, root []byte, leafCount int32, frontier []byte) error
+	InsertRoot(ctx context.Context, tx pgx.Tx, entityKind, entityKey, bucketKey string, leafCount int32, root []byte) error
+}
+
+// rewriteTree replaces a locked bucket's leaves and nodes with the tree over
+// leaves, and its frontier if it is open (a sealed bucket has none to save).
+// Roots recorded at smaller sizes are kept: they were published, even when
+// over another leaf order (see ConsistencyProof).
+func rewriteTree(ctx context.Context, repo treeWriter, tx pgx.Tx, entityKind, entityKey, bKey string, alg crypto.TreeAlg, leaves [][]byte, open bool) (*crypto.Frontier, error) {
+	if err := repo.DeleteTree(ctx, tx, entityKind, entityKey, bKey); err != nil {
+		return nil, err
+	}
+	if err := repo.RestoreLeaves(ctx, tx, entityKind, entityKey, bKey, leaves); err != nil {
+		return nil, err
+	}
+	f := crypto.NewFrontier(alg)
+	for _, l := range leaves {
+		if err := insertNodes(ctx, repo, tx, entityKind, entityKey, bKey, f.Append(l)); err != nil {
+			return nil, err
+		}
+	}
+	if open {
+		if err := repo.SaveFrontier(ctx, tx, entityKind, entityKey, bKey, f.Root(), int32(f.Size), f.Encode()); err != nil {
+			return nil, err
+		}
+	}
+	if f.Size == 0 {
+		return f, nil
+	}
+	return f, repo.InsertRoot(ctx, tx, entityKind, entityKey, bKey, int32(f.Size), f.Root())
+}
+
+func sameLeaves(a, b [][]byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !bytes.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func sameLeafSet(a, b [][]byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	n := map[string]int{}
+	for _, l := range a {
+		n[string(l)]++
+	}
+	for _, l := range b {
+		if n[string(l)] == 0 {
+			return false
+		}
+		n[string(l)]--
+	}
+	return true
+}
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"testing"
+
+	"github.com/gusplusbus/trustflow/data_server/internal/repo/postgres"
+	"github.com/gusplusbus/trustflow/data_server/internal/service/crypto"
+	"github.com/jackc/pgx/v5"
+)
+
+// memTree keeps one bucket's tree rows; like the database, it has no
+// frontier to save once the bucket is sealed.
+type memTree struct {
+	open   bool
+	leaves [][]byte
+	nodes  map[[2]int64][]byte
+	roots  map[int32][]byte
+	saved  int32 // leaf_count of the last saved frontier
+}
+
+func (m *memTree) DeleteTree(context.Context, pgx.Tx, string, string, string) error {
+	m.leaves, m.nodes = nil, map[[2]int64][]byte{}
+	return nil
+}
+
+func (m *memTree) RestoreLeaves(_ context.Context, _ pgx.Tx, _, _, _ string, leaves [][]byte) error {
+	m.leaves = leaves
+	return nil
+}
+
+func (m *memTree) InsertLeaf(_ context.Context, _ pgx.Tx, k, key, bkey string, _ int32, leaf []byte) error {
+	if !m.open {
+		return fmt.Errorf("%s/%s/%s: %w", k, key, bkey, postgres.ErrBucketSealed)
+	}
+	m.leaves = append(m.leaves, leaf)
+	return nil
+}
+
+func (m *memTree) InsertNode(_ context.Context, _ pgx.Tx, _, _, _ string, level int32, idx int64, hash []byte) error {
+	m.nodes[[2]int64{int64(level), idx}] = hash
+	return nil
+}
+
+func (m *memTree) SaveFrontier(_ context.Context, _ pgx.Tx, _, _, _ string, _ []byte, leafCount int32, _ []byte) error {
+	if !m.open {
+		return postgres.ErrBucketSealed
+	}
+	m.saved = leafCount
+	return nil
+}
+
+func (m *memTree) InsertRoot(_ context.Context, _ pgx.Tx, _, _, _ string, leafCount int32, root []byte) error {
+	if _, ok := m.roots[leafCount]; !ok {
+		m.roots[leafCount] = root
+	}
+	return nil
+}
+
+func TestRewriteTree(t *testing.T) {
+	ctx := context.Background()
+	var leaves [][]byte
+	for _, s := range []string{"a", "b", "c"} {
+		h := sha256.Sum256([]byte(s))
+		leaves = append(leaves, h[:])
+	}
+	want := crypto.BuildMerkleRoot(crypto.TreeAlgRFC6962, leaves)
+	published := []byte("root published at 2 leaves")
+
+	for _, open := range []bool{true, false} {
+		m := &memTree{open: open, roots: map[int32][]byte{2: published}}
+		f, err := rewriteTree(ctx, m, nil, "issue", "gh#1", "2025-08-22", crypto.TreeAlgRFC6962, leaves, open)
+		if err != nil {
+			t.Fatalf("open=%v: rewriteTree() error = %v", open, err)
+		}
+		if !bytes.Equal(f.Root(), want) || !bytes.Equal(m.roots[3], want) || len(m.leaves) != 3 || len(m.nodes) == 0 {
+			t.Fatalf("open=%v: root %x, recorded %x, %d leaves, %d nodes", open, f.Root(), m.roots[3], len(m.leaves), len(m.nodes))
+		}
+		if !bytes.Equal(m.roots[2], published) {
+			t.Errorf("open=%v: root recorded at 2 leaves was dropped or replaced", open)
+		}
+		if open && m.saved != 3 {
+			t.Errorf("open bucket: frontier saved at %d leaves, want 3", m.saved)
+		}
+	}
+}
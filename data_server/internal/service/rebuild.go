@@ -0,0 +1,129 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/gusplusbus/trustflow/data_server/internal/repo/postgres"
+	"github.com/gusplusbus/trustflow/data_server/internal/service/crypto"
+)
+
+// RebuildResult compares one bucket's stored tree with the tree its items give.
+type RebuildResult struct {
+	Bucket      postgres.BucketRow
+	Root        []byte // root rebuilt from the items
+	RootDiffers bool   // stored root_hash or leaf_count is not the rebuilt tree's
+	TreeDiffers bool   // stored leaf, node or root rows do not give the rebuilt tree
+	Rewritten   bool
+	Problem     string // why the bucket could not be rebuilt at all
+}
+
+// Rebuild regenerates every bucket's leaves and Merkle tree from its
+// timeline_items (rehashed, in the bucket's leaf order, chain leaf last) and
+// compares them with what is stored; it returns how many buckets it checked
+// and those that differ. With apply it rewrites the leaf, node and root rows
+// of buckets whose rows differ but whose root_hash the items still give. A
+// bucket whose root differs is only reported: that root may already be
+// signed or anchored. entityKind "" means every scope.
+func (s *BucketService) Rebuild(ctx context.Context, entityKind, entityKey string, apply bool) (int, []RebuildResult, error) {
+	var (
+		checked int
+		out     []RebuildResult
+		after   postgres.BucketKeyRow
+	)
+	for {
+		page, err := s.repo.ListAll(ctx, entityKind, entityKey, after, 200)
+		if err != nil {
+			return checked, out, err
+		}
+		for _, b := range page {
+			r, err := s.rebuildBucket(ctx, b, apply)
+			if err != nil {
+				return checked, out, fmt.Errorf("%s/%s/%s: %w", b.EntityKind, b.EntityKey, b.BucketKey, err)
+			}
+			checked++
+			if r.RootDiffers || r.TreeDiffers || r.Problem != "" {
+				out = append(out, r)
+			}
+		}
+		if len(page) < 200 {
+			return checked, out, nil
+		}
+		last := page[len(page)-1]
+		after = postgres.BucketKeyRow{EntityKind: last.EntityKind, EntityKey: last.EntityKey, BucketKey: last.BucketKey}
+	}
+}
+
+func (s *BucketService) rebuildBucket(ctx context.Context, b postgres.BucketRow, apply bool) (RebuildResult, error) {
+	out := RebuildResult{Bucket: b}
+	kind, key, bkey := b.EntityKind, b.EntityKey, b.BucketKey
+	alg := crypto.TreeAlg(b.TreeAlg)
+	if !alg.Valid() {
+		out.Problem = fmt.Sprintf("unknown tree_alg %d", b.TreeAlg)
+		return out, nil
+	}
+	if !crypto.LeafOrder(b.LeafOrder).Valid() {
+		out.Problem = fmt.Sprintf("unknown leaf_order %d", b.LeafOrder)
+		return out, nil
+	}
+
+	items, err := s.repo.SelectItems(ctx, kind, key, bkey)
+	if err != nil {
+		return out, err
+	}
+	for _, it := range items {
+		canon, err := crypto.CanonicalItem(crypto.CanonVersion(it.CanonVersion), it.Provider, it.ProviderEventID, it.Type, it.Actor, it.CreatedAt, it.PayloadJSON)
+		if err != nil {
+			out.Problem = err.Error()
+			return out, nil
+		}
+		_, h, err := crypto.HashDAGCBOR(canon)
+		if err != nil {
+			return out, err
+		}
+		if !bytes.Equal(h, it.ItemHash) {
+			out.Problem = fmt.Sprintf("item %s no longer matches its stored hash", it.ProviderEventID)
+			return out, nil
+		}
+	}
+	leaves := bucketLeaves(b, items)
+	out.Root = crypto.BuildMerkleRoot(alg, leaves)
+	out.RootDiffers = int(b.LeafCount) != len(leaves) || !bytes.Equal(out.Root, b.RootHash)
+
+	stored, err := s.repo.SelectLeaves(ctx, kind, key, bkey)
+	if err != nil {
+		return out, err
+	}
+	cur := make([][]byte, len(stored))
+	for i, l := range stored {
+		cur[i] = l.LeafHash
+	}
+	out.TreeDiffers = !sameLeaves(cur, leaves)
+	if !out.TreeDiffers && len(leaves) > 0 {
+		if _, err := observedRoot(ctx, s.repo, kind, key, bkey, alg, uint32(len(leaves))); err != nil {
+			out.TreeDiffers = true
+		}
+	}
+	if !apply || out.RootDiffers || !out.TreeDiffers {
+		return out, nil
+	}
+
+	tx, err := s.repo.Begin(ctx)
+	if err != nil {
+		return out, err
+	}
+	defer tx.Rollback(ctx)
+	fr, err := s.repo.LockFrontier(ctx, tx, kind, key, bkey)
+	if err != nil {
+		return out, err
+	}
+	if fr.LeafCount != b.LeafCount || fr.Status != b.Status {
+		return out, fmt.Errorf("bucket changed while rebuilding; run again")
+	}
+	if _, err := rewriteTree(ctx, s.repo, tx, kind, key, bkey, alg, leaves, fr.Status == StatusOpen); err != nil {
+		return out, err
+	}
+	out.Rewritten = true
+	return out, tx.Commit(ctx)
+}
@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gusplusbus/trustflow/data_server/internal/repo/postgres"
+	"github.com/gusplusbus/trustflow/data_server/internal/service/bucketing"
+	"github.com/jackc/pgx/v5"
+)
+
+// SealIdle seals every open windowed bucket whose window ended more than
+// grace before now. Buckets are otherwise only sealed when the next item of
+// their scope arrives, so a quiet scope's last bucket would stay open and
+// never be anchored. It returns how many buckets it sealed.
+func (s *BucketService) SealIdle(ctx context.Context, now time.Time, grace time.Duration) (int, error) {
+	return sealIdle(ctx, s.repo, now, grace, func(b postgres.BucketRow, reason string) error {
+		_, err := sealBucket(ctx, s.repo, b.EntityKind, b.EntityKey, b.BucketKey, actorDataServer, reason)
+		return err
+	})
+}
+
+// idleLister is the part of the bucket repo sealIdle reads.
+type idleLister interface {
+	ListIdle(ctx context.Context, hourlyKey, dailyKey, weeklyKey string, limit int32) ([]postgres.BucketRow, error)
+}
+
+// sealIdle seals what repo lists as idle at now-grace with seal, which
+// returns pgx.ErrNoRows for a bucket that is no longer open.
+func sealIdle(ctx context.Context, repo idleLister, now time.Time, grace time.Duration, seal func(b postgres.BucketRow, reason string) error) (int, error) {
+	cutoff := now.Add(-grace)
+	key := func(k bucketing.Kind) string { return bucketing.Policy{Kind: k}.Key(cutoff) }
+	sealed := 0
+	for {
+		// sealed buckets drop out of the list, so always read the first page
+		page, err := repo.ListIdle(ctx, key(bucketing.Hourly), key(bucketing.Daily), key(bucketing.Weekly), 100)
+		if err != nil {
+			return sealed, err
+		}
+		n := 0
+		for _, b := range page {
+			reason := fmt.Sprintf("%s window ended (grace %s)", b.Policy, grace)
+			err := seal(b, reason)
+			if errors.Is(err, pgx.ErrNoRows) {
+				continue // sealed meanwhile
+			}
+			if err != nil {
+				log.Printf("seal idle %s/%s/%s: %v", b.EntityKind, b.EntityKey, b.BucketKey, err)
+				continue
+			}
+			n++
+		}
+		sealed += n
+		if n == 0 || len(page) < 100 {
+			return sealed, nil
+		}
+	}
+}
+
+// RunSealer seals idle buckets every interval until ctx is done.
+func (s *BucketService) RunSealer(ctx context.Context, interval, grace time.Duration) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		n, err := s.SealIdle(ctx, time.Now(), grace)
+		if err != nil {
+			log.Printf("seal idle: %v", err)
+		} else if n > 0 {
+			log.Printf("seal idle: sealed %d buckets", n)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
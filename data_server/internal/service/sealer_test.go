@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/gusplusbus/trustflow/data_server/internal/repo/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+// memIdle lists buckets the way tl_list_idle_buckets.sql does and seals
+// them the way sealBucket does: only an open bucket can be sealed.
+type memIdle struct {
+	buckets   []postgres.BucketRow
+	afterList func() // runs between listing and sealing, like a concurrent append
+}
+
+func (m *memIdle) ListIdle(_ context.Context, hourlyKey, dailyKey, weeklyKey string, limit int32) ([]postgres.BucketRow, error) {
+	cutoff := map[string]string{"hourly": hourlyKey, "daily": dailyKey, "weekly": weeklyKey}
+	var out []postgres.BucketRow
+	for _, b := range m.buckets {
+		if c, ok := cutoff[b.Policy]; ok && b.Status == StatusOpen && b.BucketKey < c {
+			out = append(out, b)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].BucketKey < out[j].BucketKey })
+	if len(out) > int(limit) {
+		out = out[:limit]
+	}
+	if m.afterList != nil {
+		m.afterList()
+	}
+	return out, nil
+}
+
+func (m *memIdle) seal(b postgres.BucketRow, _ string) error {
+	for i := range m.buckets {
+		if m.buckets[i].EntityKey == b.EntityKey && m.buckets[i].BucketKey == b.BucketKey {
+			if m.buckets[i].Status != StatusOpen {
+				return pgx.ErrNoRows
+			}
+			m.buckets[i].Status = StatusNeedsAnchoring
+			return nil
+		}
+	}
+	return pgx.ErrNoRows
+}
+
+func (m *memIdle) status(entityKey, bucketKey string) string {
+	for _, b := range m.buckets {
+		if b.EntityKey == entityKey && b.BucketKey == bucketKey {
+			return b.Status
+		}
+	}
+	return ""
+}
+
+func TestSealIdleSelectsDueBuckets(t *testing.T) {
+	now := time.Date(2025, 8, 22, 10, 20, 0, 0, time.UTC) // Friday, ISO week 34
+	open := func(key, policy, bkey string) postgres.BucketRow {
+		return postgres.BucketRow{EntityKind: "issue", EntityKey: key, BucketKey: bkey, Policy: policy, Status: StatusOpen}
+	}
+	m := &memIdle{buckets: []postgres.BucketRow{
+		open("h-old", "hourly", "2025-08-22T08"),   // ended 1h20m ago: due
+		open("h-grace", "hourly", "2025-08-22T09"), // ended 20m ago, within grace
+		open("h-now", "hourly", "2025-08-22T10"),   // current window
+		open("d-old", "daily", "2025-08-21"),       // ended 10h20m ago: due
+		open("d-now", "daily", "2025-08-22"),       // current window
+		open("w-old", "weekly", "2025-W33"),        // ended days ago: due
+		open("w-now", "weekly", "2025-W34"),        // current window
+		open("size", "size:500", "seq-000001"),     // size-capped: never idle
+		{EntityKind: "issue", EntityKey: "d-sealed", BucketKey: "2025-08-20", Policy: "daily", Status: StatusNeedsAnchoring},
+	}}
+
+	n, err := sealIdle(context.Background(), m, now, 30*time.Minute, m.seal)
+	if err != nil {
+		t.Fatalf("sealIdle() error = %v", err)
+	}
+	if n != 3 {
+		t.Errorf("sealIdle() sealed %d buckets, want 3", n)
+	}
+	for _, b := range m.buckets {
+		want := b.EntityKey == "h-old" || b.EntityKey == "d-old" || b.EntityKey == "w-old" || b.EntityKey == "d-sealed"
+		if got := b.Status != StatusOpen; got != want {
+			t.Errorf("%s %s: sealed = %v, want %v", b.EntityKey, b.BucketKey, got, want)
+		}
+	}
+}
+
+func TestSealIdlePages(t *testing.T) {
+	m := &memIdle{}
+	for i := 0; i < 250; i++ {
+		m.buckets = append(m.buckets, postgres.BucketRow{EntityKind: "issue", EntityKey: fmt.Sprintf("gh#%d", i), BucketKey: "2025-08-21", Policy: "daily", Status: StatusOpen})
+	}
+	n, err := sealIdle(context.Background(), m, time.Date(2025, 8, 22, 12, 0, 0, 0, time.UTC), time.Hour, m.seal)
+	if err != nil || n != 250 {
+		t.Fatalf("sealIdle() = %d, %v; want 250 sealed", n, err)
+	}
+}
+
+func TestSealIdleRacingAppend(t *testing.T) {
+	now := time.Date(2025, 8, 22, 12, 0, 0, 0, time.UTC)
+	m := &memIdle{buckets: []postgres.BucketRow{
+		{EntityKind: "issue", EntityKey: "gh#1", BucketKey: "2025-08-21", Policy: "daily", Status: StatusOpen},
+		{EntityKind: "issue", EntityKey: "gh#2", BucketKey: "2025-08-21", Policy: "daily", Status: StatusOpen},
+	}}
+	// an item for gh#1 arrives after the sealer listed it: the append seals
+	// the passed window itself before rolling over to today's bucket
+	m.afterList = func() {
+		if m.status("gh#1", "2025-08-21") == StatusOpen {
+			m.seal(postgres.BucketRow{EntityKey: "gh#1", BucketKey: "2025-08-21"}, "")
+			m.buckets = append(m.buckets, postgres.BucketRow{EntityKind: "issue", EntityKey: "gh#1", BucketKey: "2025-08-22", Policy: "daily", Status: StatusOpen})
+		}
+	}
+
+	n, err := sealIdle(context.Background(), m, now, time.Hour, m.seal)
+	if err != nil {
+		t.Fatalf("sealIdle() error = %v", err)
+	}
+	if n != 1 {
+		t.Errorf("sealIdle() sealed %d buckets, want 1 (gh#1 was sealed by the append)", n)
+	}
+	if s := m.status("gh#2", "2025-08-21"); s != StatusNeedsAnchoring {
+		t.Errorf("gh#2 status = %s, want %s", s, StatusNeedsAnchoring)
+	}
+	if s := m.status("gh#1", "2025-08-22"); s != StatusOpen {
+		t.Errorf("gh#1 today's bucket status = %s, want it left open", s)
+	}
+}
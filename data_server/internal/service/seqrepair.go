@@ -0,0 +1,94 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/gusplusbus/trustflow/data_server/internal/repo/postgres"
+	"github.com/gusplusbus/trustflow/data_server/internal/service/crypto"
+)
+
+// SeqRepair is one scope whose seq_in_entity was not exactly 1..n.
+type SeqRepair struct {
+	postgres.SeqAnomalyRow
+	Renumbered int    // items whose seq_in_entity changed (or would, dry run)
+	Skipped    string // why the scope was left as is; "" if repaired
+}
+
+// RepairSequences renumbers every scope whose seq_in_entity has gaps or
+// duplicates to 1..n, keeping the current order (duplicates by insertion).
+// A scope is left as is when that would change a sequence log one of its
+// sealed buckets already committed to. With apply false it only reports.
+func (s *BucketService) RepairSequences(ctx context.Context, apply bool) ([]SeqRepair, error) {
+	anomalies, err := s.repo.SelectSeqAnomalies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]SeqRepair, 0, len(anomalies))
+	for _, a := range anomalies {
+		r, err := s.repairSeq(ctx, a, apply)
+		if err != nil {
+			return out, fmt.Errorf("%s/%s: %w", a.EntityKind, a.EntityKey, err)
+		}
+		out = append(out, r)
+	}
+	return out, nil
+}
+
+func (s *BucketService) repairSeq(ctx context.Context, a postgres.SeqAnomalyRow, apply bool) (SeqRepair, error) {
+	out := SeqRepair{SeqAnomalyRow: a}
+	tx, err := s.repo.Begin(ctx)
+	if err != nil {
+		return out, err
+	}
+	defer tx.Rollback(ctx)
+
+	// holds off appends to the scope until we commit
+	if _, err := s.repo.LockEntitySeq(ctx, tx, a.EntityKind, a.EntityKey); err != nil {
+		return out, err
+	}
+	rows, _, err := seqLog(ctx, s.repo, a.EntityKind, a.EntityKey, 0)
+	if err != nil {
+		return out, err
+	}
+	commits, err := s.repo.SelectLogCommitments(ctx, a.EntityKind, a.EntityKey)
+	if err != nil {
+		return out, err
+	}
+	ids, seqs := renumberSeq(a.EntityKind, a.EntityKey, rows, commits, &out)
+	if out.Skipped != "" || !apply || out.Renumbered == 0 {
+		return out, nil
+	}
+
+	if err := s.repo.RenumberItems(ctx, tx, a.EntityKind, a.EntityKey, ids, seqs); err != nil {
+		return out, err
+	}
+	if err := s.repo.SetEntitySeq(ctx, tx, a.EntityKind, a.EntityKey, int64(len(rows))); err != nil {
+		return out, err
+	}
+	return out, tx.Commit(ctx)
+}
+
+// renumberSeq numbers rows, in their current order, 1..n. It counts the
+// items that change in out.Renumbered, and sets out.Skipped instead of
+// returning a plan if that would change a committed sequence log.
+func renumberSeq(entityKind, entityKey string, rows []postgres.SeqLogRow, commits []postgres.LogCommitmentRow, out *SeqRepair) (ids []string, seqs []int64) {
+	ids = make([]string, len(rows))
+	seqs = make([]int64, len(rows))
+	entries := make([][]byte, len(rows))
+	for i, r := range rows {
+		ids[i], seqs[i] = r.ProviderEventID, int64(i+1)
+		entries[i] = crypto.SeqLeaf(entityKind, entityKey, uint64(i+1), r.ItemHash)
+		if r.Seq != seqs[i] {
+			out.Renumbered++
+		}
+	}
+	for _, c := range commits {
+		if int(c.LogSize) > len(entries) || !bytes.Equal(crypto.BuildMerkleRoot(crypto.SeqLogAlg, entries[:c.LogSize]), c.LogRoot) {
+			out.Skipped = fmt.Sprintf("renumbering changes the %d-entry sequence log committed by bucket %s", c.LogSize, c.BucketKey)
+			return nil, nil
+		}
+	}
+	return ids, seqs
+}
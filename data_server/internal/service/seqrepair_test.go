@@ -0,0 +1,74 @@
+package service
+
+import (
+	"crypto/sha256"
+	"reflect"
+	"testing"
+
+	"github.com/gusplusbus/trustflow/data_server/internal/repo/postgres"
+	"github.com/gusplusbus/trustflow/data_server/internal/service/crypto"
+)
+
+// seqRows returns one item per seq, with ids e1, e2, ... in that order.
+func seqRows(seqs ...int64) []postgres.SeqLogRow {
+	rows := make([]postgres.SeqLogRow, len(seqs))
+	for i, s := range seqs {
+		id := "e" + string(rune('1'+i))
+		h := sha256.Sum256([]byte(id))
+		rows[i] = postgres.SeqLogRow{Seq: s, ItemHash: h[:], ProviderEventID: id}
+	}
+	return rows
+}
+
+func TestSeqEntries(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		seqs []int64
+		ok   bool
+	}{
+		{"empty", nil, true},
+		{"gapless", []int64{1, 2, 3}, true},
+		{"gap", []int64{1, 2, 4}, false},
+		{"duplicate", []int64{1, 2, 2, 3}, false},
+		{"starts late", []int64{2, 3}, false},
+	} {
+		got := seqEntries("issue", "gh#1", seqRows(tc.seqs...))
+		if (got != nil) != tc.ok {
+			t.Errorf("%s: seqEntries() = %d entries (nil=%v), want a log=%v", tc.name, len(got), got == nil, tc.ok)
+		}
+		if tc.ok && len(got) != len(tc.seqs) {
+			t.Errorf("%s: seqEntries() = %d entries, want %d", tc.name, len(got), len(tc.seqs))
+		}
+	}
+}
+
+func TestRenumberSeq(t *testing.T) {
+	// a gap after 2 and a duplicate 5: the items keep their order as 1..5
+	rows := seqRows(1, 2, 4, 5, 5)
+	var out SeqRepair
+	ids, seqs := renumberSeq("issue", "gh#1", rows, nil, &out)
+	if want := []string{"e1", "e2", "e3", "e4", "e5"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("ids = %v, want %v", ids, want)
+	}
+	if want := []int64{1, 2, 3, 4, 5}; !reflect.DeepEqual(seqs, want) {
+		t.Errorf("seqs = %v, want %v", seqs, want)
+	}
+	if out.Renumbered != 2 || out.Skipped != "" {
+		t.Errorf("renumbered %d, skipped %q; want 2 renumbered (4->3, 5->4)", out.Renumbered, out.Skipped)
+	}
+
+	// a sealed bucket committed to the first two entries, which do not move
+	prefix := seqEntries("issue", "gh#1", rows[:2])
+	kept := []postgres.LogCommitmentRow{{BucketKey: "2025-08-21", LogSize: 2, LogRoot: crypto.BuildMerkleRoot(crypto.SeqLogAlg, prefix)}}
+	out = SeqRepair{}
+	if ids, _ := renumberSeq("issue", "gh#1", rows, kept, &out); out.Skipped != "" || len(ids) != 5 {
+		t.Errorf("committed prefix unchanged: skipped %q, %d ids; want repaired", out.Skipped, len(ids))
+	}
+
+	// one committed to a log whose third entry renumbering would change
+	moved := []postgres.LogCommitmentRow{{BucketKey: "2025-08-22", LogSize: 3, LogRoot: []byte("log root over seqs 1, 2, 4")}}
+	out = SeqRepair{}
+	if ids, seqs := renumberSeq("issue", "gh#1", rows, moved, &out); out.Skipped == "" || ids != nil || seqs != nil {
+		t.Errorf("committed log changed: skipped %q, ids %v; want skipped with no plan", out.Skipped, ids)
+	}
+}
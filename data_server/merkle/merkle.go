@@ -0,0 +1,31 @@
+// Package merkle exposes the tree rules data_server builds bucket and epoch
+// roots with, so the ledger and offline verifiers check proofs against the
+// same bytes.
+package merkle
+
+import "github.com/gusplusbus/trustflow/data_server/internal/service/crypto"
+
+// TreeAlg is a bucket's tree_alg: 1 = legacy, 2 = RFC 6962.
+type TreeAlg = crypto.TreeAlg
+
+// ProofStep is one sibling on an inclusion path.
+type ProofStep = crypto.ProofStep
+
+// EpochTreeAlg is the tree every epoch is built with.
+const EpochTreeAlg = crypto.EpochTreeAlg
+
+// EpochLeaf is the epoch tree entry committing to one bucket root.
+func EpochLeaf(entityKind, entityKey, bucketKey string, alg TreeAlg, leafCount uint32, root []byte) []byte {
+	return crypto.EpochLeaf(entityKind, entityKey, bucketKey, alg, leafCount, root)
+}
+
+// BuildProof returns the entry at index, its path and the root over leaves.
+func BuildProof(alg TreeAlg, leaves [][]byte, index int) (leaf []byte, path []ProofStep, root []byte) {
+	return crypto.BuildProof(alg, leaves, index)
+}
+
+// VerifyProof reports whether path takes leaf (the entry, before hashing)
+// to root.
+func VerifyProof(alg TreeAlg, leaf []byte, path []ProofStep, root []byte) bool {
+	return crypto.VerifyProof(alg, leaf, path, root)
+}
@@ -0,0 +1,345 @@
+// Package rfc3161 verifies RFC 3161 timestamp tokens offline, so the ledger
+// (which obtains them) and bundle verifiers (which recheck tsa anchors) agree
+// on what a valid token is.
+package rfc3161
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	_ "crypto/sha1" // for signing-certificate (v1) hashes
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"time"
+)
+
+// Object identifiers (RFC 5652, RFC 3161, RFC 2634, RFC 5035).
+var (
+	oidSignedData    = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 2}
+	oidTSTInfo       = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 16, 1, 4}
+	oidContentType   = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 3}
+	oidMessageDigest = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 4}
+	oidSigningCert   = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 16, 2, 12}
+	oidSigningCertV2 = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 16, 2, 47}
+	oidSHA1          = asn1.ObjectIdentifier{1, 3, 14, 3, 2, 26}
+	oidSHA256        = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 1}
+	oidSHA384        = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 2}
+	oidSHA512        = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 3}
+)
+
+type messageImprint struct {
+	HashAlgorithm pkix.AlgorithmIdentifier
+	HashedMessage []byte
+}
+
+type contentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue `asn1:"tag:0"` // [0] EXPLICIT; Bytes is the inner value
+}
+
+type signedData struct {
+	Version          int
+	DigestAlgorithms []pkix.AlgorithmIdentifier `asn1:"set"`
+	EncapContentInfo encapContentInfo
+	Certificates     asn1.RawValue `asn1:"optional,tag:0"`
+	CRLs             asn1.RawValue `asn1:"optional,tag:1"`
+	SignerInfos      []signerInfo  `asn1:"set"`
+}
+
+type encapContentInfo struct {
+	EContentType asn1.ObjectIdentifier
+	EContent     []byte `asn1:"explicit,optional,tag:0"`
+}
+
+type signerInfo struct {
+	Version            int
+	SID                asn1.RawValue
+	DigestAlgorithm    pkix.AlgorithmIdentifier
+	SignedAttrs        asn1.RawValue `asn1:"optional,tag:0"`
+	SignatureAlgorithm pkix.AlgorithmIdentifier
+	Signature          []byte
+	UnsignedAttrs      asn1.RawValue `asn1:"optional,tag:1"`
+}
+
+type attribute struct {
+	Type   asn1.ObjectIdentifier
+	Values asn1.RawValue // SET OF; Bytes holds the values
+}
+
+type essCertID struct {
+	CertHash     []byte
+	IssuerSerial asn1.RawValue `asn1:"optional"`
+}
+
+type essCertIDv2 struct {
+	HashAlgorithm pkix.AlgorithmIdentifier `asn1:"optional"` // DEFAULT sha256
+	CertHash      []byte
+	IssuerSerial  asn1.RawValue `asn1:"optional"`
+}
+
+type signingCertificate struct {
+	Certs []essCertID
+}
+
+type signingCertificateV2 struct {
+	Certs []essCertIDv2
+}
+
+type tstInfo struct {
+	Version        int
+	Policy         asn1.ObjectIdentifier
+	MessageImprint messageImprint
+	SerialNumber   *big.Int
+	GenTime        asn1.RawValue // GeneralizedTime, possibly with fractional seconds
+	Accuracy       accuracy      `asn1:"optional"`
+	Ordering       bool          `asn1:"optional,default:false"`
+	Nonce          *big.Int      `asn1:"optional"`
+	TSA            asn1.RawValue `asn1:"optional,tag:0"`
+	Extensions     asn1.RawValue `asn1:"optional,tag:1"`
+}
+
+type accuracy struct {
+	Seconds int `asn1:"optional"`
+	Millis  int `asn1:"optional,tag:0"`
+	Micros  int `asn1:"optional,tag:1"`
+}
+
+// Info is what a verified timestamp token attests.
+type Info struct {
+	Digest  []byte    // the timestamped SHA-256 digest
+	GenTime time.Time // when the TSA signed it
+	Serial  *big.Int  // unique per TSA
+	Nonce   *big.Int  // echoed from the request, if any
+	Policy  asn1.ObjectIdentifier
+	Signer  *x509.Certificate
+}
+
+// Verify checks a timestamp token (a CMS SignedData over a TSTInfo) without
+// contacting the TSA: the signature must verify under a certificate in the
+// token that chains to roots (nil => system roots) and is valid for time
+// stamping at the token's time.
+func Verify(token []byte, roots *x509.CertPool) (*Info, error) {
+	var ci contentInfo
+	if rest, err := asn1.Unmarshal(token, &ci); err != nil {
+		return nil, fmt.Errorf("tsa token: %w", err)
+	} else if len(rest) > 0 {
+		return nil, errors.New("tsa token: trailing data")
+	}
+	if !ci.ContentType.Equal(oidSignedData) {
+		return nil, fmt.Errorf("tsa token: content type %v is not signed data", ci.ContentType)
+	}
+	var sd signedData
+	if _, err := asn1.Unmarshal(ci.Content.Bytes, &sd); err != nil {
+		return nil, fmt.Errorf("tsa token: signed data: %w", err)
+	}
+	if !sd.EncapContentInfo.EContentType.Equal(oidTSTInfo) || len(sd.EncapContentInfo.EContent) == 0 {
+		return nil, errors.New("tsa token: does not carry a TSTInfo")
+	}
+	if len(sd.SignerInfos) != 1 {
+		return nil, fmt.Errorf("tsa token: %d signers, want 1", len(sd.SignerInfos))
+	}
+	certs, err := x509.ParseCertificates(sd.Certificates.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("tsa token: certificates: %w", err)
+	}
+	if len(certs) == 0 {
+		return nil, errors.New("tsa token: carries no certificates")
+	}
+
+	var tst tstInfo
+	if _, err := asn1.Unmarshal(sd.EncapContentInfo.EContent, &tst); err != nil {
+		return nil, fmt.Errorf("tsa token: tst info: %w", err)
+	}
+	genTime, err := parseGeneralizedTime(tst.GenTime)
+	if err != nil {
+		return nil, fmt.Errorf("tsa token: gen time: %w", err)
+	}
+	if !tst.MessageImprint.HashAlgorithm.Algorithm.Equal(oidSHA256) || len(tst.MessageImprint.HashedMessage) != 32 {
+		return nil, errors.New("tsa token: message imprint is not a SHA-256 digest")
+	}
+
+	signer, err := verifySigner(sd.SignerInfos[0], sd.EncapContentInfo.EContent, certs)
+	if err != nil {
+		return nil, fmt.Errorf("tsa token: %w", err)
+	}
+	inter := x509.NewCertPool()
+	for _, c := range certs {
+		if c != signer {
+			inter.AddCert(c)
+		}
+	}
+	if _, err := signer.Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: inter,
+		CurrentTime:   genTime,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageTimeStamping},
+	}); err != nil {
+		return nil, fmt.Errorf("tsa token: signer: %w", err)
+	}
+
+	return &Info{
+		Digest:  tst.MessageImprint.HashedMessage,
+		GenTime: genTime,
+		Serial:  tst.SerialNumber,
+		Nonce:   tst.Nonce,
+		Policy:  tst.Policy,
+		Signer:  signer,
+	}, nil
+}
+
+// verifySigner checks the signed attributes against content and their
+// signature, and returns the certificate they name (ESS signing-certificate).
+func verifySigner(si signerInfo, content []byte, certs []*x509.Certificate) (*x509.Certificate, error) {
+	if len(si.SignedAttrs.FullBytes) == 0 {
+		return nil, errors.New("signer has no signed attributes")
+	}
+	h, err := hashFor(si.DigestAlgorithm.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	var contentType asn1.ObjectIdentifier
+	var digest []byte
+	var signer *x509.Certificate
+	for rest := si.SignedAttrs.Bytes; len(rest) > 0; {
+		var a attribute
+		if rest, err = asn1.Unmarshal(rest, &a); err != nil {
+			return nil, fmt.Errorf("signed attributes: %w", err)
+		}
+		switch {
+		case a.Type.Equal(oidContentType):
+			_, err = asn1.Unmarshal(a.Values.Bytes, &contentType)
+		case a.Type.Equal(oidMessageDigest):
+			_, err = asn1.Unmarshal(a.Values.Bytes, &digest)
+		case a.Type.Equal(oidSigningCert):
+			var sc signingCertificate
+			if _, err = asn1.Unmarshal(a.Values.Bytes, &sc); err == nil && len(sc.Certs) > 0 {
+				signer = certByHash(certs, crypto.SHA1, sc.Certs[0].CertHash)
+			}
+		case a.Type.Equal(oidSigningCertV2):
+			var sc signingCertificateV2
+			if _, err = asn1.Unmarshal(a.Values.Bytes, &sc); err == nil && len(sc.Certs) > 0 {
+				alg := sc.Certs[0].HashAlgorithm.Algorithm
+				if len(alg) == 0 {
+					alg = oidSHA256
+				}
+				ch, herr := hashFor(alg)
+				if herr != nil {
+					return nil, herr
+				}
+				signer = certByHash(certs, ch, sc.Certs[0].CertHash)
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("signed attribute %v: %w", a.Type, err)
+		}
+	}
+	if !contentType.Equal(oidTSTInfo) {
+		return nil, errors.New("signed content type is not TSTInfo")
+	}
+	d := h.New()
+	d.Write(content)
+	if !bytes.Equal(digest, d.Sum(nil)) {
+		return nil, errors.New("message digest does not match the TSTInfo")
+	}
+	if signer == nil {
+		return nil, errors.New("signing certificate attribute missing or names no certificate in the token")
+	}
+
+	// The signature covers the attributes DER-encoded as a SET, not [0].
+	signed := append([]byte{0x31}, si.SignedAttrs.FullBytes[1:]...)
+	alg, err := signatureAlgorithm(signer, h)
+	if err != nil {
+		return nil, err
+	}
+	if err := signer.CheckSignature(alg, signed, si.Signature); err != nil {
+		return nil, fmt.Errorf("signature: %w", err)
+	}
+	return signer, nil
+}
+
+func certByHash(certs []*x509.Certificate, h crypto.Hash, sum []byte) *x509.Certificate {
+	for _, c := range certs {
+		d := h.New()
+		d.Write(c.Raw)
+		if bytes.Equal(d.Sum(nil), sum) {
+			return c
+		}
+	}
+	return nil
+}
+
+func hashFor(oid asn1.ObjectIdentifier) (crypto.Hash, error) {
+	switch {
+	case oid.Equal(oidSHA256):
+		return crypto.SHA256, nil
+	case oid.Equal(oidSHA384):
+		return crypto.SHA384, nil
+	case oid.Equal(oidSHA512):
+		return crypto.SHA512, nil
+	case oid.Equal(oidSHA1):
+		return crypto.SHA1, nil
+	}
+	return 0, fmt.Errorf("unsupported digest algorithm %v", oid)
+}
+
+// signatureAlgorithm picks the x509 algorithm for the signer's key type and
+// the signer info's digest; CMS names them separately.
+func signatureAlgorithm(c *x509.Certificate, h crypto.Hash) (x509.SignatureAlgorithm, error) {
+	switch c.PublicKey.(type) {
+	case *ecdsa.PublicKey:
+		switch h {
+		case crypto.SHA256:
+			return x509.ECDSAWithSHA256, nil
+		case crypto.SHA384:
+			return x509.ECDSAWithSHA384, nil
+		case crypto.SHA512:
+			return x509.ECDSAWithSHA512, nil
+		}
+	case *rsa.PublicKey:
+		switch h {
+		case crypto.SHA256:
+			return x509.SHA256WithRSA, nil
+		case crypto.SHA384:
+			return x509.SHA384WithRSA, nil
+		case crypto.SHA512:
+			return x509.SHA512WithRSA, nil
+		}
+	case ed25519.PublicKey:
+		return x509.PureEd25519, nil
+	}
+	return 0, fmt.Errorf("unsupported signer key %T with %v", c.PublicKey, h)
+}
+
+func parseGeneralizedTime(v asn1.RawValue) (time.Time, error) {
+	if v.Class != asn1.ClassUniversal || v.Tag != asn1.TagGeneralizedTime {
+		return time.Time{}, errors.New("not a GeneralizedTime")
+	}
+	return time.Parse("20060102150405Z", string(v.Bytes)) // fractions allowed when parsing
+}
+
+// LoadRoots reads the PEM certificates in file into a pool; "" => nil
+// (system roots).
+func LoadRoots(file string) (*x509.CertPool, error) {
+	if file == "" {
+		return nil, nil
+	}
+	pem, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificates in %s", file)
+	}
+	return roots, nil
+}
@@ -0,0 +1,300 @@
+// Package tsatest runs an in-process RFC 3161 Time Stamping Authority for
+// tests: it answers timestamp queries with tokens signed by a fresh P-256
+// key whose certificate chains to a throwaway CA (see Roots).
+package tsatest
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync"
+	"time"
+)
+
+var (
+	oidSignedData    = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 2}
+	oidTSTInfo       = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 16, 1, 4}
+	oidContentType   = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 3}
+	oidMessageDigest = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 4}
+	oidSigningCertV2 = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 16, 2, 47}
+	oidSHA256        = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 1}
+	oidECDSAWithSHA2 = asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 2}
+	oidPolicy        = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 99999, 1} // made up
+)
+
+type messageImprint struct {
+	HashAlgorithm pkix.AlgorithmIdentifier
+	HashedMessage []byte
+}
+
+type timeStampReq struct {
+	Version        int
+	MessageImprint messageImprint
+	ReqPolicy      asn1.ObjectIdentifier `asn1:"optional"`
+	Nonce          *big.Int              `asn1:"optional"`
+	CertReq        bool                  `asn1:"optional,default:false"`
+}
+
+type tstInfo struct {
+	Version        int
+	Policy         asn1.ObjectIdentifier
+	MessageImprint messageImprint
+	SerialNumber   *big.Int
+	GenTime        time.Time `asn1:"generalized"`
+	Nonce          *big.Int  `asn1:"optional"`
+}
+
+type issuerAndSerial struct {
+	Issuer asn1.RawValue
+	Serial *big.Int
+}
+
+type signerInfo struct {
+	Version            int
+	SID                issuerAndSerial
+	DigestAlgorithm    pkix.AlgorithmIdentifier
+	SignedAttrs        asn1.RawValue
+	SignatureAlgorithm pkix.AlgorithmIdentifier
+	Signature          []byte
+}
+
+type signedData struct {
+	Version          int
+	DigestAlgorithms []pkix.AlgorithmIdentifier `asn1:"set"`
+	EncapContentInfo encapContentInfo
+	Certificates     asn1.RawValue
+	SignerInfos      []signerInfo `asn1:"set"`
+}
+
+type encapContentInfo struct {
+	EContentType asn1.ObjectIdentifier
+	EContent     []byte `asn1:"explicit,tag:0"`
+}
+
+type contentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue
+}
+
+type pkiStatusInfo struct {
+	Status       int
+	StatusString []string `asn1:"optional,utf8"`
+}
+
+type timeStampResp struct {
+	Status         pkiStatusInfo
+	TimeStampToken asn1.RawValue `asn1:"optional"`
+}
+
+type TSA struct {
+	Now func() time.Time // genTime of issued tokens (default time.Now)
+
+	srv    *httptest.Server
+	ca     *x509.Certificate
+	cert   *x509.Certificate
+	key    *ecdsa.PrivateKey
+	serial int64
+
+	mu     sync.Mutex
+	issued int
+	reject string // non-empty => answer with status rejection(2)
+}
+
+// New starts a TSA; call Close when done.
+func New() *TSA {
+	caKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	notBefore := time.Now().Add(-24 * time.Hour)
+	caDER, _ := x509.CreateCertificate(rand.Reader, &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "tsatest CA"},
+		NotBefore:             notBefore,
+		NotAfter:              notBefore.Add(10 * 365 * 24 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}, &x509.Certificate{Subject: pkix.Name{CommonName: "tsatest CA"}}, &caKey.PublicKey, caKey)
+	ca, _ := x509.ParseCertificate(caDER)
+	certDER, _ := x509.CreateCertificate(rand.Reader, &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "tsatest TSA"},
+		NotBefore:    notBefore,
+		NotAfter:     notBefore.Add(10 * 365 * 24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageTimeStamping},
+	}, ca, &key.PublicKey, caKey)
+	cert, _ := x509.ParseCertificate(certDER)
+
+	t := &TSA{Now: time.Now, ca: ca, cert: cert, key: key}
+	t.srv = httptest.NewServer(http.HandlerFunc(t.serve))
+	return t
+}
+
+func (t *TSA) URL() string { return t.srv.URL }
+func (t *TSA) Close()      { t.srv.Close() }
+
+// Roots trusts the TSA's CA.
+func (t *TSA) Roots() *x509.CertPool {
+	p := x509.NewCertPool()
+	p.AddCert(t.ca)
+	return p
+}
+
+// CAPEM is the TSA's CA certificate, PEM-encoded.
+func (t *TSA) CAPEM() []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: t.ca.Raw})
+}
+
+// Issued counts the tokens handed out.
+func (t *TSA) Issued() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.issued
+}
+
+// Reject makes the TSA refuse requests with reason ("" accepts again).
+func (t *TSA) Reject(reason string) {
+	t.mu.Lock()
+	t.reject = reason
+	t.mu.Unlock()
+}
+
+// Token issues a token for a SHA-256 digest without a request, as a bundle
+// a tsa anchor was exported with would carry.
+func (t *TSA) Token(digest []byte) ([]byte, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.serial++
+	t.issued++
+	return t.sign(timeStampReq{
+		Version:        1,
+		MessageImprint: messageImprint{HashAlgorithm: pkix.AlgorithmIdentifier{Algorithm: oidSHA256, Parameters: asn1.NullRawValue}, HashedMessage: digest},
+		CertReq:        true,
+	}, t.serial)
+}
+
+func (t *TSA) serve(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/timestamp-query" {
+		http.Error(w, "want POST application/timestamp-query", http.StatusBadRequest)
+		return
+	}
+	body, _ := io.ReadAll(r.Body)
+	var req timeStampReq
+	if _, err := asn1.Unmarshal(body, &req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var resp timeStampResp
+	t.mu.Lock()
+	if t.reject != "" {
+		resp.Status = pkiStatusInfo{Status: 2, StatusString: []string{t.reject}}
+	} else {
+		t.serial++
+		t.issued++
+		token, err := t.sign(req, t.serial)
+		if err != nil {
+			t.mu.Unlock()
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		resp.TimeStampToken = asn1.RawValue{FullBytes: token}
+	}
+	t.mu.Unlock()
+
+	out, err := asn1.Marshal(resp)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/timestamp-reply")
+	_, _ = w.Write(out)
+}
+
+func (t *TSA) sign(req timeStampReq, serial int64) ([]byte, error) {
+	sha256Alg := pkix.AlgorithmIdentifier{Algorithm: oidSHA256, Parameters: asn1.NullRawValue}
+	tst, err := asn1.Marshal(tstInfo{
+		Version:        1,
+		Policy:         oidPolicy,
+		MessageImprint: req.MessageImprint,
+		SerialNumber:   big.NewInt(serial),
+		GenTime:        t.Now().UTC().Truncate(time.Second),
+		Nonce:          req.Nonce,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tstDigest := sha256.Sum256(tst)
+	certHash := sha256.Sum256(t.cert.Raw)
+	essV2, _ := asn1.Marshal(struct{ Certs []struct{ CertHash []byte } }{
+		Certs: []struct{ CertHash []byte }{{CertHash: certHash[:]}},
+	})
+	attrs := [][]byte{
+		attr(oidContentType, oidTSTInfo),
+		attr(oidMessageDigest, tstDigest[:]),
+		attrRaw(oidSigningCertV2, essV2),
+	}
+	// DER orders SET OF by encoding.
+	sort.Slice(attrs, func(i, j int) bool { return string(attrs[i]) < string(attrs[j]) })
+	var attrBytes []byte
+	for _, a := range attrs {
+		attrBytes = append(attrBytes, a...)
+	}
+	signedSet, _ := asn1.Marshal(asn1.RawValue{Class: asn1.ClassUniversal, Tag: asn1.TagSet, IsCompound: true, Bytes: attrBytes})
+	h := sha256.Sum256(signedSet)
+	sig, err := t.key.Sign(rand.Reader, h[:], crypto.SHA256)
+	if err != nil {
+		return nil, err
+	}
+
+	var certs []byte
+	if req.CertReq {
+		certs = t.cert.Raw
+	}
+	sd, err := asn1.Marshal(signedData{
+		Version:          3,
+		DigestAlgorithms: []pkix.AlgorithmIdentifier{sha256Alg},
+		EncapContentInfo: encapContentInfo{EContentType: oidTSTInfo, EContent: tst},
+		Certificates:     asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: certs},
+		SignerInfos: []signerInfo{{
+			Version:            1,
+			SID:                issuerAndSerial{Issuer: asn1.RawValue{FullBytes: t.cert.RawIssuer}, Serial: t.cert.SerialNumber},
+			DigestAlgorithm:    sha256Alg,
+			SignedAttrs:        asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: attrBytes},
+			SignatureAlgorithm: pkix.AlgorithmIdentifier{Algorithm: oidECDSAWithSHA2},
+			Signature:          sig,
+		}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return asn1.Marshal(contentInfo{
+		ContentType: oidSignedData,
+		Content:     asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: sd},
+	})
+}
+
+func attr(typ asn1.ObjectIdentifier, value any) []byte {
+	v, _ := asn1.Marshal(value)
+	return attrRaw(typ, v)
+}
+
+func attrRaw(typ asn1.ObjectIdentifier, value []byte) []byte {
+	out, _ := asn1.Marshal(struct {
+		Type   asn1.ObjectIdentifier
+		Values asn1.RawValue
+	}{typ, asn1.RawValue{Class: asn1.ClassUniversal, Tag: asn1.TagSet, IsCompound: true, Bytes: value}})
+	return out
+}
@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+/*
+  Incremental Merkle state per bucket.
+
+  - timeline_buckets.frontier:
+      right-edge subtree hashes (one 32-byte node per set bit of leaf_count,
+      lowest level first); NULL for buckets written before this migration,
+      which get it rebuilt from their leaves on the next append
+
+  - timeline_bucket_nodes:
+      completed interior nodes (level >= 1; level 0 lives in
+      timeline_bucket_leaves) so inclusion proofs read O(log n) rows
+*/
+ALTER TABLE timeline_buckets
+  ADD COLUMN IF NOT EXISTS frontier BYTEA;
+
+CREATE TABLE IF NOT EXISTS timeline_bucket_nodes (
+  entity_kind  TEXT   NOT NULL,
+  entity_key   TEXT   NOT NULL,
+  bucket_key   TEXT   NOT NULL,
+  level        INT    NOT NULL,
+  idx          BIGINT NOT NULL,
+  hash         BYTEA  NOT NULL,
+  PRIMARY KEY (entity_kind, entity_key, bucket_key, level, idx)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS timeline_bucket_nodes;
+ALTER TABLE timeline_buckets DROP COLUMN IF EXISTS frontier;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+/*
+  Versioned Merkle tree per bucket.
+
+  - 1 = legacy sha256(l||r), last odd node duplicated (every bucket so far)
+  - 2 = RFC 6962: 0x00 leaf / 0x01 node prefixes, no duplication
+
+  Existing rows keep 1 so their roots and proofs stay verifiable; the
+  service picks the algorithm explicitly when it creates a bucket.
+*/
+ALTER TABLE timeline_buckets
+  ADD COLUMN IF NOT EXISTS tree_alg SMALLINT NOT NULL DEFAULT 1;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE timeline_buckets DROP COLUMN IF EXISTS tree_alg;
+-- +goose StatementEnd
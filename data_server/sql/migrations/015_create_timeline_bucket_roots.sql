@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+/*
+  Every root a bucket has published, keyed by the leaf count it covered.
+  Consistency proofs are only served between recorded sizes, so an auditor
+  can prove that any root they once saw is a prefix of a later one.
+*/
+CREATE TABLE IF NOT EXISTS timeline_bucket_roots (
+  entity_kind  TEXT        NOT NULL,
+  entity_key   TEXT        NOT NULL,
+  bucket_key   TEXT        NOT NULL,
+  leaf_count   INT         NOT NULL,
+  root_hash    BYTEA       NOT NULL,
+  recorded_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+  PRIMARY KEY (entity_kind, entity_key, bucket_key, leaf_count)
+);
+
+-- the current root of every existing bucket counts as observed
+INSERT INTO timeline_bucket_roots (entity_kind, entity_key, bucket_key, leaf_count, root_hash)
+SELECT entity_kind, entity_key, bucket_key, leaf_count, root_hash
+FROM timeline_buckets
+WHERE leaf_count > 0
+ON CONFLICT DO NOTHING;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS timeline_bucket_roots;
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+/*
+  Ledger-signed checkpoints: Ed25519 signatures over
+  (scope, bucket_key, tree_alg, leaf_count, root_hash), see data_server/checkpoint.
+  key_id names the ledger key; public keys are served by the ledger at
+  /.well-known/trustflow-keys.json.
+*/
+CREATE TABLE IF NOT EXISTS timeline_bucket_checkpoints (
+  entity_kind  TEXT        NOT NULL,
+  entity_key   TEXT        NOT NULL,
+  bucket_key   TEXT        NOT NULL,
+  leaf_count   INT         NOT NULL,
+  root_hash    BYTEA       NOT NULL,
+  tree_alg     SMALLINT    NOT NULL,
+  key_id       TEXT        NOT NULL,
+  signature    BYTEA       NOT NULL,
+  signed_at    TIMESTAMPTZ NOT NULL DEFAULT now(),
+  PRIMARY KEY (entity_kind, entity_key, bucket_key, leaf_count, key_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS timeline_bucket_checkpoints;
+-- +goose StatementEnd
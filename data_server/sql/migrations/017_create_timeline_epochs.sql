@@ -0,0 +1,46 @@
+-- +goose Up
+-- +goose StatementBegin
+/*
+  Epochs roll the roots of every bucket closed on one UTC day into a single
+  RFC 6962 tree, so the ledger anchors one root per epoch instead of one per
+  bucket. A straggler closed after its day was sealed gets a second epoch for
+  the same period.
+
+  Each member row records the bucket root the epoch committed to; its epoch
+  leaf is crypto.EpochLeaf(entity_kind, entity_key, bucket_key, tree_alg,
+  leaf_count, root_hash). A bucket belongs to at most one epoch.
+*/
+CREATE TABLE IF NOT EXISTS timeline_epochs (
+  id           BIGSERIAL   PRIMARY KEY,
+  period       TEXT        NOT NULL,
+  tree_alg     SMALLINT    NOT NULL DEFAULT 2,
+  root_hash    BYTEA       NOT NULL,
+  leaf_count   INT         NOT NULL,
+  status       TEXT        NOT NULL DEFAULT 'needs_anchoring', -- needs_anchoring|anchored
+  cid          TEXT,
+  anchored_tx  TEXT,
+  created_at   TIMESTAMPTZ NOT NULL DEFAULT now(),
+  anchored_at  TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_timeline_epochs_status ON timeline_epochs (status, id);
+
+CREATE TABLE IF NOT EXISTS timeline_epoch_members (
+  epoch_id     BIGINT      NOT NULL REFERENCES timeline_epochs(id) ON DELETE CASCADE,
+  leaf_index   INT         NOT NULL,
+  entity_kind  TEXT        NOT NULL,
+  entity_key   TEXT        NOT NULL,
+  bucket_key   TEXT        NOT NULL,
+  tree_alg     SMALLINT    NOT NULL,
+  leaf_count   INT         NOT NULL,
+  root_hash    BYTEA       NOT NULL,
+  PRIMARY KEY (epoch_id, leaf_index),
+  UNIQUE (entity_kind, entity_key, bucket_key)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS timeline_epoch_members;
+DROP TABLE IF EXISTS timeline_epochs;
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+/*
+  Bucketing policy. Each bucket records the policy that keyed it
+  ("hourly" | "daily" | "weekly" | "size:N"); every bucket so far was daily.
+
+  timeline_bucket_policies holds per-scope overrides. A scope's own row wins,
+  then the row of its project (entity_kind 'project', entity_key = project id),
+  then the data server's BUCKET_POLICY default.
+*/
+ALTER TABLE timeline_buckets
+  ADD COLUMN IF NOT EXISTS policy TEXT NOT NULL DEFAULT 'daily';
+
+CREATE TABLE IF NOT EXISTS timeline_bucket_policies (
+  entity_kind  TEXT        NOT NULL,
+  entity_key   TEXT        NOT NULL,
+  policy       TEXT        NOT NULL,
+  updated_at   TIMESTAMPTZ NOT NULL DEFAULT now(),
+  PRIMARY KEY (entity_kind, entity_key)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS timeline_bucket_policies;
+ALTER TABLE timeline_buckets DROP COLUMN IF EXISTS policy;
+-- +goose StatementEnd
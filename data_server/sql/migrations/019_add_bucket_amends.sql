@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+/*
+  Late arrivals. Once a bucket leaves 'open' its leaves and root are final:
+  the repo refuses leaf inserts and frontier updates for it. An event that
+  would land in a sealed bucket goes to an amendment bucket instead, keyed
+  "<sealed key>+amend-<n>" with amends = the sealed bucket_key. Each
+  amendment is closed when the batch that filled it commits, so a later
+  late event opens the next one.
+*/
+ALTER TABLE timeline_buckets
+  ADD COLUMN IF NOT EXISTS amends TEXT;
+
+CREATE INDEX IF NOT EXISTS idx_timeline_buckets_amends
+  ON timeline_buckets (entity_kind, entity_key, amends)
+  WHERE amends IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_timeline_buckets_amends;
+ALTER TABLE timeline_buckets DROP COLUMN IF EXISTS amends;
+-- +goose StatementEnd
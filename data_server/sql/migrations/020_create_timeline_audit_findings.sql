@@ -0,0 +1,39 @@
+-- +goose Up
+-- +goose StatementBegin
+/*
+  Integrity auditor. It re-derives a bucket's evidence from the database
+  (item hashes from payload_json, leaves vs items, root vs leaves, the stored
+  frontier and recorded roots) and keeps one row per failing check. A row is
+  resolved once the same check passes again; audited_at lets the background
+  walker visit the least recently audited buckets first.
+*/
+ALTER TABLE timeline_buckets
+  ADD COLUMN IF NOT EXISTS audited_at TIMESTAMPTZ;
+
+CREATE TABLE IF NOT EXISTS timeline_audit_findings (
+  id             BIGSERIAL   PRIMARY KEY,
+  entity_kind    TEXT        NOT NULL,
+  entity_key     TEXT        NOT NULL,
+  bucket_key     TEXT        NOT NULL,
+  check_name     TEXT        NOT NULL,
+  detail         TEXT        NOT NULL,
+  first_seen_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+  last_seen_at   TIMESTAMPTZ NOT NULL DEFAULT now(),
+  resolved_at    TIMESTAMPTZ
+);
+
+-- at most one open finding per bucket and check
+CREATE UNIQUE INDEX IF NOT EXISTS ux_timeline_audit_findings_open
+  ON timeline_audit_findings (entity_kind, entity_key, bucket_key, check_name)
+  WHERE resolved_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_timeline_buckets_audited
+  ON timeline_buckets (audited_at NULLS FIRST);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_timeline_buckets_audited;
+DROP TABLE IF EXISTS timeline_audit_findings;
+ALTER TABLE timeline_buckets DROP COLUMN IF EXISTS audited_at;
+-- +goose StatementEnd
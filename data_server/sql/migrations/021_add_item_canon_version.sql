@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+/*
+  Strict item canonicalization. Items hashed so far decoded payload_json into
+  float64 numbers (canon_version 1); new items use the documented strict
+  encoding (canon_version 2: exact integers, floats rejected, empty and null
+  payloads equal). The version travels with each item so old hashes stay
+  reproducible.
+*/
+ALTER TABLE timeline_items
+  ADD COLUMN IF NOT EXISTS canon_version SMALLINT NOT NULL DEFAULT 1;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE timeline_items DROP COLUMN IF EXISTS canon_version;
+-- +goose StatementEnd
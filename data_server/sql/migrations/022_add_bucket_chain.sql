@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+/*
+  Hash chain of sealed buckets per scope. When a bucket leaves 'open' it
+  takes the next chain_seq of its (entity_kind, entity_key) and a final leaf
+  committing to prev_root, the root of the scope's previously sealed bucket
+  (empty for the first). Dropping or reordering a bucket then breaks the next
+  link. Buckets sealed before this migration stay unchained.
+*/
+ALTER TABLE timeline_buckets
+  ADD COLUMN IF NOT EXISTS prev_root BYTEA,
+  ADD COLUMN IF NOT EXISTS chain_seq INT;
+
+CREATE UNIQUE INDEX IF NOT EXISTS ux_timeline_buckets_chain
+  ON timeline_buckets (entity_kind, entity_key, chain_seq)
+  WHERE chain_seq IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS ux_timeline_buckets_chain;
+ALTER TABLE timeline_buckets
+  DROP COLUMN IF EXISTS chain_seq,
+  DROP COLUMN IF EXISTS prev_root;
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+/*
+  Completeness. A scope's sequence log is the RFC 6962 tree over its items in
+  seq_in_entity order (entry = seq + item_hash). When a bucket is sealed its
+  chain link also commits the log's size and root at that moment, so a range
+  proof against a committed log root shows a run of seq numbers is complete.
+  log_size stays NULL where the sequence had gaps or duplicates at the seal.
+*/
+ALTER TABLE timeline_buckets
+  ADD COLUMN IF NOT EXISTS log_size INT,
+  ADD COLUMN IF NOT EXISTS log_root BYTEA;
+
+CREATE INDEX IF NOT EXISTS idx_timeline_buckets_log_size
+  ON timeline_buckets (entity_kind, entity_key, log_size)
+  WHERE log_size IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS timeline_items_entity_seq_idx
+  ON timeline_items (entity_kind, entity_key, seq_in_entity);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS timeline_items_entity_seq_idx;
+DROP INDEX IF EXISTS idx_timeline_buckets_log_size;
+ALTER TABLE timeline_buckets
+  DROP COLUMN IF EXISTS log_root,
+  DROP COLUMN IF EXISTS log_size;
+-- +goose StatementEnd
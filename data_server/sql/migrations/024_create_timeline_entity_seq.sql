@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+/*
+  Per-scope sequence counter. seq_in_entity used to be MAX(seq_in_entity)+1
+  at insert time, so two concurrent batches of one issue could both take the
+  same number. Appends now lock the scope's counter row and take numbers from
+  it. Backfilled from the items; 025 then makes the numbers unique.
+*/
+CREATE TABLE IF NOT EXISTS timeline_entity_seq (
+  entity_kind TEXT   NOT NULL,
+  entity_key  TEXT   NOT NULL,
+  last_seq    BIGINT NOT NULL,
+  PRIMARY KEY (entity_kind, entity_key)
+);
+
+INSERT INTO timeline_entity_seq (entity_kind, entity_key, last_seq)
+SELECT entity_kind, entity_key, MAX(seq_in_entity)
+FROM timeline_items
+GROUP BY entity_kind, entity_key
+ON CONFLICT (entity_kind, entity_key) DO NOTHING;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS timeline_entity_seq;
+-- +goose StatementEnd
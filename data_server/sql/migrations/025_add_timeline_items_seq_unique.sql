@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+/*
+  One item per (entity_kind, entity_key, seq_in_entity). Duplicates left by
+  the old MAX+1 numbering must be renumbered first with
+  `trustflow-seqrepair -apply` (it needs 024); this migration refuses to run
+  over them. The constraint is deferrable only so the repair can renumber a
+  scope within one tx.
+*/
+DO $$
+BEGIN
+  IF EXISTS (
+    SELECT 1 FROM timeline_items
+    GROUP BY entity_kind, entity_key, seq_in_entity
+    HAVING COUNT(*) > 1
+  ) THEN
+    RAISE EXCEPTION 'timeline_items has duplicate seq_in_entity; run trustflow-seqrepair -apply first';
+  END IF;
+END $$;
+
+DROP INDEX IF EXISTS timeline_items_entity_seq_idx;
+ALTER TABLE timeline_items
+  ADD CONSTRAINT timeline_items_entity_seq_key
+  UNIQUE (entity_kind, entity_key, seq_in_entity) DEFERRABLE INITIALLY IMMEDIATE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE timeline_items DROP CONSTRAINT IF EXISTS timeline_items_entity_seq_key;
+CREATE INDEX IF NOT EXISTS timeline_items_entity_seq_idx
+  ON timeline_items (entity_kind, entity_key, seq_in_entity);
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+/*
+  Deterministic leaf order. Leaves are appended in arrival order while a
+  bucket is open; when it is sealed its item leaves are re-sorted by
+  created_at, then provider_event_id (leaf_order 1), so the same items give
+  the same root whatever order they were ingested in. The tree is rebuilt
+  in that order, which drops the roots recorded while the bucket was open.
+  Buckets sealed before this migration keep leaf_order 0 (arrival).
+*/
+ALTER TABLE timeline_buckets
+  ADD COLUMN IF NOT EXISTS leaf_order SMALLINT NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE timeline_buckets DROP COLUMN IF EXISTS leaf_order;
+-- +goose StatementEnd
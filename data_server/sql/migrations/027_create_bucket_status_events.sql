@@ -0,0 +1,65 @@
+-- +goose Up
+-- +goose StatementBegin
+/*
+  Bucket status state machine:
+
+    open -> needs_anchoring -> anchoring -> anchored
+                                        \-> failed -> needs_anchoring
+
+  Sealing moves a bucket to needs_anchoring; the ledger claims it
+  (anchoring) before anchoring it, or an epoch claims it when it rolls it
+  up. Every transition is a row in bucket_status_events with who made it
+  and why.
+
+  'closed' was never set by any query; any such rows become
+  needs_anchoring. Buckets already rolled into an epoch that is not
+  anchored yet are claimed by it (anchoring). Both are recorded with actor
+  'migration'.
+*/
+CREATE TABLE IF NOT EXISTS bucket_status_events (
+  id           BIGSERIAL   PRIMARY KEY,
+  entity_kind  TEXT        NOT NULL,
+  entity_key   TEXT        NOT NULL,
+  bucket_key   TEXT        NOT NULL,
+  from_status  TEXT        NOT NULL,
+  to_status    TEXT        NOT NULL,
+  actor        TEXT        NOT NULL,
+  reason       TEXT        NOT NULL DEFAULT '',
+  at           TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS bucket_status_events_bucket_idx
+  ON bucket_status_events (entity_kind, entity_key, bucket_key, id);
+
+WITH moved AS (
+  UPDATE timeline_buckets SET status = 'needs_anchoring'
+  WHERE status = 'closed'
+  RETURNING entity_kind, entity_key, bucket_key
+)
+INSERT INTO bucket_status_events (entity_kind, entity_key, bucket_key, from_status, to_status, actor, reason)
+SELECT entity_kind, entity_key, bucket_key, 'closed', 'needs_anchoring', 'migration', 'closed is no longer a status'
+FROM moved;
+
+WITH moved AS (
+  UPDATE timeline_buckets t SET status = 'anchoring'
+  FROM timeline_epoch_members m, timeline_epochs e
+  WHERE t.status = 'needs_anchoring'
+    AND m.entity_kind = t.entity_kind AND m.entity_key = t.entity_key AND m.bucket_key = t.bucket_key
+    AND e.id = m.epoch_id AND e.status <> 'anchored'
+  RETURNING t.entity_kind, t.entity_key, t.bucket_key, e.id
+)
+INSERT INTO bucket_status_events (entity_kind, entity_key, bucket_key, from_status, to_status, actor, reason)
+SELECT entity_kind, entity_key, bucket_key, 'needs_anchoring', 'anchoring', 'migration', 'member of epoch ' || id
+FROM moved;
+
+ALTER TABLE timeline_buckets
+  ADD CONSTRAINT timeline_buckets_status_check
+  CHECK (status IN ('open', 'needs_anchoring', 'anchoring', 'anchored', 'failed'));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE timeline_buckets DROP CONSTRAINT IF EXISTS timeline_buckets_status_check;
+UPDATE timeline_buckets SET status = 'needs_anchoring' WHERE status IN ('anchoring', 'failed');
+DROP TABLE IF EXISTS bucket_status_events;
+-- +goose StatementEnd
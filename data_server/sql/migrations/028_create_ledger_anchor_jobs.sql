@@ -0,0 +1,45 @@
+-- +goose Up
+-- +goose StatementBegin
+/*
+  Ledger anchoring jobs. The ledger (same database) keeps one row per bucket
+  it has tried to anchor: attempts so far, the last error and when it may
+  try again. After LEDGER_ANCHOR_MAX_ATTEMPTS failures it gives the bucket up
+  and moves it to anchor_failed, a dead letter only an operator re-drives
+  (trustflow-redrive) back to needs_anchoring:
+
+    anchoring -> failed -> needs_anchoring (retry, after next_attempt_at)
+                       \-> anchor_failed -> needs_anchoring (re-drive)
+
+  state is running | pending | retry | dead | done; pending means the
+  anchor tx was sent and is waiting for confirmations.
+*/
+CREATE TABLE IF NOT EXISTS ledger_anchor_jobs (
+  entity_kind      TEXT        NOT NULL,
+  entity_key       TEXT        NOT NULL,
+  bucket_key       TEXT        NOT NULL,
+  state            TEXT        NOT NULL,
+  attempts         INT         NOT NULL DEFAULT 0,
+  last_error       TEXT        NOT NULL DEFAULT '',
+  next_attempt_at  TIMESTAMPTZ,
+  updated_at       TIMESTAMPTZ NOT NULL DEFAULT now(),
+  PRIMARY KEY (entity_kind, entity_key, bucket_key)
+);
+
+CREATE INDEX IF NOT EXISTS ledger_anchor_jobs_state_idx
+  ON ledger_anchor_jobs (state, next_attempt_at);
+
+ALTER TABLE timeline_buckets DROP CONSTRAINT IF EXISTS timeline_buckets_status_check;
+ALTER TABLE timeline_buckets
+  ADD CONSTRAINT timeline_buckets_status_check
+  CHECK (status IN ('open', 'needs_anchoring', 'anchoring', 'anchored', 'failed', 'anchor_failed'));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+UPDATE timeline_buckets SET status = 'failed' WHERE status = 'anchor_failed';
+ALTER TABLE timeline_buckets DROP CONSTRAINT IF EXISTS timeline_buckets_status_check;
+ALTER TABLE timeline_buckets
+  ADD CONSTRAINT timeline_buckets_status_check
+  CHECK (status IN ('open', 'needs_anchoring', 'anchoring', 'anchored', 'failed'));
+DROP TABLE IF EXISTS ledger_anchor_jobs;
+-- +goose StatementEnd
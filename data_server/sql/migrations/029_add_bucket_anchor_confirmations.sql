@@ -0,0 +1,39 @@
+-- +goose Up
+-- +goose StatementBegin
+/*
+  Anchor confirmations. The ledger used to mark a bucket anchored as soon as
+  its tx was sent. It now parks the bucket in anchoring_pending with the tx
+  and polls for the receipt; only once the tx has succeeded and has
+  LEDGER_ANCHOR_CONFIRMATIONS blocks on top is the bucket anchored, with the
+  block that included it:
+
+    anchoring -> anchoring_pending -> anchored
+                                   \-> needs_anchoring (receipt gone: dropped or reorged out)
+                                   \-> failed          (reverted)
+
+  A bucket that leaves anchoring_pending without being anchored loses its
+  anchored_tx. Epoch members take the same path with their epoch's tx (see
+  033). While a bucket is pending its ledger_anchor_jobs row is in state
+  pending, updated_at being when the tx was sent.
+*/
+ALTER TABLE timeline_buckets
+  ADD COLUMN IF NOT EXISTS anchored_block      BIGINT,
+  ADD COLUMN IF NOT EXISTS anchored_block_hash TEXT;
+
+ALTER TABLE timeline_buckets DROP CONSTRAINT IF EXISTS timeline_buckets_status_check;
+ALTER TABLE timeline_buckets
+  ADD CONSTRAINT timeline_buckets_status_check
+  CHECK (status IN ('open', 'needs_anchoring', 'anchoring', 'anchoring_pending', 'anchored', 'failed', 'anchor_failed'));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+UPDATE timeline_buckets SET status = 'anchored', anchored_at = now() WHERE status = 'anchoring_pending';
+ALTER TABLE timeline_buckets DROP CONSTRAINT IF EXISTS timeline_buckets_status_check;
+ALTER TABLE timeline_buckets
+  ADD CONSTRAINT timeline_buckets_status_check
+  CHECK (status IN ('open', 'needs_anchoring', 'anchoring', 'anchored', 'failed', 'anchor_failed'));
+ALTER TABLE timeline_buckets
+  DROP COLUMN IF EXISTS anchored_block_hash,
+  DROP COLUMN IF EXISTS anchored_block;
+-- +goose StatementEnd
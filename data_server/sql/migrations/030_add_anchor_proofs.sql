@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+/*
+  Anchor proofs. Besides EVM transactions the ledger can anchor a root with
+  an RFC 3161 Time Stamping Authority. Such an anchor is the signed
+  timestamp token itself, which anyone holding the TSA's certificate can
+  check offline; anchored_tx then only names it ("tsa:<serial>"). Chain
+  anchors leave anchor_proof NULL.
+*/
+ALTER TABLE timeline_buckets ADD COLUMN IF NOT EXISTS anchor_proof BYTEA;
+ALTER TABLE timeline_epochs  ADD COLUMN IF NOT EXISTS anchor_proof BYTEA;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE timeline_epochs  DROP COLUMN IF EXISTS anchor_proof;
+ALTER TABLE timeline_buckets DROP COLUMN IF EXISTS anchor_proof;
+-- +goose StatementEnd
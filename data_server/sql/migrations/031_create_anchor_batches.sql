@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+/*
+  Anchor batches. In batch mode the ledger anchors the buckets it claims in
+  one tick with a single transaction: their roots become leaves of one
+  RFC 6962 tree (crypto.EpochLeaf, as in epochs) and only the batch root goes
+  on chain, under the id batch/<period>/<id>.
+
+  Each member bucket keeps its own sub-proof to the batch root (leaf index
+  and path, see crypto.EncodeProof) next to the shared anchored_tx. A bucket
+  that goes back to the queue leaves its batch and joins a new one when it is
+  claimed again, so the batch a bucket points at is always the one its
+  anchored_tx committed.
+*/
+CREATE TABLE IF NOT EXISTS timeline_anchor_batches (
+  id          BIGSERIAL   PRIMARY KEY,
+  period      TEXT        NOT NULL,  -- UTC day the batch was sealed
+  tree_alg    SMALLINT    NOT NULL DEFAULT 2,
+  root_hash   BYTEA       NOT NULL,
+  leaf_count  INT         NOT NULL,
+  created_at  TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+ALTER TABLE timeline_buckets
+  ADD COLUMN IF NOT EXISTS anchor_batch_id    BIGINT REFERENCES timeline_anchor_batches(id),
+  ADD COLUMN IF NOT EXISTS anchor_batch_index INT,
+  ADD COLUMN IF NOT EXISTS anchor_batch_path  BYTEA;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE timeline_buckets
+  DROP COLUMN IF EXISTS anchor_batch_path,
+  DROP COLUMN IF EXISTS anchor_batch_index,
+  DROP COLUMN IF EXISTS anchor_batch_id;
+DROP TABLE IF EXISTS timeline_anchor_batches;
+-- +goose StatementEnd
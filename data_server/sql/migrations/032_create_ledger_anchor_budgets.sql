@@ -0,0 +1,42 @@
+-- +goose Up
+-- +goose StatementBegin
+/*
+  Ledger anchoring spend. The ledger (same database) charges the gas of each
+  bucket anchor to the project the bucket's scope belongs to
+  (BucketService.GetScopeProject): one ledger_anchor_fees row per bucket and
+  tx, the tx of an epoch split evenly between its member buckets.
+
+  ledger_anchor_budgets caps a project's spend per UTC day or calendar month,
+  in wei (fee paid) or gas units. A bucket whose project has reached its cap
+  waits in needs_anchoring until the next period, whatever the anchor unit.
+*/
+CREATE TABLE IF NOT EXISTS ledger_anchor_budgets (
+  project_id  TEXT           PRIMARY KEY,
+  period      TEXT           NOT NULL CHECK (period IN ('day', 'month')),
+  unit        TEXT           NOT NULL CHECK (unit IN ('wei', 'gas')),
+  cap         NUMERIC(78, 0) NOT NULL CHECK (cap >= 0),
+  updated_at  TIMESTAMPTZ    NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS ledger_anchor_fees (
+  id           BIGSERIAL      PRIMARY KEY,
+  project_id   TEXT           NOT NULL,
+  entity_kind  TEXT           NOT NULL,
+  entity_key   TEXT           NOT NULL,
+  bucket_key   TEXT           NOT NULL,
+  tx_id        TEXT           NOT NULL,
+  gas_used     BIGINT         NOT NULL,
+  fee_wei      NUMERIC(78, 0) NOT NULL,
+  charged_at   TIMESTAMPTZ    NOT NULL DEFAULT now(),
+  UNIQUE (tx_id, entity_kind, entity_key, bucket_key)
+);
+
+CREATE INDEX IF NOT EXISTS ledger_anchor_fees_project_idx
+  ON ledger_anchor_fees (project_id, charged_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS ledger_anchor_fees;
+DROP TABLE IF EXISTS ledger_anchor_budgets;
+-- +goose StatementEnd
@@ -0,0 +1,38 @@
+-- +goose Up
+-- +goose StatementBegin
+/*
+  Epoch confirmations. An epoch used to be anchored, with its members, as
+  soon as its tx was sent. Its members now wait in anchoring_pending with
+  the epoch's tx like any other sent anchor, and the ledger confirms them
+  one by one; the epoch is anchored with its first confirmed member:
+
+    needs_anchoring -> anchoring_pending -> anchored
+                    \-> failed           \-> failed
+
+  An epoch fails as soon as one of its members leaves anchoring or
+  anchoring_pending without being anchored (send failed, tx dropped or
+  reverted). Its members are then retried and rolled into a new epoch, so a
+  bucket can now be a member of several epochs; the latest one is the one
+  its anchored_tx committed.
+*/
+ALTER TABLE timeline_epoch_members
+  DROP CONSTRAINT IF EXISTS timeline_epoch_members_entity_kind_entity_key_bucket_key_key;
+CREATE INDEX IF NOT EXISTS idx_timeline_epoch_members_bucket
+  ON timeline_epoch_members (entity_kind, entity_key, bucket_key, epoch_id);
+
+ALTER TABLE timeline_epochs DROP CONSTRAINT IF EXISTS timeline_epochs_status_check;
+ALTER TABLE timeline_epochs
+  ADD CONSTRAINT timeline_epochs_status_check
+  CHECK (status IN ('needs_anchoring', 'anchoring_pending', 'anchored', 'failed'));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE timeline_epochs DROP CONSTRAINT IF EXISTS timeline_epochs_status_check;
+UPDATE timeline_epochs SET status = 'anchored', anchored_at = now() WHERE status = 'anchoring_pending';
+DELETE FROM timeline_epochs WHERE status = 'failed';
+DROP INDEX IF EXISTS idx_timeline_epoch_members_bucket;
+ALTER TABLE timeline_epoch_members
+  ADD CONSTRAINT timeline_epoch_members_entity_kind_entity_key_bucket_key_key
+  UNIQUE (entity_kind, entity_key, bucket_key);
+-- +goose StatementEnd
@@ -0,0 +1,39 @@
+-- +goose Up
+-- +goose StatementBegin
+/*
+  Anchor batches are epochs now. In batch mode the ledger seals the buckets
+  it claimed in one tick into an epoch (SealEpoch) and anchors it like a
+  day's epoch, so members get their sub-proof, confirmations and retries
+  from the epoch machinery instead of a tree of their own.
+
+  Batch members still waiting for their tx go back to the queue and are
+  anchored again through an epoch. Anchored ones keep their tx but no longer
+  carry a proof to its batch root.
+*/
+UPDATE timeline_buckets
+SET status = 'needs_anchoring', anchored_tx = NULL, anchor_proof = NULL
+WHERE anchor_batch_id IS NOT NULL AND status IN ('anchoring', 'anchoring_pending');
+
+ALTER TABLE timeline_buckets
+  DROP COLUMN IF EXISTS anchor_batch_path,
+  DROP COLUMN IF EXISTS anchor_batch_index,
+  DROP COLUMN IF EXISTS anchor_batch_id;
+DROP TABLE IF EXISTS timeline_anchor_batches;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS timeline_anchor_batches (
+  id          BIGSERIAL   PRIMARY KEY,
+  period      TEXT        NOT NULL,
+  tree_alg    SMALLINT    NOT NULL DEFAULT 2,
+  root_hash   BYTEA       NOT NULL,
+  leaf_count  INT         NOT NULL,
+  created_at  TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+ALTER TABLE timeline_buckets
+  ADD COLUMN IF NOT EXISTS anchor_batch_id    BIGINT REFERENCES timeline_anchor_batches(id),
+  ADD COLUMN IF NOT EXISTS anchor_batch_index INT,
+  ADD COLUMN IF NOT EXISTS anchor_batch_path  BYTEA;
+-- +goose StatementEnd
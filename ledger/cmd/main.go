@@ -3,12 +3,24 @@ package main
 import (
 	"context"
 	"log"
+	"net"
 	"net/http"
 	"time"
 
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc"
+
+	"github.com/gusplusbus/trustflow/data_server/checkpoint"
+	anchorv1 "github.com/gusplusbus/trustflow/data_server/gen/anchorv1"
+	"github.com/gusplusbus/trustflow/ledger/internal/anchor"
+	"github.com/gusplusbus/trustflow/ledger/internal/budget"
 	"github.com/gusplusbus/trustflow/ledger/internal/config"
 	"github.com/gusplusbus/trustflow/ledger/internal/dataserver"
+	"github.com/gusplusbus/trustflow/ledger/internal/jobs"
+	"github.com/gusplusbus/trustflow/ledger/internal/leader"
 	"github.com/gusplusbus/trustflow/ledger/internal/runner"
+	"github.com/gusplusbus/trustflow/ledger/internal/signing"
+	"github.com/gusplusbus/trustflow/ledger/internal/verify"
 	"github.com/gusplusbus/trustflow/ledger/internal/webhook"
 )
 
@@ -22,16 +34,84 @@ func main() {
 	}
 	defer func() { _ = closeBuckets() }()
 
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	anchorer, err := anchor.New(ctx, anchor.Config{
+		Mode:       cfg.AnchorMode,
+		RPCURL:     cfg.RPCURL,
+		PrivateKey: cfg.PrivateKey,
+		Contract:   cfg.AnchorContract,
+		TSAURL:     cfg.TSAURL,
+		TSACACert:  cfg.TSACACert,
+	})
+	if err != nil {
+		log.Fatalf("[runner] anchor backend: %v", err)
+	}
+
+	keys, err := signing.NewKeyring(cfg.SigningKey, cfg.RetiredKeys)
+	if err != nil {
+		log.Fatalf("[ledger] signing keys: %v", err)
+	}
+	if cfg.SigningKey == "" {
+		log.Printf("[ledger] LEDGER_SIGNING_KEY not set; signing checkpoints with ephemeral key %s", keys.ActiveID())
+	}
+
+	// With a database, replicas share the job and budget stores and elect
+	// one leader to anchor; without one this must be the only replica.
+	var (
+		store   jobs.Store
+		budgets budget.Store
+		elector *leader.Elector
+	)
+	if cfg.DatabaseURL != "" {
+		pool, err := pgxpool.New(ctx, cfg.DatabaseURL)
+		if err != nil {
+			log.Fatalf("[runner] job store: %v", err)
+		}
+		defer pool.Close()
+		store = jobs.NewPGStore(pool)
+		budgets = budget.NewPGStore(pool)
+		elector = leader.New(cfg.DatabaseURL, leader.LockKey)
+		defer func() { _ = elector.Close(context.Background()) }()
+	} else {
+		log.Printf("[runner] DATABASE_URL not set; anchoring jobs and fees are kept in memory and no leader is elected")
+		store = jobs.NewMemoryStore()
+		budgets = budget.NewMemoryStore()
+	}
+
 	r := runner.New(runner.Config{
 		DataServerGRPCAddr: cfg.DataServerGRPCAddr,
 		Interval:           30 * time.Second,
 		ListPageSize:       50,
-	}, bcli)
-
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
+		Epochs:             cfg.AnchorEpochs,
+		Batch:              cfg.AnchorBatch,
+		Retry:              jobs.Policy{MaxAttempts: cfg.AnchorMaxAttempts, BaseDelay: cfg.AnchorRetryBase, MaxDelay: time.Hour},
+		Confirmations:      cfg.AnchorConfirmations,
+		PendingTimeout:     cfg.AnchorTxTimeout,
+	}, bcli, store, anchorer, keys).WithBudgets(budgets)
+	if elector != nil {
+		r.WithLeader(elector)
+	}
 	go r.Start(ctx)
 
+	// --- Anchor verification (gRPC + HTTP) and spend (gRPC) ---
+	reader, _ := anchorer.(anchor.Reader) // nil for dev anchors
+	verifier := verify.New(bcli, reader)
+	lis, err := net.Listen("tcp", cfg.GRPCAddr)
+	if err != nil {
+		log.Fatalf("[ledger] grpc listen: %v", err)
+	}
+	gs := grpc.NewServer()
+	anchorv1.RegisterAnchorServiceServer(gs, verify.NewServer(verifier))
+	anchorv1.RegisterAnchorBudgetServiceServer(gs, budget.NewServer(budgets))
+	go func() {
+		log.Printf("[ledger] grpc listening on %s", cfg.GRPCAddr)
+		if err := gs.Serve(lis); err != nil {
+			log.Fatalf("[ledger] grpc: %v", err)
+		}
+	}()
+
 	// --- HTTP (webhook) ---
 	mux := http.NewServeMux()
 	mux.HandleFunc("/healthz", func(w http.ResponseWriter, _ *http.Request) {
@@ -40,6 +120,8 @@ func main() {
 		_, _ = w.Write([]byte("ok"))
 	})
 	mux.Handle("/webhook/github", webhook.NewGitHubHandler(cfg)) // unchanged
+	mux.Handle(checkpoint.WellKnownPath, keys.Handler())
+	mux.Handle(verify.HTTPPath, verifier.Handler())
 
 	srv := &http.Server{
 		Addr:              cfg.HTTPAddr,
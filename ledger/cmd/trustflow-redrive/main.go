@@ -0,0 +1,110 @@
+// Command trustflow-redrive lists buckets the ledger gave up anchoring
+// (status anchor_failed) with their last error and, with -apply, puts them
+// back in the anchoring queue (needs_anchoring) with a fresh set of attempts.
+// -kind, -key and -bucket narrow it to matching buckets.
+//
+// It talks to the data server at DATASERVER_GRPC_ADDR and to the ledger's job
+// store at DATABASE_URL.
+//
+// Usage:
+//
+//	trustflow-redrive [-apply] [-kind issue] [-key 123] [-bucket 2025-08-21]
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	bucketv1 "github.com/gusplusbus/trustflow/data_server/gen/bucketv1"
+	"github.com/gusplusbus/trustflow/ledger/internal/dataserver"
+	"github.com/gusplusbus/trustflow/ledger/internal/jobs"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+func main() {
+	apply := flag.Bool("apply", false, "re-drive (default: list only)")
+	kind := flag.String("kind", "", "only buckets of this entity kind")
+	key := flag.String("key", "", "only buckets of this entity key")
+	bucket := flag.String("bucket", "", "only this bucket key")
+	flag.Parse()
+	addr, dsn := os.Getenv("DATASERVER_GRPC_ADDR"), os.Getenv("DATABASE_URL")
+	if addr == "" || dsn == "" {
+		fmt.Fprintln(os.Stderr, "DATASERVER_GRPC_ADDR and DATABASE_URL must be set")
+		os.Exit(2)
+	}
+
+	ctx := context.Background()
+	buckets, closeBuckets, err := dataserver.NewBucketClient(addr, 5*time.Second)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "data server: %v\n", err)
+		os.Exit(2)
+	}
+	defer func() { _ = closeBuckets() }()
+	pool, err := pgxpool.New(ctx, dsn)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "connect: %v\n", err)
+		os.Exit(2)
+	}
+	defer pool.Close()
+	store := jobs.NewPGStore(pool)
+
+	var failed []*bucketv1.BucketInfo
+	page := ""
+	for {
+		resp, err := buckets.ListByStatus(ctx, "anchor_failed", 200, page)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "list: %v\n", err)
+			os.Exit(1)
+		}
+		failed = append(failed, resp.GetBuckets()...)
+		if page = resp.GetNextPageToken(); page == "" {
+			break
+		}
+	}
+
+	n, bad := 0, false
+	for _, b := range failed {
+		ref := b.GetRef()
+		k := jobs.Key{EntityKind: ref.GetScope().GetEntityKind(), EntityKey: ref.GetScope().GetEntityKey(), BucketKey: ref.GetBucketKey()}
+		if (*kind != "" && k.EntityKind != *kind) || (*key != "" && k.EntityKey != *key) || (*bucket != "" && k.BucketKey != *bucket) {
+			continue
+		}
+		n++
+		job, err := store.Get(ctx, k)
+		switch {
+		case errors.Is(err, jobs.ErrNotFound):
+			fmt.Printf("%s: no job recorded", k)
+		case err != nil:
+			fmt.Fprintf(os.Stderr, "%s: job: %v\n", k, err)
+			bad = true
+			continue
+		default:
+			fmt.Printf("%s: %d attempts, last error: %s", k, job.Attempts, job.LastError)
+		}
+		if !*apply {
+			fmt.Println()
+			continue
+		}
+		if err := store.Redrive(ctx, k); err != nil {
+			fmt.Printf(": FAILED, %v\n", err)
+			bad = true
+			continue
+		}
+		if _, err := buckets.SetStatus(ctx, ref, "needs_anchoring", "re-driven by operator"); err != nil {
+			fmt.Printf(": FAILED, %v\n", err)
+			bad = true
+			continue
+		}
+		fmt.Println(": re-driven")
+	}
+	if n == 0 {
+		fmt.Println("no anchor_failed buckets")
+	}
+	if bad {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,15 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.20;
+
+/// Minimal registry the ledger anchors bucket Merkle roots into.
+/// bucketId = keccak256("<entity_kind>/<entity_key>/<bucket_key>")
+contract BucketAnchor {
+    event Anchored(bytes32 indexed bucketId, bytes32 root, address indexed sender);
+
+    mapping(bytes32 => bytes32) public roots;
+
+    function anchor(bytes32 bucketId, bytes32 root) external {
+        roots[bucketId] = root;
+        emit Anchored(bucketId, root, msg.sender);
+    }
+}
@@ -0,0 +1,110 @@
+package anchor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// Request is what the runner asks a backend to commit for one bucket.
+type Request struct {
+	EntityKind string
+	EntityKey  string
+	BucketKey  string
+	RootHash   []byte
+	LeafCount  uint32
+	CID        string // manifest CIDv1 (DAG-CBOR, see PackBucket)
+}
+
+// Receipt identifies where the root was committed.
+type Receipt struct {
+	TxID  string
+	Proof []byte // self-contained anchor to store with the bucket (tsa token); nil for chain txs
+}
+
+// Anchorer commits bucket roots somewhere third parties can check them.
+type Anchorer interface {
+	Anchor(ctx context.Context, req Request) (Receipt, error)
+}
+
+// TxStatus is what the chain currently says about an anchor tx.
+type TxStatus struct {
+	Mined         bool // false while pending, or once dropped or reorged out
+	Success       bool // false: the tx reverted
+	BlockNumber   uint64
+	BlockHash     string
+	Confirmations uint64 // blocks from BlockNumber to the head, inclusive
+	GasUsed       uint64
+	Fee           *big.Int // wei paid; nil when the backend costs no gas
+}
+
+// Confirmer is implemented by backends whose receipts are not final when
+// Anchor returns; the runner polls it until a tx has enough confirmations.
+type Confirmer interface {
+	TxStatus(ctx context.Context, txID string) (TxStatus, error)
+}
+
+// EpochKind scopes epoch anchors so their on-chain ids never collide with
+// per-bucket ones: an epoch is anchored as epoch/<period>/<id>.
+const EpochKind = "epoch"
+
+// Commitment is what an anchor committed.
+type Commitment struct {
+	TxStatus
+	BucketID []byte // see BucketID; nil when the anchor does not commit one (tsa)
+	Root     []byte
+	Time     time.Time // when a tsa anchor was made; zero for chain txs
+}
+
+var (
+	// ErrNotAnchorCall means a tx is not an anchor call to the contract.
+	ErrNotAnchorCall = errors.New("not an anchor call")
+	// ErrInvalidProof means a stored anchor proof does not verify.
+	ErrInvalidProof = errors.New("invalid anchor proof")
+)
+
+// Reader is implemented by backends that can read an anchor back, so its
+// root can be checked against the data server's. proof is the Receipt.Proof
+// stored with the anchor, if any.
+type Reader interface {
+	Committed(ctx context.Context, txID string, proof []byte) (*Commitment, error)
+}
+
+const (
+	ModeDev = "dev"
+	ModeEVM = "evm"
+	ModeTSA = "tsa"
+)
+
+// Config selects and configures the anchoring backend.
+type Config struct {
+	Mode string // dev | evm | tsa
+
+	// EVM
+	RPCURL     string
+	PrivateKey string
+	Contract   string
+	ChainID    int64 // 0 => ask the node
+	RPCTimeout time.Duration
+
+	// TSA (RFC 3161)
+	TSAURL    string
+	TSACACert string // PEM file of the CA(s) the TSA chains to; empty => system roots
+}
+
+// New builds the backend selected by cfg.Mode (dev when empty).
+func New(ctx context.Context, cfg Config) (Anchorer, error) {
+	switch strings.ToLower(strings.TrimSpace(cfg.Mode)) {
+	case "", ModeDev:
+		return DevAnchorer{}, nil
+	case ModeEVM:
+		return NewEVMAnchorer(ctx, cfg)
+	case ModeTSA:
+		return NewTSAAnchorer(cfg)
+	default:
+		return nil, fmt.Errorf("unknown anchor mode %q", cfg.Mode)
+	}
+}
@@ -0,0 +1,15 @@
+package anchor
+
+import (
+	"context"
+
+	"github.com/gusplusbus/trustflow/ledger/internal/dataserver"
+)
+
+// DevAnchorer fakes a tx id so the bucket lifecycle can run without a chain.
+// Nothing it returns can be checked by a third party.
+type DevAnchorer struct{}
+
+func (DevAnchorer) Anchor(_ context.Context, _ Request) (Receipt, error) {
+	return Receipt{TxID: dataserver.DevTX("anchored")}, nil
+}
@@ -0,0 +1,158 @@
+package anchor
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/gusplusbus/trustflow/ledger/internal/chain"
+)
+
+// anchor(bytes32 bucketId, bytes32 root) on the BucketAnchor contract.
+var anchorSelector = chain.Keccak256([]byte("anchor(bytes32,bytes32)"))[:4]
+
+// EVMAnchorer submits bucket roots to the BucketAnchor contract over JSON-RPC,
+// signing legacy (EIP-155) transactions with a local key.
+type EVMAnchorer struct {
+	rpc      *chain.Client
+	signer   *chain.Signer
+	contract chain.Address
+	chainID  *big.Int
+
+	mu sync.Mutex // one tx at a time keeps nonces sequential
+}
+
+func NewEVMAnchorer(ctx context.Context, cfg Config) (*EVMAnchorer, error) {
+	if cfg.RPCURL == "" {
+		return nil, errors.New("evm anchor: RPC_URL required")
+	}
+	signer, err := chain.ParsePrivateKey(cfg.PrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("evm anchor: private key: %w", err)
+	}
+	contract, err := chain.ParseAddress(cfg.Contract)
+	if err != nil {
+		return nil, fmt.Errorf("evm anchor: contract: %w", err)
+	}
+	timeout := cfg.RPCTimeout
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	rpc := chain.NewClient(cfg.RPCURL, timeout)
+
+	chainID := big.NewInt(cfg.ChainID)
+	if cfg.ChainID == 0 {
+		if chainID, err = rpc.ChainID(ctx); err != nil {
+			return nil, fmt.Errorf("evm anchor: chain id: %w", err)
+		}
+	}
+	return &EVMAnchorer{rpc: rpc, signer: signer, contract: contract, chainID: chainID}, nil
+}
+
+// BucketID is the bytes32 key the contract stores a root under:
+// keccak256("<entity_kind>/<entity_key>/<bucket_key>").
+func BucketID(entityKind, entityKey, bucketKey string) []byte {
+	return chain.Keccak256([]byte(entityKind + "/" + entityKey + "/" + bucketKey))
+}
+
+// AnchorCalldata encodes anchor(bucketId, root).
+func AnchorCalldata(bucketID, root []byte) ([]byte, error) {
+	if len(bucketID) != 32 || len(root) != 32 {
+		return nil, errors.New("bucket id and root must be 32 bytes")
+	}
+	data := make([]byte, 0, 4+64)
+	data = append(data, anchorSelector...)
+	data = append(data, bucketID...)
+	data = append(data, root...)
+	return data, nil
+}
+
+// ParseAnchorCalldata decodes anchor(bucketId, root) calldata.
+func ParseAnchorCalldata(data []byte) (bucketID, root []byte, err error) {
+	if len(data) != 4+64 || !bytes.Equal(data[:4], anchorSelector) {
+		return nil, nil, ErrNotAnchorCall
+	}
+	return data[4:36], data[36:68], nil
+}
+
+func (a *EVMAnchorer) Anchor(ctx context.Context, req Request) (Receipt, error) {
+	data, err := AnchorCalldata(BucketID(req.EntityKind, req.EntityKey, req.BucketKey), req.RootHash)
+	if err != nil {
+		return Receipt{}, err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	from := a.signer.Address()
+	nonce, err := a.rpc.PendingNonce(ctx, from)
+	if err != nil {
+		return Receipt{}, fmt.Errorf("nonce: %w", err)
+	}
+	gasPrice, err := a.rpc.GasPrice(ctx)
+	if err != nil {
+		return Receipt{}, fmt.Errorf("gas price: %w", err)
+	}
+	gas, err := a.rpc.EstimateGas(ctx, from, a.contract, data)
+	if err != nil {
+		return Receipt{}, fmt.Errorf("estimate gas: %w", err)
+	}
+	gas += gas / 5 // headroom
+
+	raw, _ := a.signer.Sign(chain.LegacyTx{
+		Nonce:    nonce,
+		GasPrice: gasPrice,
+		Gas:      gas,
+		To:       a.contract,
+		Data:     data,
+	}, a.chainID)
+
+	txHash, err := a.rpc.SendRawTransaction(ctx, raw)
+	if err != nil {
+		return Receipt{}, fmt.Errorf("send tx: %w", err)
+	}
+	return Receipt{TxID: txHash}, nil
+}
+
+// TxStatus reads the receipt of an anchor tx and counts its confirmations.
+// A reorg shows up as a receipt in another block, or none at all.
+func (a *EVMAnchorer) TxStatus(ctx context.Context, txID string) (TxStatus, error) {
+	rec, err := a.rpc.TransactionReceipt(ctx, txID)
+	if err != nil || rec == nil {
+		return TxStatus{}, err
+	}
+	head, err := a.rpc.BlockNumber(ctx)
+	if err != nil {
+		return TxStatus{}, err
+	}
+	st := TxStatus{Mined: true, Success: rec.Success, BlockNumber: rec.BlockNumber, BlockHash: rec.BlockHash, GasUsed: rec.GasUsed, Fee: rec.Fee}
+	if head >= rec.BlockNumber {
+		st.Confirmations = head - rec.BlockNumber + 1
+	}
+	return st, nil
+}
+
+// Committed reads back what an anchor tx committed; nil if the chain has no
+// such mined tx. Chain anchors have no proof; the tx is the anchor.
+func (a *EVMAnchorer) Committed(ctx context.Context, txID string, _ []byte) (*Commitment, error) {
+	st, err := a.TxStatus(ctx, txID)
+	if err != nil || !st.Mined {
+		return nil, err
+	}
+	tx, err := a.rpc.TransactionByHash(ctx, txID)
+	if err != nil || tx == nil {
+		return nil, err
+	}
+	if tx.To != a.contract {
+		return nil, fmt.Errorf("%w: tx %s is sent to %s, not %s", ErrNotAnchorCall, txID, tx.To.Hex(), a.contract.Hex())
+	}
+	bucketID, root, err := ParseAnchorCalldata(tx.Input)
+	if err != nil {
+		return nil, err
+	}
+	return &Commitment{TxStatus: st, BucketID: bucketID, Root: root}, nil
+}
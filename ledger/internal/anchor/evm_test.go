@@ -0,0 +1,73 @@
+package anchor
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/gusplusbus/trustflow/ledger/internal/chain/chaintest"
+)
+
+const (
+	devKey      = "ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80"
+	devAddr     = "0xf39fd6e51aad88f6f4ce6ab8827279cfffb92266"
+	devContract = "0x5fbdb2315678afecb367f032d93f642f64180aa3"
+)
+
+func TestEVMAnchorerSubmitsToContract(t *testing.T) {
+	sim := chaintest.New(31337)
+	defer sim.Close()
+
+	ctx := context.Background()
+	a, err := New(ctx, Config{Mode: ModeEVM, RPCURL: sim.URL(), PrivateKey: devKey, Contract: devContract})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	root := sha256.Sum256([]byte("root"))
+	for i := 0; i < 2; i++ {
+		rec, err := a.Anchor(ctx, Request{EntityKind: "issue", EntityKey: "gh#1", BucketKey: "2025-08-22", RootHash: root[:]})
+		if err != nil {
+			t.Fatalf("Anchor() error = %v", err)
+		}
+		if rec.TxID == "" {
+			t.Fatal("Anchor() returned empty tx id")
+		}
+	}
+
+	txs := sim.Txs()
+	if len(txs) != 2 {
+		t.Fatalf("mined %d txs, want 2", len(txs))
+	}
+	if got := txs[0].From.Hex(); got != devAddr {
+		t.Errorf("sender = %s, want %s", got, devAddr)
+	}
+	if got := txs[0].To.Hex(); got != devContract {
+		t.Errorf("to = %s, want %s", got, devContract)
+	}
+	if txs[0].Nonce != 0 || txs[1].Nonce != 1 {
+		t.Errorf("nonces = %d,%d want 0,1", txs[0].Nonce, txs[1].Nonce)
+	}
+	want, _ := AnchorCalldata(BucketID("issue", "gh#1", "2025-08-22"), root[:])
+	if !bytes.Equal(txs[0].Data, want) {
+		t.Errorf("calldata = %x, want %x", txs[0].Data, want)
+	}
+}
+
+func TestEVMAnchorerRejectsBadRoot(t *testing.T) {
+	sim := chaintest.New(31337)
+	defer sim.Close()
+
+	ctx := context.Background()
+	a, err := New(ctx, Config{Mode: ModeEVM, RPCURL: sim.URL(), PrivateKey: devKey, Contract: devContract})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if _, err := a.Anchor(ctx, Request{RootHash: []byte{1, 2, 3}}); err == nil {
+		t.Error("Anchor() accepted a short root")
+	}
+	if n := len(sim.Txs()); n != 0 {
+		t.Errorf("mined %d txs, want 0", n)
+	}
+}
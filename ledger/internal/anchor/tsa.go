@@ -0,0 +1,60 @@
+package anchor
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+
+	"github.com/gusplusbus/trustflow/data_server/rfc3161"
+	"github.com/gusplusbus/trustflow/ledger/internal/tsa"
+)
+
+// TSAAnchorer has bucket roots timestamped by an RFC 3161 Time Stamping
+// Authority. The signed token is the anchor: it proves the root existed at
+// the token's time to anyone who trusts the TSA, with no chain involved, and
+// is final as soon as it is issued. Receipts name the token "tsa:<serial>".
+type TSAAnchorer struct {
+	client *tsa.Client
+	roots  *x509.CertPool // nil => system roots
+}
+
+func NewTSAAnchorer(cfg Config) (*TSAAnchorer, error) {
+	if cfg.TSAURL == "" {
+		return nil, errors.New("tsa anchor: TSA_URL required")
+	}
+	roots, err := rfc3161.LoadRoots(cfg.TSACACert)
+	if err != nil {
+		return nil, fmt.Errorf("tsa anchor: ca cert: %w", err)
+	}
+	return &TSAAnchorer{client: tsa.NewClient(cfg.TSAURL, roots, cfg.RPCTimeout), roots: roots}, nil
+}
+
+func (a *TSAAnchorer) Anchor(ctx context.Context, req Request) (Receipt, error) {
+	token, info, err := a.client.Timestamp(ctx, req.RootHash)
+	if err != nil {
+		return Receipt{}, err
+	}
+	return Receipt{TxID: tsaTxID(info), Proof: token}, nil
+}
+
+// Committed verifies the stored token offline; nil if there is none.
+func (a *TSAAnchorer) Committed(_ context.Context, txID string, proof []byte) (*Commitment, error) {
+	if len(proof) == 0 {
+		return nil, nil
+	}
+	info, err := rfc3161.Verify(proof, a.roots)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidProof, err)
+	}
+	if id := tsaTxID(info); id != txID {
+		return nil, fmt.Errorf("%w: token is %s, not %s", ErrInvalidProof, id, txID)
+	}
+	return &Commitment{
+		TxStatus: TxStatus{Mined: true, Success: true},
+		Root:     info.Digest,
+		Time:     info.GenTime,
+	}, nil
+}
+
+func tsaTxID(info *rfc3161.Info) string { return "tsa:" + info.Serial.Text(16) }
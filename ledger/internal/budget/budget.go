@@ -0,0 +1,167 @@
+// Package budget charges the gas of anchoring to the project a bucket
+// belongs to and caps what each project may spend per day or month.
+package budget
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/gusplusbus/trustflow/ledger/internal/jobs"
+)
+
+// Budget periods (UTC) and units.
+const (
+	Day   = "day"
+	Month = "month"
+
+	Wei = "wei" // fee paid
+	Gas = "gas" // gas used
+)
+
+// ErrNoBudget means the project's spend is not capped.
+var ErrNoBudget = errors.New("no anchoring budget")
+
+// Budget caps a project's anchoring spend per Period, in Unit.
+type Budget struct {
+	ProjectID string
+	Period    string
+	Unit      string
+	Cap       *big.Int
+	UpdatedAt time.Time
+}
+
+func (b Budget) Validate() error {
+	switch {
+	case b.ProjectID == "":
+		return errors.New("project id required")
+	case b.Period != Day && b.Period != Month:
+		return fmt.Errorf("period %q: want %s or %s", b.Period, Day, Month)
+	case b.Unit != Wei && b.Unit != Gas:
+		return fmt.Errorf("unit %q: want %s or %s", b.Unit, Wei, Gas)
+	case b.Cap == nil || b.Cap.Sign() < 0:
+		return errors.New("cap must be a non-negative integer")
+	}
+	return nil
+}
+
+// Start returns when the period containing now began.
+func (b Budget) Start(now time.Time) time.Time {
+	y, m, d := now.UTC().Date()
+	if b.Period == Day {
+		return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	}
+	return time.Date(y, m, 1, 0, 0, 0, 0, time.UTC)
+}
+
+// Fee is one bucket's share of an anchor tx.
+type Fee struct {
+	ProjectID string
+	jobs.Key
+	TxID      string
+	GasUsed   uint64
+	FeeWei    *big.Int
+	ChargedAt time.Time
+}
+
+// Spend totals a project's fees.
+type Spend struct {
+	GasUsed uint64
+	FeeWei  *big.Int
+	Anchors int // buckets charged
+}
+
+// Store persists budgets and fees.
+type Store interface {
+	GetBudget(ctx context.Context, projectID string) (Budget, error)
+	SetBudget(ctx context.Context, b Budget) (Budget, error)
+	DeleteBudget(ctx context.Context, projectID string) error
+
+	// Charge records f; charging a bucket for a tx it was already charged
+	// for does nothing.
+	Charge(ctx context.Context, f Fee) error
+
+	// Spent totals the project's fees charged at or after since.
+	Spent(ctx context.Context, projectID string, since time.Time) (Spend, error)
+}
+
+// Report is a project's spend in the current period of its budget (the
+// current month when it has none).
+type Report struct {
+	ProjectID string
+	Budget    *Budget // nil => uncapped
+	Since     time.Time
+	Spend
+	Over bool // spend has reached the cap
+}
+
+// Check reports projectID's spend in the period containing now.
+func Check(ctx context.Context, s Store, projectID string, now time.Time) (Report, error) {
+	rep := Report{ProjectID: projectID}
+	b, err := s.GetBudget(ctx, projectID)
+	switch {
+	case errors.Is(err, ErrNoBudget):
+		b = Budget{Period: Month}
+	case err != nil:
+		return Report{}, err
+	default:
+		rep.Budget = &b
+	}
+	rep.Since = b.Start(now)
+	if rep.Spend, err = s.Spent(ctx, projectID, rep.Since); err != nil {
+		return Report{}, err
+	}
+	if rep.Budget != nil {
+		spent := rep.FeeWei
+		if b.Unit == Gas {
+			spent = new(big.Int).SetUint64(rep.GasUsed)
+		}
+		rep.Over = spent.Cmp(b.Cap) >= 0
+	}
+	return rep, nil
+}
+
+// OverWith reports whether the spend reaches the cap once inflight more
+// buckets, sent but not charged yet, are charged at the period's average
+// cost per bucket. With nothing charged yet there is no average, and any
+// bucket in flight counts as reaching the cap.
+func (r Report) OverWith(inflight int) bool {
+	if r.Budget == nil {
+		return false
+	}
+	if r.Over || inflight == 0 {
+		return r.Over
+	}
+	if r.Anchors == 0 {
+		return true
+	}
+	spent := r.FeeWei
+	if r.Budget.Unit == Gas {
+		spent = new(big.Int).SetUint64(r.GasUsed)
+	}
+	// spent + spent/anchors*inflight >= cap, kept in integers
+	projected := new(big.Int).Mul(spent, big.NewInt(int64(r.Anchors+inflight)))
+	return projected.Cmp(new(big.Int).Mul(r.Budget.Cap, big.NewInt(int64(r.Anchors)))) >= 0
+}
+
+// Split returns bucket i's share of a tx that anchored n buckets; the first
+// takes what does not divide evenly. fee may be nil (no fee known).
+func Split(gas uint64, fee *big.Int, n, i int) (uint64, *big.Int) {
+	if n <= 1 {
+		return gas, fee
+	}
+	g := gas / uint64(n)
+	if i == 0 {
+		g += gas % uint64(n)
+	}
+	if fee == nil {
+		return g, nil
+	}
+	f, rem := new(big.Int).QuoRem(fee, big.NewInt(int64(n)), new(big.Int))
+	if i == 0 {
+		f.Add(f, rem)
+	}
+	return g, f
+}
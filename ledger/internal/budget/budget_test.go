@@ -0,0 +1,95 @@
+package budget
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/gusplusbus/trustflow/ledger/internal/jobs"
+)
+
+func TestCheck(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemoryStore()
+	now := time.Date(2025, 8, 22, 10, 0, 0, 0, time.UTC)
+	charge := func(bucket string, at time.Time, gas, wei int64) {
+		k := jobs.Key{EntityKind: "issue", EntityKey: "gh#1", BucketKey: bucket}
+		if err := s.Charge(ctx, Fee{ProjectID: "p1", Key: k, TxID: "0x" + bucket, GasUsed: uint64(gas), FeeWei: big.NewInt(wei), ChargedAt: at}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	charge("a", now.AddDate(0, -1, 0), 50, 500) // last month
+	charge("b", now.Add(-24*time.Hour), 30, 300)
+	charge("c", now, 40, 400)
+	charge("c", now, 40, 400) // same bucket and tx: charged once
+
+	rep, err := Check(ctx, s, "p1", now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rep.Budget != nil || rep.Over || rep.Since != time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC) || rep.GasUsed != 70 || rep.Anchors != 2 {
+		t.Fatalf("uncapped: %+v", rep)
+	}
+
+	for _, tc := range []struct {
+		period, unit string
+		cap          int64
+		want         bool
+	}{
+		{Day, Gas, 41, false},
+		{Day, Gas, 40, true},
+		{Month, Gas, 71, false},
+		{Month, Wei, 700, true},
+		{Day, Wei, 0, true},
+	} {
+		if _, err := s.SetBudget(ctx, Budget{ProjectID: "p1", Period: tc.period, Unit: tc.unit, Cap: big.NewInt(tc.cap)}); err != nil {
+			t.Fatal(err)
+		}
+		rep, err := Check(ctx, s, "p1", now)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rep.Over != tc.want {
+			t.Errorf("%s cap %d %s: over = %v (spent %d gas, %s wei), want %v", tc.period, tc.cap, tc.unit, rep.Over, rep.GasUsed, rep.FeeWei, tc.want)
+		}
+	}
+}
+
+func TestOverWith(t *testing.T) {
+	capped := func(cap int64) *Budget { return &Budget{Period: Day, Unit: Gas, Cap: big.NewInt(cap)} }
+	for _, tc := range []struct {
+		name     string
+		rep      Report
+		inflight int
+		want     bool
+	}{
+		{"uncapped", Report{Spend: Spend{GasUsed: 90, Anchors: 1}}, 5, false},
+		{"nothing in flight", Report{Budget: capped(100), Spend: Spend{GasUsed: 40, Anchors: 2}}, 0, false},
+		{"room for two more", Report{Budget: capped(100), Spend: Spend{GasUsed: 40, Anchors: 2}}, 2, false},
+		{"third reaches it", Report{Budget: capped(100), Spend: Spend{GasUsed: 40, Anchors: 2}}, 3, true},
+		{"no average yet", Report{Budget: capped(100), Spend: Spend{FeeWei: new(big.Int)}}, 1, true},
+		{"already over", Report{Budget: capped(100), Spend: Spend{GasUsed: 100, Anchors: 1}, Over: true}, 0, true},
+	} {
+		if got := tc.rep.OverWith(tc.inflight); got != tc.want {
+			t.Errorf("%s: OverWith(%d) = %v, want %v", tc.name, tc.inflight, got, tc.want)
+		}
+	}
+}
+
+func TestSplit(t *testing.T) {
+	fee := big.NewInt(1001)
+	var gas uint64
+	total := new(big.Int)
+	for i := 0; i < 3; i++ {
+		g, f := Split(100, fee, 3, i)
+		gas += g
+		total.Add(total, f)
+	}
+	if gas != 100 || total.Cmp(fee) != 0 {
+		t.Fatalf("shares add up to %d gas, %s wei; want 100, 1001", gas, total)
+	}
+	if g, f := Split(100, nil, 2, 1); g != 50 || f != nil {
+		t.Fatalf("Split without fee = %d, %v", g, f)
+	}
+}
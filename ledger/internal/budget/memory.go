@@ -0,0 +1,83 @@
+package budget
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/gusplusbus/trustflow/ledger/internal/jobs"
+)
+
+// MemoryStore keeps budgets and fees in memory (dev, tests); they are lost
+// on restart.
+type MemoryStore struct {
+	mu      sync.Mutex
+	budgets map[string]Budget
+	fees    []Fee
+	charged map[feeKey]bool
+	now     func() time.Time
+}
+
+type feeKey struct {
+	tx string
+	jobs.Key
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{budgets: map[string]Budget{}, charged: map[feeKey]bool{}, now: time.Now}
+}
+
+func (s *MemoryStore) GetBudget(_ context.Context, projectID string) (Budget, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, ok := s.budgets[projectID]
+	if !ok {
+		return Budget{}, ErrNoBudget
+	}
+	return b, nil
+}
+
+func (s *MemoryStore) SetBudget(_ context.Context, b Budget) (Budget, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b.Cap, b.UpdatedAt = new(big.Int).Set(b.Cap), s.now()
+	s.budgets[b.ProjectID] = b
+	return b, nil
+}
+
+func (s *MemoryStore) DeleteBudget(_ context.Context, projectID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.budgets, projectID)
+	return nil
+}
+
+func (s *MemoryStore) Charge(_ context.Context, f Fee) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	k := feeKey{tx: f.TxID, Key: f.Key}
+	if s.charged[k] {
+		return nil
+	}
+	s.charged[k] = true
+	s.fees = append(s.fees, f)
+	return nil
+}
+
+func (s *MemoryStore) Spent(_ context.Context, projectID string, since time.Time) (Spend, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := Spend{FeeWei: new(big.Int)}
+	for _, f := range s.fees {
+		if f.ProjectID != projectID || f.ChargedAt.Before(since) {
+			continue
+		}
+		out.GasUsed += f.GasUsed
+		if f.FeeWei != nil {
+			out.FeeWei.Add(out.FeeWei, f.FeeWei)
+		}
+		out.Anchors++
+	}
+	return out, nil
+}
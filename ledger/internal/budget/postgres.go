@@ -0,0 +1,100 @@
+package budget
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PGStore keeps budgets in ledger_anchor_budgets and fees in
+// ledger_anchor_fees (data_server migration 032).
+type PGStore struct {
+	db *pgxpool.Pool
+}
+
+func NewPGStore(db *pgxpool.Pool) *PGStore { return &PGStore{db: db} }
+
+// Amounts travel as decimal text; NUMERIC(78,0) holds any uint256.
+const (
+	getBudget = `SELECT project_id, period, unit, cap::text, updated_at
+FROM ledger_anchor_budgets WHERE project_id = $1`
+
+	setBudget = `INSERT INTO ledger_anchor_budgets (project_id, period, unit, cap)
+VALUES ($1, $2, $3, $4::numeric)
+ON CONFLICT (project_id) DO UPDATE
+SET period = EXCLUDED.period, unit = EXCLUDED.unit, cap = EXCLUDED.cap, updated_at = now()
+RETURNING project_id, period, unit, cap::text, updated_at`
+
+	deleteBudget = `DELETE FROM ledger_anchor_budgets WHERE project_id = $1`
+
+	chargeFee = `INSERT INTO ledger_anchor_fees
+  (project_id, entity_kind, entity_key, bucket_key, tx_id, gas_used, fee_wei, charged_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7::numeric, $8)
+ON CONFLICT (tx_id, entity_kind, entity_key, bucket_key) DO NOTHING`
+
+	spentFees = `SELECT COALESCE(SUM(gas_used), 0)::bigint, COALESCE(SUM(fee_wei), 0)::text, count(*)
+FROM ledger_anchor_fees
+WHERE project_id = $1 AND charged_at >= $2`
+)
+
+func scanBudget(row pgx.Row) (Budget, error) {
+	var b Budget
+	var cap string
+	err := row.Scan(&b.ProjectID, &b.Period, &b.Unit, &cap, &b.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return b, ErrNoBudget
+	}
+	if err != nil {
+		return b, err
+	}
+	b.Cap, err = parseAmount(cap)
+	return b, err
+}
+
+func (s *PGStore) GetBudget(ctx context.Context, projectID string) (Budget, error) {
+	return scanBudget(s.db.QueryRow(ctx, getBudget, projectID))
+}
+
+func (s *PGStore) SetBudget(ctx context.Context, b Budget) (Budget, error) {
+	return scanBudget(s.db.QueryRow(ctx, setBudget, b.ProjectID, b.Period, b.Unit, b.Cap.String()))
+}
+
+func (s *PGStore) DeleteBudget(ctx context.Context, projectID string) error {
+	_, err := s.db.Exec(ctx, deleteBudget, projectID)
+	return err
+}
+
+func (s *PGStore) Charge(ctx context.Context, f Fee) error {
+	fee := "0"
+	if f.FeeWei != nil {
+		fee = f.FeeWei.String()
+	}
+	_, err := s.db.Exec(ctx, chargeFee, f.ProjectID, f.EntityKind, f.EntityKey, f.BucketKey, f.TxID, int64(f.GasUsed), fee, f.ChargedAt)
+	return err
+}
+
+func (s *PGStore) Spent(ctx context.Context, projectID string, since time.Time) (Spend, error) {
+	var gas int64
+	var fee string
+	var out Spend
+	if err := s.db.QueryRow(ctx, spentFees, projectID, since).Scan(&gas, &fee, &out.Anchors); err != nil {
+		return Spend{}, err
+	}
+	out.GasUsed = uint64(gas)
+	var err error
+	out.FeeWei, err = parseAmount(fee)
+	return out, err
+}
+
+func parseAmount(s string) (*big.Int, error) {
+	n, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return nil, fmt.Errorf("amount %q is not an integer", s)
+	}
+	return n, nil
+}
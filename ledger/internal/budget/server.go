@@ -0,0 +1,76 @@
+package budget
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	anchorv1 "github.com/gusplusbus/trustflow/data_server/gen/anchorv1"
+)
+
+// Server serves AnchorBudgetService.
+type Server struct {
+	anchorv1.UnimplementedAnchorBudgetServiceServer
+	store Store
+	now   func() time.Time
+}
+
+func NewServer(store Store) *Server { return &Server{store: store, now: time.Now} }
+
+func (s *Server) SetAnchorBudget(ctx context.Context, req *anchorv1.SetAnchorBudgetRequest) (*anchorv1.SetAnchorBudgetResponse, error) {
+	if req.GetProjectId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "project_id required")
+	}
+	if pb := req.GetBudget(); pb == nil {
+		if err := s.store.DeleteBudget(ctx, req.GetProjectId()); err != nil {
+			return nil, err
+		}
+	} else {
+		b := Budget{ProjectID: req.GetProjectId(), Period: pb.GetPeriod(), Unit: pb.GetUnit()}
+		b.Cap, _ = new(big.Int).SetString(pb.GetCap(), 10)
+		if err := b.Validate(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if _, err := s.store.SetBudget(ctx, b); err != nil {
+			return nil, err
+		}
+	}
+	spend, err := s.spend(ctx, req.GetProjectId())
+	if err != nil {
+		return nil, err
+	}
+	return &anchorv1.SetAnchorBudgetResponse{Spend: spend}, nil
+}
+
+func (s *Server) GetAnchorSpend(ctx context.Context, req *anchorv1.GetAnchorSpendRequest) (*anchorv1.GetAnchorSpendResponse, error) {
+	if req.GetProjectId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "project_id required")
+	}
+	spend, err := s.spend(ctx, req.GetProjectId())
+	if err != nil {
+		return nil, err
+	}
+	return &anchorv1.GetAnchorSpendResponse{Spend: spend}, nil
+}
+
+func (s *Server) spend(ctx context.Context, projectID string) (*anchorv1.AnchorSpend, error) {
+	rep, err := Check(ctx, s.store, projectID, s.now())
+	if err != nil {
+		return nil, err
+	}
+	out := &anchorv1.AnchorSpend{
+		ProjectId:  rep.ProjectID,
+		Since:      rep.Since.Format(time.RFC3339),
+		GasUsed:    rep.GasUsed,
+		FeeWei:     rep.FeeWei.String(),
+		Anchors:    uint32(rep.Anchors),
+		OverBudget: rep.Over,
+	}
+	if b := rep.Budget; b != nil {
+		out.Budget = &anchorv1.AnchorBudget{Period: b.Period, Unit: b.Unit, Cap: b.Cap.String()}
+	}
+	return out, nil
+}
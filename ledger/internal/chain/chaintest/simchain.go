@@ -0,0 +1,304 @@
+// Package chaintest runs an in-process JSON-RPC "chain" for tests: it accepts
+// signed legacy transactions, checks their signatures and mines each one into
+// its own block immediately. Tests add empty blocks with Mine and fake
+// reorgs and failures with Drop and Revert.
+package chaintest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+
+	"github.com/gusplusbus/trustflow/ledger/internal/chain"
+)
+
+// Tx is a transaction accepted by the simulated chain.
+type Tx struct {
+	Hash     string
+	From     chain.Address
+	To       chain.Address
+	Nonce    uint64
+	GasPrice *big.Int
+	Gas      uint64
+	Data     []byte
+	Block    uint64
+	Reverted bool
+}
+
+type SimChain struct {
+	ChainID  *big.Int
+	GasPrice *big.Int
+	GasUsed  uint64
+
+	srv *httptest.Server
+
+	mu     sync.Mutex
+	head   uint64
+	nonces map[chain.Address]uint64
+	txs    []*Tx
+	byHash map[string]*Tx
+}
+
+// New starts a simulated chain; call Close when done.
+func New(chainID int64) *SimChain {
+	s := &SimChain{
+		ChainID:  big.NewInt(chainID),
+		GasPrice: big.NewInt(1_000_000_000),
+		GasUsed:  45_000,
+		nonces:   map[chain.Address]uint64{},
+		byHash:   map[string]*Tx{},
+	}
+	s.srv = httptest.NewServer(http.HandlerFunc(s.serve))
+	return s
+}
+
+func (s *SimChain) URL() string { return s.srv.URL }
+func (s *SimChain) Close()      { s.srv.Close() }
+
+// Mine adds n empty blocks.
+func (s *SimChain) Mine(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.head += uint64(n)
+}
+
+// Drop forgets a tx's receipt, as when a reorg takes its block away and the
+// tx is not included again.
+func (s *SimChain) Drop(hash string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.byHash, hash)
+}
+
+// Revert makes a tx's receipt report failure (status 0x0).
+func (s *SimChain) Revert(hash string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if tx, ok := s.byHash[hash]; ok {
+		tx.Reverted = true
+	}
+}
+
+// BlockHash is the simulated hash of block n.
+func BlockHash(n uint64) string {
+	return chain.EncodeHex(chain.Keccak256(new(big.Int).SetUint64(n).Bytes()))
+}
+
+// Txs returns the mined transactions in order.
+func (s *SimChain) Txs() []*Tx {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*Tx(nil), s.txs...)
+}
+
+type request struct {
+	ID     json.RawMessage   `json:"id"`
+	Method string            `json:"method"`
+	Params []json.RawMessage `json:"params"`
+}
+
+func (s *SimChain) serve(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	var req request
+	if err := json.Unmarshal(body, &req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	result, err := s.handle(req)
+	resp := map[string]any{"jsonrpc": "2.0", "id": req.ID}
+	if err != nil {
+		resp["error"] = map[string]any{"code": -32000, "message": err.Error()}
+	} else {
+		resp["result"] = result
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func (s *SimChain) handle(req request) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	str := func(i int) string {
+		var v string
+		if i < len(req.Params) {
+			_ = json.Unmarshal(req.Params[i], &v)
+		}
+		return v
+	}
+
+	switch req.Method {
+	case "eth_chainId":
+		return chain.EncodeQuantity(s.ChainID), nil
+	case "eth_gasPrice":
+		return chain.EncodeQuantity(s.GasPrice), nil
+	case "eth_estimateGas":
+		return chain.EncodeQuantity(new(big.Int).SetUint64(s.GasUsed)), nil
+	case "eth_blockNumber":
+		return chain.EncodeQuantity(new(big.Int).SetUint64(s.head)), nil
+	case "eth_getTransactionByHash":
+		tx, ok := s.byHash[str(0)]
+		if !ok {
+			return nil, nil
+		}
+		return map[string]any{
+			"hash":        tx.Hash,
+			"to":          tx.To.Hex(),
+			"input":       chain.EncodeHex(tx.Data),
+			"blockNumber": chain.EncodeQuantity(new(big.Int).SetUint64(tx.Block)),
+		}, nil
+	case "eth_getTransactionReceipt":
+		tx, ok := s.byHash[str(0)]
+		if !ok {
+			return nil, nil
+		}
+		status := "0x1"
+		if tx.Reverted {
+			status = "0x0"
+		}
+		return map[string]any{
+			"transactionHash":   tx.Hash,
+			"blockNumber":       chain.EncodeQuantity(new(big.Int).SetUint64(tx.Block)),
+			"blockHash":         BlockHash(tx.Block),
+			"status":            status,
+			"gasUsed":           chain.EncodeQuantity(new(big.Int).SetUint64(s.GasUsed)),
+			"effectiveGasPrice": chain.EncodeQuantity(tx.GasPrice),
+		}, nil
+	case "eth_getTransactionCount":
+		addr, err := chain.ParseAddress(str(0))
+		if err != nil {
+			return nil, err
+		}
+		return chain.EncodeQuantity(new(big.Int).SetUint64(s.nonces[addr])), nil
+	case "eth_sendRawTransaction":
+		raw, err := chain.DecodeHex(str(0))
+		if err != nil {
+			return nil, err
+		}
+		tx, err := s.accept(raw)
+		if err != nil {
+			return nil, err
+		}
+		return tx.Hash, nil
+	default:
+		return nil, fmt.Errorf("method %s not supported", req.Method)
+	}
+}
+
+func (s *SimChain) accept(raw []byte) (*Tx, error) {
+	items, err := decodeList(raw)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) != 9 {
+		return nil, errors.New("expected legacy tx with 9 fields")
+	}
+	num := func(b []byte) *big.Int { return new(big.Int).SetBytes(b) }
+
+	tx := chain.LegacyTx{
+		Nonce:    num(items[0]).Uint64(),
+		GasPrice: num(items[1]),
+		Gas:      num(items[2]).Uint64(),
+		Value:    num(items[4]),
+		Data:     items[5],
+	}
+	copy(tx.To[:], items[3])
+
+	// v = chainId*2 + 35 + recid
+	v := num(items[6])
+	recid := new(big.Int).Sub(v, new(big.Int).Add(new(big.Int).Mul(s.ChainID, big.NewInt(2)), big.NewInt(35)))
+	if recid.Sign() < 0 || recid.Cmp(big.NewInt(1)) > 0 {
+		return nil, errors.New("invalid v / chain id")
+	}
+	sig := make([]byte, 65)
+	sig[0] = 27 + byte(recid.Int64())
+	copy(sig[33-len(items[7]):33], items[7])
+	copy(sig[65-len(items[8]):65], items[8])
+	pub, _, err := ecdsa.RecoverCompact(sig, tx.SigningHash(s.ChainID))
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature: %w", err)
+	}
+	from := chain.PubkeyToAddress(pub)
+	if tx.Nonce != s.nonces[from] {
+		return nil, fmt.Errorf("nonce too low/high: got %d want %d", tx.Nonce, s.nonces[from])
+	}
+	s.nonces[from]++
+	s.head++
+
+	mined := &Tx{
+		Hash:     chain.EncodeHex(chain.Keccak256(raw)),
+		From:     from,
+		To:       tx.To,
+		Nonce:    tx.Nonce,
+		GasPrice: tx.GasPrice,
+		Gas:      tx.Gas,
+		Data:     tx.Data,
+		Block:    s.head,
+	}
+	s.txs = append(s.txs, mined)
+	s.byHash[mined.Hash] = mined
+	return mined, nil
+}
+
+// decodeList decodes a flat RLP list of byte strings.
+func decodeList(b []byte) ([][]byte, error) {
+	payload, rest, err := split(b, true)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) != 0 {
+		return nil, errors.New("rlp: trailing bytes")
+	}
+	var out [][]byte
+	for len(payload) > 0 {
+		var item []byte
+		item, payload, err = split(payload, false)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, item)
+	}
+	return out, nil
+}
+
+func split(b []byte, wantList bool) (payload, rest []byte, err error) {
+	if len(b) == 0 {
+		return nil, nil, errors.New("rlp: empty input")
+	}
+	p := b[0]
+	isList := p >= 0xc0
+	if isList != wantList {
+		return nil, nil, errors.New("rlp: unexpected kind")
+	}
+	base := byte(0x80)
+	if isList {
+		base = 0xc0
+	}
+	switch {
+	case !isList && p < 0x80:
+		return b[:1], b[1:], nil
+	case p < base+56:
+		n := int(p - base)
+		if len(b) < 1+n {
+			return nil, nil, errors.New("rlp: short input")
+		}
+		return b[1 : 1+n], b[1+n:], nil
+	default:
+		ll := int(p - base - 55)
+		if len(b) < 1+ll {
+			return nil, nil, errors.New("rlp: short input")
+		}
+		n := int(new(big.Int).SetBytes(b[1 : 1+ll]).Int64())
+		if len(b) < 1+ll+n {
+			return nil, nil, errors.New("rlp: short input")
+		}
+		return b[1+ll : 1+ll+n], b[1+ll+n:], nil
+	}
+}
@@ -0,0 +1,296 @@
+package chain
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// Client is a minimal Ethereum JSON-RPC client (just what anchoring needs).
+type Client struct {
+	URL        string
+	HTTPClient *http.Client
+	id         atomic.Int64
+}
+
+func NewClient(url string, timeout time.Duration) *Client {
+	return &Client{URL: url, HTTPClient: &http.Client{Timeout: timeout}}
+}
+
+type rpcRequest struct {
+	JSONRPC string `json:"jsonrpc"`
+	ID      int64  `json:"id"`
+	Method  string `json:"method"`
+	Params  []any  `json:"params"`
+}
+
+type rpcResponse struct {
+	ID     int64           `json:"id"`
+	Result json.RawMessage `json:"result"`
+	Error  *RPCError       `json:"error"`
+}
+
+// RPCError is an error object returned by the node.
+type RPCError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *RPCError) Error() string { return fmt.Sprintf("rpc error %d: %s", e.Code, e.Message) }
+
+// Call invokes method with params and decodes the result into out (may be nil).
+func (c *Client) Call(ctx context.Context, out any, method string, params ...any) error {
+	if params == nil {
+		params = []any{}
+	}
+	body, err := json.Marshal(rpcRequest{JSONRPC: "2.0", ID: c.id.Add(1), Method: method, Params: params})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	hc := c.HTTPClient
+	if hc == nil {
+		hc = http.DefaultClient
+	}
+	res, err := hc.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode/100 != 2 {
+		return fmt.Errorf("%s: http %d", method, res.StatusCode)
+	}
+
+	var rr rpcResponse
+	if err := json.NewDecoder(res.Body).Decode(&rr); err != nil {
+		return fmt.Errorf("%s: decode: %w", method, err)
+	}
+	if rr.Error != nil {
+		return rr.Error
+	}
+	if out == nil {
+		return nil
+	}
+	return json.Unmarshal(rr.Result, out)
+}
+
+func (c *Client) ChainID(ctx context.Context) (*big.Int, error) {
+	var s string
+	if err := c.Call(ctx, &s, "eth_chainId"); err != nil {
+		return nil, err
+	}
+	return ParseQuantity(s)
+}
+
+func (c *Client) PendingNonce(ctx context.Context, addr Address) (uint64, error) {
+	var s string
+	if err := c.Call(ctx, &s, "eth_getTransactionCount", addr.Hex(), "pending"); err != nil {
+		return 0, err
+	}
+	n, err := ParseQuantity(s)
+	if err != nil {
+		return 0, err
+	}
+	return n.Uint64(), nil
+}
+
+func (c *Client) GasPrice(ctx context.Context) (*big.Int, error) {
+	var s string
+	if err := c.Call(ctx, &s, "eth_gasPrice"); err != nil {
+		return nil, err
+	}
+	return ParseQuantity(s)
+}
+
+// EstimateGas estimates the gas of a call from `from` to `to` with data.
+func (c *Client) EstimateGas(ctx context.Context, from, to Address, data []byte) (uint64, error) {
+	msg := map[string]string{
+		"from": from.Hex(),
+		"to":   to.Hex(),
+		"data": EncodeHex(data),
+	}
+	var s string
+	if err := c.Call(ctx, &s, "eth_estimateGas", msg); err != nil {
+		return 0, err
+	}
+	n, err := ParseQuantity(s)
+	if err != nil {
+		return 0, err
+	}
+	return n.Uint64(), nil
+}
+
+// SendRawTransaction broadcasts a signed tx and returns its hash (0x-hex).
+func (c *Client) SendRawTransaction(ctx context.Context, raw []byte) (string, error) {
+	var h string
+	if err := c.Call(ctx, &h, "eth_sendRawTransaction", EncodeHex(raw)); err != nil {
+		return "", err
+	}
+	return h, nil
+}
+
+func (c *Client) BlockNumber(ctx context.Context) (uint64, error) {
+	var s string
+	if err := c.Call(ctx, &s, "eth_blockNumber"); err != nil {
+		return 0, err
+	}
+	n, err := ParseQuantity(s)
+	if err != nil {
+		return 0, err
+	}
+	return n.Uint64(), nil
+}
+
+// TxReceipt is the part of a transaction receipt anchoring checks.
+type TxReceipt struct {
+	BlockNumber uint64
+	BlockHash   string
+	Success     bool // status 0x1; false means the tx reverted
+	GasUsed     uint64
+	Fee         *big.Int // wei paid: gasUsed * effectiveGasPrice; nil if the node omits the price
+}
+
+// TransactionReceipt returns the receipt of a mined tx, or nil while the
+// node does not know it as mined (pending, dropped or reorged out).
+func (c *Client) TransactionReceipt(ctx context.Context, txHash string) (*TxReceipt, error) {
+	var raw *struct {
+		BlockNumber string `json:"blockNumber"`
+		BlockHash   string `json:"blockHash"`
+		Status      string `json:"status"`
+		GasUsed     string `json:"gasUsed"`
+		GasPrice    string `json:"effectiveGasPrice"`
+	}
+	if err := c.Call(ctx, &raw, "eth_getTransactionReceipt", txHash); err != nil {
+		return nil, err
+	}
+	if raw == nil || raw.BlockNumber == "" {
+		return nil, nil
+	}
+	n, err := ParseQuantity(raw.BlockNumber)
+	if err != nil {
+		return nil, err
+	}
+	status, err := ParseQuantity(raw.Status)
+	if err != nil {
+		return nil, err
+	}
+	rec := &TxReceipt{BlockNumber: n.Uint64(), BlockHash: raw.BlockHash, Success: status.Sign() != 0}
+	if raw.GasUsed != "" {
+		gas, err := ParseQuantity(raw.GasUsed)
+		if err != nil {
+			return nil, err
+		}
+		rec.GasUsed = gas.Uint64()
+		if raw.GasPrice != "" {
+			price, err := ParseQuantity(raw.GasPrice)
+			if err != nil {
+				return nil, err
+			}
+			rec.Fee = price.Mul(price, gas)
+		}
+	}
+	return rec, nil
+}
+
+// TxInfo is the part of a transaction anchoring reads back.
+type TxInfo struct {
+	To    Address
+	Input []byte
+}
+
+// TransactionByHash returns a tx the node knows (mined or pending), or nil.
+func (c *Client) TransactionByHash(ctx context.Context, txHash string) (*TxInfo, error) {
+	var raw *struct {
+		To    string `json:"to"`
+		Input string `json:"input"`
+	}
+	if err := c.Call(ctx, &raw, "eth_getTransactionByHash", txHash); err != nil {
+		return nil, err
+	}
+	if raw == nil {
+		return nil, nil
+	}
+	out := &TxInfo{}
+	if raw.To != "" { // empty for contract creation
+		to, err := ParseAddress(raw.To)
+		if err != nil {
+			return nil, err
+		}
+		out.To = to
+	}
+	input, err := DecodeHex(raw.Input)
+	if err != nil {
+		return nil, err
+	}
+	out.Input = input
+	return out, nil
+}
+
+// ---- hex helpers ----
+
+// EncodeHex returns 0x-prefixed lowercase hex.
+func EncodeHex(b []byte) string {
+	const digits = "0123456789abcdef"
+	out := make([]byte, 2+2*len(b))
+	out[0], out[1] = '0', 'x'
+	for i, v := range b {
+		out[2+2*i] = digits[v>>4]
+		out[3+2*i] = digits[v&0x0f]
+	}
+	return string(out)
+}
+
+// DecodeHex accepts an optional 0x prefix.
+func DecodeHex(s string) ([]byte, error) {
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X")
+	if len(s)%2 == 1 {
+		s = "0" + s
+	}
+	out := make([]byte, len(s)/2)
+	for i := range out {
+		hi, ok1 := fromHexChar(s[2*i])
+		lo, ok2 := fromHexChar(s[2*i+1])
+		if !ok1 || !ok2 {
+			return nil, fmt.Errorf("invalid hex %q", s)
+		}
+		out[i] = hi<<4 | lo
+	}
+	return out, nil
+}
+
+func fromHexChar(c byte) (byte, bool) {
+	switch {
+	case c >= '0' && c <= '9':
+		return c - '0', true
+	case c >= 'a' && c <= 'f':
+		return c - 'a' + 10, true
+	case c >= 'A' && c <= 'F':
+		return c - 'A' + 10, true
+	}
+	return 0, false
+}
+
+// ParseQuantity parses a JSON-RPC hex quantity ("0x1a").
+func ParseQuantity(s string) (*big.Int, error) {
+	v, ok := new(big.Int).SetString(strings.TrimPrefix(s, "0x"), 16)
+	if !ok {
+		return nil, fmt.Errorf("invalid quantity %q", s)
+	}
+	return v, nil
+}
+
+// EncodeQuantity formats v as a JSON-RPC hex quantity.
+func EncodeQuantity(v *big.Int) string {
+	return "0x" + v.Text(16)
+}
@@ -0,0 +1,154 @@
+package chain
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"golang.org/x/crypto/sha3"
+)
+
+// Address is a 20-byte EVM account address.
+type Address [20]byte
+
+func (a Address) Hex() string { return EncodeHex(a[:]) }
+
+func ParseAddress(s string) (Address, error) {
+	var a Address
+	b, err := DecodeHex(strings.TrimSpace(s))
+	if err != nil {
+		return a, err
+	}
+	if len(b) != len(a) {
+		return a, errors.New("address must be 20 bytes")
+	}
+	copy(a[:], b)
+	return a, nil
+}
+
+// Keccak256 is the legacy Keccak used by the EVM (not NIST SHA3).
+func Keccak256(parts ...[]byte) []byte {
+	h := sha3.NewLegacyKeccak256()
+	for _, p := range parts {
+		h.Write(p)
+	}
+	return h.Sum(nil)
+}
+
+// Signer holds the secp256k1 key that pays for and signs anchor txs.
+type Signer struct {
+	key  *secp256k1.PrivateKey
+	addr Address
+}
+
+// ParsePrivateKey accepts a 32-byte hex key (with or without 0x).
+func ParsePrivateKey(s string) (*Signer, error) {
+	b, err := DecodeHex(strings.TrimSpace(s))
+	if err != nil {
+		return nil, err
+	}
+	if len(b) != 32 {
+		return nil, errors.New("private key must be 32 bytes")
+	}
+	key := secp256k1.PrivKeyFromBytes(b)
+	return &Signer{key: key, addr: PubkeyToAddress(key.PubKey())}, nil
+}
+
+func (s *Signer) Address() Address { return s.addr }
+
+// PubkeyToAddress derives the account address (last 20 bytes of keccak(X||Y)).
+func PubkeyToAddress(pub *secp256k1.PublicKey) Address {
+	var a Address
+	raw := pub.SerializeUncompressed() // 0x04 || X || Y
+	copy(a[:], Keccak256(raw[1:])[12:])
+	return a
+}
+
+// LegacyTx is a pre-EIP-1559 transaction, signed with EIP-155 replay protection.
+type LegacyTx struct {
+	Nonce    uint64
+	GasPrice *big.Int
+	Gas      uint64
+	To       Address
+	Value    *big.Int
+	Data     []byte
+}
+
+func (tx LegacyTx) fields() []any {
+	value := tx.Value
+	if value == nil {
+		value = new(big.Int)
+	}
+	gasPrice := tx.GasPrice
+	if gasPrice == nil {
+		gasPrice = new(big.Int)
+	}
+	return []any{
+		new(big.Int).SetUint64(tx.Nonce),
+		gasPrice,
+		new(big.Int).SetUint64(tx.Gas),
+		tx.To[:],
+		value,
+		tx.Data,
+	}
+}
+
+// SigningHash is keccak(rlp(nonce, gasPrice, gas, to, value, data, chainId, 0, 0)).
+func (tx LegacyTx) SigningHash(chainID *big.Int) []byte {
+	f := append(tx.fields(), chainID, new(big.Int), new(big.Int))
+	return Keccak256(rlpList(f...))
+}
+
+// Sign returns the RLP-encoded signed transaction and its hash.
+func (s *Signer) Sign(tx LegacyTx, chainID *big.Int) (raw []byte, hash []byte) {
+	sig := ecdsa.SignCompact(s.key, tx.SigningHash(chainID), false)
+	// sig = [27 + recid] || R || S
+	recid := int64(sig[0] - 27)
+	v := new(big.Int).Mul(chainID, big.NewInt(2))
+	v.Add(v, big.NewInt(35+recid))
+
+	r := new(big.Int).SetBytes(sig[1:33])
+	ss := new(big.Int).SetBytes(sig[33:65])
+	raw = rlpList(append(tx.fields(), v, r, ss)...)
+	return raw, Keccak256(raw)
+}
+
+// ---- RLP (encode only; items are []byte, *big.Int or nested []any) ----
+
+func rlpList(items ...any) []byte {
+	var payload []byte
+	for _, it := range items {
+		payload = append(payload, rlpItem(it)...)
+	}
+	return append(rlpHeader(0xc0, len(payload)), payload...)
+}
+
+func rlpItem(v any) []byte {
+	switch x := v.(type) {
+	case []byte:
+		return rlpBytes(x)
+	case *big.Int:
+		return rlpBytes(x.Bytes()) // minimal big-endian, zero => empty
+	case []any:
+		return rlpList(x...)
+	default:
+		panic("rlp: unsupported type")
+	}
+}
+
+func rlpBytes(b []byte) []byte {
+	if len(b) == 1 && b[0] < 0x80 {
+		return []byte{b[0]}
+	}
+	return append(rlpHeader(0x80, len(b)), b...)
+}
+
+func rlpHeader(base byte, n int) []byte {
+	if n < 56 {
+		return []byte{base + byte(n)}
+	}
+	lenBytes := new(big.Int).SetInt64(int64(n)).Bytes()
+	return append([]byte{base + 55 + byte(len(lenBytes))}, lenBytes...)
+}
@@ -0,0 +1,47 @@
+package chain
+
+import (
+	"math/big"
+	"testing"
+)
+
+// Example transaction from the EIP-155 specification.
+func TestLegacyTxEIP155Vector(t *testing.T) {
+	signer, err := ParsePrivateKey("0x4646464646464646464646464646464646464646464646464646464646464646")
+	if err != nil {
+		t.Fatalf("ParsePrivateKey() error = %v", err)
+	}
+	to, _ := ParseAddress("0x3535353535353535353535353535353535353535")
+	value, _ := new(big.Int).SetString("1000000000000000000", 10)
+	tx := LegacyTx{
+		Nonce:    9,
+		GasPrice: big.NewInt(20_000_000_000),
+		Gas:      21000,
+		To:       to,
+		Value:    value,
+	}
+	chainID := big.NewInt(1)
+
+	wantHash := "0xdaf5a779ae972f972197303d7b574746c7ef83eadac0f2791ad23db92e4c8e53"
+	if got := EncodeHex(tx.SigningHash(chainID)); got != wantHash {
+		t.Errorf("SigningHash() = %s, want %s", got, wantHash)
+	}
+
+	raw, _ := signer.Sign(tx, chainID)
+	wantRaw := "0xf86c098504a817c800825208943535353535353535353535353535353535353535880de0b6b3a76400008025a028ef61340bd939bc2195fe537567866003e1a15d3c71ff63e1590620aa636276a067cbe9d8997f761aecb703304b3800ccf555c9f3dc64214b297fb1966a3b6d83"
+	if got := EncodeHex(raw); got != wantRaw {
+		t.Errorf("Sign() = %s, want %s", got, wantRaw)
+	}
+}
+
+func TestPubkeyToAddress(t *testing.T) {
+	// First anvil/hardhat dev account.
+	signer, err := ParsePrivateKey("ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80")
+	if err != nil {
+		t.Fatalf("ParsePrivateKey() error = %v", err)
+	}
+	want := "0xf39fd6e51aad88f6f4ce6ab8827279cfffb92266"
+	if got := signer.Address().Hex(); got != want {
+		t.Errorf("Address() = %s, want %s", got, want)
+	}
+}
@@ -3,16 +3,41 @@ package config
 import (
 	"log"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 )
 
 type Config struct {
 	HTTPAddr             string // e.g. :9091
+	GRPCAddr             string // AnchorService, e.g. :9092
 	GitHubWebhookSecret  []byte // used to verify X-Hub-Signature-256
 	APIURL               string // e.g. http://api:8080/internal/ledger/notify
 	DataServerGRPCAddr   string // optional: e.g. data_server:9090 (stubbed)
 	APITimeout           time.Duration
+
+	// Anchoring backend (see internal/anchor)
+	AnchorMode     string // dev | evm | tsa (default dev)
+	RPCURL         string // EVM JSON-RPC endpoint, e.g. http://127.0.0.1:8545
+	PrivateKey     string // hex secp256k1 key paying for anchor txs
+	AnchorContract string // BucketAnchor contract address
+	AnchorEpochs   bool   // anchor one root per epoch (default) instead of per bucket
+	AnchorBatch    bool   // per bucket, but one epoch per runner tick
+	TSAURL         string // RFC 3161 endpoint (tsa mode)
+	TSACACert      string // PEM file the TSA chains to; empty => system roots
+
+	// Anchoring job store (see internal/jobs); empty DatabaseURL keeps jobs in memory
+	DatabaseURL       string
+	AnchorMaxAttempts int           // failed anchors before a bucket is anchor_failed
+	AnchorRetryBase   time.Duration // backoff after the first failure; doubles up to an hour
+
+	// Confirmation tracking (bucket anchors on evm)
+	AnchorConfirmations uint64        // blocks, counting the including one, before a bucket is anchored
+	AnchorTxTimeout     time.Duration // a sent tx still not mined after this is sent again
+
+	// Checkpoint signing (see internal/signing)
+	SigningKey  string // base64 Ed25519 seed; empty => ephemeral dev key
+	RetiredKeys string // comma-separated base64 public keys still published
 }
 
 func mustEnv(key string) string {
@@ -28,17 +53,72 @@ func Load() Config {
 	if strings.TrimSpace(httpAddr) == "" {
 		httpAddr = ":9091"
 	}
+	grpcAddr := os.Getenv("LEDGER_GRPC_ADDR")
+	if strings.TrimSpace(grpcAddr) == "" {
+		grpcAddr = ":9092"
+	}
 	apiURL := os.Getenv("API_SYNC_URL")
 	if strings.TrimSpace(apiURL) == "" {
 		apiURL = "http://api:8080/internal/ledger/notify"
 	}
 	gh := []byte(mustEnv("GITHUB_WEBHOOK_SECRET"))
 
+	maxAttempts := 8
+	if v := strings.TrimSpace(os.Getenv("LEDGER_ANCHOR_MAX_ATTEMPTS")); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 {
+			log.Fatalf("LEDGER_ANCHOR_MAX_ATTEMPTS: want a positive integer, got %q", v)
+		}
+		maxAttempts = n
+	}
+	retryBase := 30 * time.Second
+	if v := strings.TrimSpace(os.Getenv("LEDGER_ANCHOR_RETRY_BASE")); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			log.Fatalf("LEDGER_ANCHOR_RETRY_BASE: want a positive duration, got %q", v)
+		}
+		retryBase = d
+	}
+	confirmations := uint64(12)
+	if v := strings.TrimSpace(os.Getenv("LEDGER_ANCHOR_CONFIRMATIONS")); v != "" {
+		n, err := strconv.ParseUint(v, 10, 64)
+		if err != nil || n == 0 {
+			log.Fatalf("LEDGER_ANCHOR_CONFIRMATIONS: want a positive integer, got %q", v)
+		}
+		confirmations = n
+	}
+	pendingTimeout := 10 * time.Minute
+	if v := strings.TrimSpace(os.Getenv("LEDGER_ANCHOR_PENDING_TIMEOUT")); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			log.Fatalf("LEDGER_ANCHOR_PENDING_TIMEOUT: want a positive duration, got %q", v)
+		}
+		pendingTimeout = d
+	}
+
+	unit := strings.ToLower(strings.TrimSpace(os.Getenv("LEDGER_ANCHOR_UNIT")))
+
 	return Config{
 		HTTPAddr:            httpAddr,
+		GRPCAddr:            grpcAddr,
 		GitHubWebhookSecret: gh,
 		APIURL:              apiURL,
 		DataServerGRPCAddr:  os.Getenv("DATASERVER_GRPC_ADDR"),
 		APITimeout:          6 * time.Second,
+		AnchorMode:          os.Getenv("LEDGER_ANCHOR_MODE"),
+		RPCURL:              os.Getenv("RPC_URL"),
+		PrivateKey:          os.Getenv("PRIVATE_KEY"),
+		AnchorContract:      os.Getenv("BUCKET_ANCHOR_CONTRACT"),
+		TSAURL:              os.Getenv("TSA_URL"),
+		TSACACert:           os.Getenv("TSA_CA_CERT"),
+		AnchorEpochs:        unit != "bucket" && unit != "batch",
+		AnchorBatch:         unit == "batch",
+		DatabaseURL:         os.Getenv("DATABASE_URL"),
+		AnchorMaxAttempts:   maxAttempts,
+		AnchorRetryBase:     retryBase,
+		AnchorConfirmations: confirmations,
+		AnchorTxTimeout:     pendingTimeout,
+		SigningKey:          os.Getenv("LEDGER_SIGNING_KEY"),
+		RetiredKeys:         os.Getenv("LEDGER_RETIRED_KEYS"),
 	}
 }
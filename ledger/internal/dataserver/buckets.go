@@ -2,8 +2,6 @@ package dataserver
 
 import (
 	"context"
-	"crypto/sha256"
-	"encoding/hex"
 	"fmt"
 	"time"
 
@@ -13,9 +11,34 @@ import (
 	bucketv1 "github.com/gusplusbus/trustflow/data_server/gen/bucketv1"
 )
 
+// actor is how the ledger appears in bucket status history.
+const actor = "ledger"
+
 type Buckets interface {
+	Get(ctx context.Context, ref *bucketv1.BucketRef) (*bucketv1.BucketInfo, error)
+	// GetWithEpoch also returns the proof of the bucket's root in its epoch
+	// (nil when it is in none).
+	GetWithEpoch(ctx context.Context, ref *bucketv1.BucketRef) (*bucketv1.BucketInfo, *bucketv1.EpochProof, error)
 	ListByStatus(ctx context.Context, status string, limit int32, pageToken string) (*bucketv1.ListBucketsByStatusResponse, error)
-	SetAnchored(ctx context.Context, ref *bucketv1.BucketRef, cid, anchoredTx string) (*bucketv1.SetBucketAnchoredResponse, error)
+	// SetStatus claims (anchoring), fails or retries (needs_anchoring) a bucket.
+	SetStatus(ctx context.Context, ref *bucketv1.BucketRef, status, reason string) (*bucketv1.BucketInfo, error)
+	// SetAnchorPending records the tx (and proof, for token anchors) sent for
+	// a claimed bucket; SetAnchored marks it anchored once that tx is
+	// confirmed in the given block.
+	SetAnchorPending(ctx context.Context, ref *bucketv1.BucketRef, cid, anchoredTx string, proof []byte) (*bucketv1.BucketInfo, error)
+	SetAnchored(ctx context.Context, ref *bucketv1.BucketRef, anchoredTx string, block uint64, blockHash string) (*bucketv1.SetBucketAnchoredResponse, error)
+	// Project returns the project a scope belongs to ("" when none).
+	Project(ctx context.Context, scope *bucketv1.Scope) (string, error)
+	Pack(ctx context.Context, ref *bucketv1.BucketRef) (*bucketv1.PackBucketResponse, error)
+	AddCheckpoint(ctx context.Context, ref *bucketv1.BucketRef, cp *bucketv1.Checkpoint) error
+
+	// Epoch roll-ups
+	// SealEpoch rolls claimed buckets into one epoch over period (a UTC day;
+	// "" => today) whose root is anchored for all of them.
+	SealEpoch(ctx context.Context, period string, refs []*bucketv1.BucketRef) (*bucketv1.EpochInfo, error)
+	// SetEpochAnchorPending records the tx sent for an epoch; its members then
+	// wait in anchoring_pending like any other sent bucket.
+	SetEpochAnchorPending(ctx context.Context, id uint64, anchoredTx string, proof []byte) (*bucketv1.EpochInfo, error)
 }
 
 type bucketClient struct {
@@ -37,21 +60,61 @@ func NewBucketClient(addr string, dialTimeout time.Duration) (Buckets, func() er
 	return &bucketClient{cc: cc, api: bucketv1.NewBucketServiceClient(cc)}, cc.Close, nil
 }
 
+func (c *bucketClient) Get(ctx context.Context, ref *bucketv1.BucketRef) (*bucketv1.BucketInfo, error) {
+	resp, err := c.api.GetBucket(ctx, &bucketv1.GetBucketRequest{Ref: ref})
+	return resp.GetBucket(), err
+}
+
+func (c *bucketClient) GetWithEpoch(ctx context.Context, ref *bucketv1.BucketRef) (*bucketv1.BucketInfo, *bucketv1.EpochProof, error) {
+	resp, err := c.api.GetBucket(ctx, &bucketv1.GetBucketRequest{Ref: ref})
+	return resp.GetBucket(), resp.GetEpoch(), err
+}
+
 func (c *bucketClient) ListByStatus(ctx context.Context, status string, limit int32, pageToken string) (*bucketv1.ListBucketsByStatusResponse, error) {
 	req := &bucketv1.ListBucketsByStatusRequest{Status: status, Limit: limit, PageToken: pageToken}
 	return c.api.ListBucketsByStatus(ctx, req)
 }
 
-func (c *bucketClient) SetAnchored(ctx context.Context, ref *bucketv1.BucketRef, cid, anchoredTx string) (*bucketv1.SetBucketAnchoredResponse, error) {
-	req := &bucketv1.SetBucketAnchoredRequest{Ref: ref, Cid: cid, AnchoredTx: anchoredTx}
+func (c *bucketClient) SetStatus(ctx context.Context, ref *bucketv1.BucketRef, status, reason string) (*bucketv1.BucketInfo, error) {
+	req := &bucketv1.SetBucketStatusRequest{Ref: ref, Status: status, Actor: actor, Reason: reason}
+	resp, err := c.api.SetBucketStatus(ctx, req)
+	return resp.GetBucket(), err
+}
+
+func (c *bucketClient) SetAnchorPending(ctx context.Context, ref *bucketv1.BucketRef, cid, anchoredTx string, proof []byte) (*bucketv1.BucketInfo, error) {
+	req := &bucketv1.SetBucketAnchorPendingRequest{Ref: ref, Cid: cid, AnchoredTx: anchoredTx, AnchorProof: proof, Actor: actor}
+	resp, err := c.api.SetBucketAnchorPending(ctx, req)
+	return resp.GetBucket(), err
+}
+
+func (c *bucketClient) SetAnchored(ctx context.Context, ref *bucketv1.BucketRef, anchoredTx string, block uint64, blockHash string) (*bucketv1.SetBucketAnchoredResponse, error) {
+	req := &bucketv1.SetBucketAnchoredRequest{Ref: ref, AnchoredTx: anchoredTx, BlockNumber: block, BlockHash: blockHash, Actor: actor}
 	return c.api.SetBucketAnchored(ctx, req)
 }
 
-// --- Helpers you can reuse if you want a quick "CID-ish" dev stub ---
-// DevCID returns a deterministic string for bytes (NOT a real IPFS CID).
-func DevCID(b []byte) string {
-	sum := sha256.Sum256(b)
-	return "devcid-" + hex.EncodeToString(sum[:8])
+func (c *bucketClient) Project(ctx context.Context, scope *bucketv1.Scope) (string, error) {
+	resp, err := c.api.GetScopeProject(ctx, &bucketv1.GetScopeProjectRequest{Scope: scope})
+	return resp.GetProjectId(), err
+}
+
+func (c *bucketClient) Pack(ctx context.Context, ref *bucketv1.BucketRef) (*bucketv1.PackBucketResponse, error) {
+	return c.api.PackBucket(ctx, &bucketv1.PackBucketRequest{Ref: ref})
+}
+
+func (c *bucketClient) AddCheckpoint(ctx context.Context, ref *bucketv1.BucketRef, cp *bucketv1.Checkpoint) error {
+	_, err := c.api.AddBucketCheckpoint(ctx, &bucketv1.AddBucketCheckpointRequest{Ref: ref, Checkpoint: cp})
+	return err
+}
+
+func (c *bucketClient) SealEpoch(ctx context.Context, period string, refs []*bucketv1.BucketRef) (*bucketv1.EpochInfo, error) {
+	resp, err := c.api.SealEpoch(ctx, &bucketv1.SealEpochRequest{Refs: refs, Period: period})
+	return resp.GetEpoch(), err
+}
+
+func (c *bucketClient) SetEpochAnchorPending(ctx context.Context, id uint64, anchoredTx string, proof []byte) (*bucketv1.EpochInfo, error) {
+	req := &bucketv1.SetEpochAnchorPendingRequest{Id: id, AnchoredTx: anchoredTx, AnchorProof: proof, Actor: actor}
+	resp, err := c.api.SetEpochAnchorPending(ctx, req)
+	return resp.GetEpoch(), err
 }
 
 // DevTX returns a fake tx id for dev.
@@ -0,0 +1,93 @@
+// Package jobs records the ledger's anchoring attempts per bucket, so failed
+// anchors are retried with backoff and, after a limit, given up until an
+// operator re-drives them.
+package jobs
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Job states.
+const (
+	Running = "running" // claimed and being anchored
+	Pending = "pending" // tx sent; waiting for confirmations since UpdatedAt
+	Retry   = "retry"   // failed; may be tried again from NextAttemptAt
+	Dead    = "dead"    // given up; the bucket is anchor_failed
+	Done    = "done"    // anchored
+)
+
+// ErrNotFound means the bucket has no job yet (it was never attempted).
+var ErrNotFound = errors.New("no anchoring job")
+
+// Key names a bucket.
+type Key struct {
+	EntityKind string
+	EntityKey  string
+	BucketKey  string
+}
+
+func (k Key) String() string { return k.EntityKind + "/" + k.EntityKey + "/" + k.BucketKey }
+
+type Job struct {
+	Key
+	State         string
+	Attempts      int
+	LastError     string
+	NextAttemptAt *time.Time
+	UpdatedAt     time.Time
+}
+
+// Due reports whether a Retry job may be attempted at now.
+func (j Job) Due(now time.Time) bool {
+	return j.State == Retry && (j.NextAttemptAt == nil || !now.Before(*j.NextAttemptAt))
+}
+
+// Store persists jobs.
+type Store interface {
+	Get(ctx context.Context, k Key) (Job, error)
+
+	// Start counts an attempt and marks the job Running, creating it if needed.
+	Start(ctx context.Context, k Key) (Job, error)
+
+	// Fail records errMsg; the job is Retry from next, or Dead if next is nil.
+	Fail(ctx context.Context, k Key, errMsg string, next *time.Time) (Job, error)
+
+	// Sent marks the job Pending from now, creating it if needed.
+	Sent(ctx context.Context, k Key) error
+
+	Done(ctx context.Context, k Key) error
+
+	// Redrive gives a Dead job a fresh set of attempts.
+	Redrive(ctx context.Context, k Key) error
+
+	// List returns the jobs in state, oldest update first.
+	List(ctx context.Context, state string) ([]Job, error)
+}
+
+// Policy is how often and how long a failing bucket is retried.
+type Policy struct {
+	MaxAttempts int           // attempts before giving up
+	BaseDelay   time.Duration // wait after the first failure; doubles after each
+	MaxDelay    time.Duration
+}
+
+var DefaultPolicy = Policy{MaxAttempts: 8, BaseDelay: 30 * time.Second, MaxDelay: time.Hour}
+
+// Next returns when to try again after attempts failed ones, or nil once
+// the bucket should be given up.
+func (p Policy) Next(attempts int, now time.Time) *time.Time {
+	if attempts >= p.MaxAttempts {
+		return nil
+	}
+	d := p.BaseDelay
+	for i := 1; i < attempts && d < p.MaxDelay; i++ {
+		d *= 2
+	}
+	if d > p.MaxDelay {
+		d = p.MaxDelay
+	}
+	t := now.Add(d)
+	return &t
+}
@@ -0,0 +1,20 @@
+package jobs
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPolicyNext(t *testing.T) {
+	p := Policy{MaxAttempts: 5, BaseDelay: 30 * time.Second, MaxDelay: 90 * time.Second}
+	now := time.Date(2025, 8, 22, 10, 0, 0, 0, time.UTC)
+	for attempts, want := range map[int]time.Duration{1: 30 * time.Second, 2: time.Minute, 3: 90 * time.Second, 4: 90 * time.Second} {
+		next := p.Next(attempts, now)
+		if next == nil || next.Sub(now) != want {
+			t.Errorf("Next(%d) = %v, want now+%s", attempts, next, want)
+		}
+	}
+	if next := p.Next(5, now); next != nil {
+		t.Errorf("Next(5) = %v, want give up", next)
+	}
+}
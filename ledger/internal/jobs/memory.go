@@ -0,0 +1,101 @@
+package jobs
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// MemoryStore keeps jobs in memory (dev, tests); they are lost on restart.
+type MemoryStore struct {
+	mu   sync.Mutex
+	jobs map[Key]Job
+	now  func() time.Time
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{jobs: map[Key]Job{}, now: time.Now}
+}
+
+func (s *MemoryStore) Get(_ context.Context, k Key) (Job, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	j, ok := s.jobs[k]
+	if !ok {
+		return Job{}, ErrNotFound
+	}
+	return j, nil
+}
+
+func (s *MemoryStore) Start(_ context.Context, k Key) (Job, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	j := s.jobs[k]
+	j.Key, j.State, j.NextAttemptAt, j.UpdatedAt = k, Running, nil, s.now()
+	j.Attempts++
+	s.jobs[k] = j
+	return j, nil
+}
+
+func (s *MemoryStore) Fail(_ context.Context, k Key, errMsg string, next *time.Time) (Job, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	j, ok := s.jobs[k]
+	if !ok {
+		return Job{}, ErrNotFound
+	}
+	j.State = Retry
+	if next == nil {
+		j.State = Dead
+	}
+	j.LastError, j.NextAttemptAt, j.UpdatedAt = errMsg, next, s.now()
+	s.jobs[k] = j
+	return j, nil
+}
+
+func (s *MemoryStore) Sent(_ context.Context, k Key) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	j := s.jobs[k]
+	j.Key, j.State, j.NextAttemptAt, j.UpdatedAt = k, Pending, nil, s.now()
+	s.jobs[k] = j
+	return nil
+}
+
+func (s *MemoryStore) Done(_ context.Context, k Key) error {
+	return s.update(k, func(j *Job) { j.State, j.NextAttemptAt = Done, nil })
+}
+
+func (s *MemoryStore) Redrive(_ context.Context, k Key) error {
+	return s.update(k, func(j *Job) { j.State, j.Attempts, j.NextAttemptAt = Retry, 0, nil })
+}
+
+func (s *MemoryStore) update(k Key, f func(*Job)) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if j, ok := s.jobs[k]; ok {
+		f(&j)
+		j.UpdatedAt = s.now()
+		s.jobs[k] = j
+	}
+	return nil
+}
+
+func (s *MemoryStore) List(_ context.Context, state string) ([]Job, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var out []Job
+	for _, j := range s.jobs {
+		if j.State == state {
+			out = append(out, j)
+		}
+	}
+	sort.Slice(out, func(a, b int) bool {
+		if !out[a].UpdatedAt.Equal(out[b].UpdatedAt) {
+			return out[a].UpdatedAt.Before(out[b].UpdatedAt)
+		}
+		return out[a].Key.String() < out[b].Key.String()
+	})
+	return out, nil
+}
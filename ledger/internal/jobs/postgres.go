@@ -0,0 +1,106 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PGStore keeps jobs in ledger_anchor_jobs (data_server migration 028).
+type PGStore struct {
+	db *pgxpool.Pool
+}
+
+func NewPGStore(db *pgxpool.Pool) *PGStore { return &PGStore{db: db} }
+
+const jobColumns = `entity_kind, entity_key, bucket_key, state, attempts, last_error, next_attempt_at, updated_at`
+
+const (
+	getJob = `SELECT ` + jobColumns + ` FROM ledger_anchor_jobs
+WHERE entity_kind = $1 AND entity_key = $2 AND bucket_key = $3`
+
+	startJob = `INSERT INTO ledger_anchor_jobs (entity_kind, entity_key, bucket_key, state, attempts)
+VALUES ($1, $2, $3, 'running', 1)
+ON CONFLICT (entity_kind, entity_key, bucket_key) DO UPDATE
+SET state = 'running', attempts = ledger_anchor_jobs.attempts + 1, next_attempt_at = NULL, updated_at = now()
+RETURNING ` + jobColumns
+
+	failJob = `UPDATE ledger_anchor_jobs
+SET state = CASE WHEN $5::timestamptz IS NULL THEN 'dead' ELSE 'retry' END,
+    last_error = $4, next_attempt_at = $5, updated_at = now()
+WHERE entity_kind = $1 AND entity_key = $2 AND bucket_key = $3
+RETURNING ` + jobColumns
+
+	sentJob = `INSERT INTO ledger_anchor_jobs (entity_kind, entity_key, bucket_key, state)
+VALUES ($1, $2, $3, 'pending')
+ON CONFLICT (entity_kind, entity_key, bucket_key) DO UPDATE
+SET state = 'pending', next_attempt_at = NULL, updated_at = now()`
+
+	doneJob = `UPDATE ledger_anchor_jobs
+SET state = 'done', next_attempt_at = NULL, updated_at = now()
+WHERE entity_kind = $1 AND entity_key = $2 AND bucket_key = $3`
+
+	redriveJob = `UPDATE ledger_anchor_jobs
+SET state = 'retry', attempts = 0, next_attempt_at = NULL, updated_at = now()
+WHERE entity_kind = $1 AND entity_key = $2 AND bucket_key = $3`
+
+	listJobs = `SELECT ` + jobColumns + ` FROM ledger_anchor_jobs
+WHERE state = $1
+ORDER BY updated_at, entity_kind, entity_key, bucket_key`
+)
+
+func scanJob(row pgx.Row) (Job, error) {
+	var j Job
+	err := row.Scan(&j.EntityKind, &j.EntityKey, &j.BucketKey, &j.State, &j.Attempts, &j.LastError, &j.NextAttemptAt, &j.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return j, ErrNotFound
+	}
+	return j, err
+}
+
+func (s *PGStore) Get(ctx context.Context, k Key) (Job, error) {
+	return scanJob(s.db.QueryRow(ctx, getJob, k.EntityKind, k.EntityKey, k.BucketKey))
+}
+
+func (s *PGStore) Start(ctx context.Context, k Key) (Job, error) {
+	return scanJob(s.db.QueryRow(ctx, startJob, k.EntityKind, k.EntityKey, k.BucketKey))
+}
+
+func (s *PGStore) Fail(ctx context.Context, k Key, errMsg string, next *time.Time) (Job, error) {
+	return scanJob(s.db.QueryRow(ctx, failJob, k.EntityKind, k.EntityKey, k.BucketKey, errMsg, next))
+}
+
+func (s *PGStore) Sent(ctx context.Context, k Key) error {
+	_, err := s.db.Exec(ctx, sentJob, k.EntityKind, k.EntityKey, k.BucketKey)
+	return err
+}
+
+func (s *PGStore) Done(ctx context.Context, k Key) error {
+	_, err := s.db.Exec(ctx, doneJob, k.EntityKind, k.EntityKey, k.BucketKey)
+	return err
+}
+
+func (s *PGStore) Redrive(ctx context.Context, k Key) error {
+	_, err := s.db.Exec(ctx, redriveJob, k.EntityKind, k.EntityKey, k.BucketKey)
+	return err
+}
+
+func (s *PGStore) List(ctx context.Context, state string) ([]Job, error) {
+	rows, err := s.db.Query(ctx, listJobs, state)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []Job
+	for rows.Next() {
+		j, err := scanJob(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, j)
+	}
+	return out, rows.Err()
+}
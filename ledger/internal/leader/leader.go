@@ -0,0 +1,71 @@
+// Package leader elects one ledger replica to run the anchoring loop, using a
+// session-level Postgres advisory lock: the replica whose connection holds
+// the lock leads, and the lock goes with the connection when that replica
+// dies, so a standby takes over on its next try.
+package leader
+
+import (
+	"context"
+	"sync"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// LockKey is the advisory lock key ledger runners compete for ("tfledger").
+const LockKey int64 = 0x74666c6564676572
+
+// Postgres drops a session whose host vanished (no FIN) after about
+// idle + interval*count seconds of silence, releasing its lock.
+const keepalives = `SET tcp_keepalives_idle = 10; SET tcp_keepalives_interval = 5; SET tcp_keepalives_count = 3`
+
+type Elector struct {
+	dsn  string
+	key  int64
+	mu   sync.Mutex
+	conn *pgx.Conn // holds the lock while leading
+}
+
+func New(dsn string, key int64) *Elector { return &Elector{dsn: dsn, key: key} }
+
+// Lead reports whether this replica leads, trying to take the lock if it
+// does not. A leader whose connection broke has lost the lock with it and
+// competes again.
+func (e *Elector) Lead(ctx context.Context) (bool, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.conn != nil {
+		if err := e.conn.Ping(ctx); err == nil {
+			return true, nil
+		}
+		_ = e.conn.Close(ctx)
+		e.conn = nil
+	}
+
+	conn, err := pgx.Connect(ctx, e.dsn)
+	if err != nil {
+		return false, err
+	}
+	if _, err := conn.Exec(ctx, keepalives); err != nil {
+		_ = conn.Close(ctx)
+		return false, err
+	}
+	var ok bool
+	if err := conn.QueryRow(ctx, `SELECT pg_try_advisory_lock($1)`, e.key).Scan(&ok); err != nil || !ok {
+		_ = conn.Close(ctx)
+		return false, err
+	}
+	e.conn = conn
+	return true, nil
+}
+
+// Close gives up leadership.
+func (e *Elector) Close(ctx context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.conn == nil {
+		return nil
+	}
+	err := e.conn.Close(ctx) // ends the session, and the lock with it
+	e.conn = nil
+	return err
+}
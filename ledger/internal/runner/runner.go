@@ -2,33 +2,102 @@ package runner
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
+	"fmt"
 	"log"
+	"maps"
+	"slices"
 	"time"
 
-	"github.com/gusplusbus/trustflow/ledger/internal/dataserver"
+	"github.com/gusplusbus/trustflow/data_server/checkpoint"
 	bucketv1 "github.com/gusplusbus/trustflow/data_server/gen/bucketv1"
+	"github.com/gusplusbus/trustflow/ledger/internal/anchor"
+	"github.com/gusplusbus/trustflow/ledger/internal/budget"
+	"github.com/gusplusbus/trustflow/ledger/internal/dataserver"
+	"github.com/gusplusbus/trustflow/ledger/internal/jobs"
+	"github.com/gusplusbus/trustflow/ledger/internal/signing"
 )
 
 type Config struct {
 	DataServerGRPCAddr string
 	Interval           time.Duration
 	ListPageSize       int32
+	Epochs             bool        // anchor one epoch root per UTC day instead of a root per bucket
+	Batch              bool        // per-bucket mode: seal each tick's buckets into one epoch and anchor that
+	Retry              jobs.Policy // per-bucket retries; zero => jobs.DefaultPolicy
+
+	// Anchors whose backend is an anchor.Confirmer wait for Confirmations
+	// blocks (counting the including one; zero => 1). One not mined within
+	// PendingTimeout (zero => 10m) of being sent goes back in the queue.
+	Confirmations  uint64
+	PendingTimeout time.Duration
+
+	// A bucket left anchoring by a leader that lost its lock is failed and
+	// retried once its job is RecoverAfter (zero => 10m) old, so a send that
+	// leader let through just before losing the lock has settled by then.
+	RecoverAfter time.Duration
+}
+
+// Leader decides which replica runs ticks (see internal/leader).
+type Leader interface {
+	Lead(ctx context.Context) (bool, error)
 }
 
 type Runner struct {
-	cfg     Config
-	buckets dataserver.Buckets
+	cfg      Config
+	buckets  dataserver.Buckets
+	jobs     jobs.Store
+	anchorer anchor.Anchorer
+	confirm  anchor.Confirmer // nil => anchors are final once sent
+	keys     *signing.Keyring // nil => no checkpoints
+	budgets  budget.Store     // nil => anchoring is neither charged nor capped
+	projects map[string]string
+	now      func() time.Time
+
+	leader  Leader // nil => this is the only replica
+	leading bool
+}
+
+// WithBudgets charges each bucket anchor's gas to the bucket's project and
+// holds back the buckets of projects over budget (see anchorPending).
+func (r *Runner) WithBudgets(s budget.Store) *Runner {
+	r.budgets = s
+	return r
 }
 
-func New(cfg Config, buckets dataserver.Buckets) *Runner {
+// WithLeader makes the runner tick only while l says this replica leads.
+func (r *Runner) WithLeader(l Leader) *Runner {
+	r.leader = l
+	return r
+}
+
+func New(cfg Config, buckets dataserver.Buckets, store jobs.Store, anchorer anchor.Anchorer, keys *signing.Keyring) *Runner {
 	if cfg.Interval <= 0 {
 		cfg.Interval = 30 * time.Second
 	}
 	if cfg.ListPageSize <= 0 {
 		cfg.ListPageSize = 50
 	}
-	return &Runner{cfg: cfg, buckets: buckets}
+	if cfg.Retry.MaxAttempts <= 0 {
+		cfg.Retry = jobs.DefaultPolicy
+	}
+	if cfg.Confirmations == 0 {
+		cfg.Confirmations = 1
+	}
+	if cfg.PendingTimeout <= 0 {
+		cfg.PendingTimeout = 10 * time.Minute
+	}
+	if cfg.RecoverAfter <= 0 {
+		cfg.RecoverAfter = 10 * time.Minute
+	}
+	if store == nil {
+		store = jobs.NewMemoryStore()
+	}
+	if anchorer == nil {
+		anchorer = anchor.DevAnchorer{}
+	}
+	confirm, _ := anchorer.(anchor.Confirmer)
+	return &Runner{cfg: cfg, buckets: buckets, jobs: store, anchorer: anchorer, confirm: confirm, keys: keys, projects: map[string]string{}, now: time.Now}
 }
 
 func (r *Runner) Start(ctx context.Context) {
@@ -49,62 +118,612 @@ func (r *Runner) Start(ctx context.Context) {
 }
 
 func (r *Runner) tick(ctx context.Context) {
-	statuses := []string{"closed", "needs_anchoring"}
-	for _, st := range statuses {
-		if err := r.handleStatus(ctx, st); err != nil {
-			log.Printf("[runner] status=%s error: %v", st, err)
-		}
+	if !r.lead(ctx) {
+		return
+	}
+	if r.leader != nil {
+		r.recoverInterrupted(ctx)
+	}
+	// failed buckets whose backoff has passed go back in the queue
+	if err := r.retryFailed(ctx); err != nil {
+		log.Printf("[runner] retry failed: %v", err)
+	}
+	if err := r.anchorPending(ctx); err != nil {
+		log.Printf("[runner] status=needs_anchoring error: %v", err)
+	}
+	if err := r.confirmSent(ctx); err != nil {
+		log.Printf("[runner] status=anchoring_pending error: %v", err)
 	}
 }
 
-func (r *Runner) handleStatus(ctx context.Context, status string) error {
-	page := ""
-	total := 0
-	for {
-		resp, err := r.buckets.ListByStatus(ctx, status, r.cfg.ListPageSize, page)
-		if err != nil {
-			return err
-		}
-		for _, b := range resp.GetBuckets() {
-			if err := r.anchorOne(ctx, b); err != nil {
-				log.Printf("[runner] anchor ref=%s/%s/%s: %v",
-					b.GetRef().GetScope().GetEntityKind(),
-					b.GetRef().GetScope().GetEntityKey(),
-					b.GetRef().GetBucketKey(), err)
+// anchorPending claims each needs_anchoring bucket (anchoring) and sends its
+// anchor (anchoring_pending); confirmSent finishes it. With Epochs, buckets
+// wait for the UTC day they were closed to end, and each day's buckets then
+// share a single anchor; with Batch, the buckets claimed in one tick do (see
+// send). A failure is recorded on the bucket's job and the bucket marked
+// failed, or anchor_failed once it has used up its attempts.
+//
+// A bucket whose project would go over its anchoring budget is held in
+// needs_anchoring until the next budget period. Its project's buckets sent
+// and not charged yet, including those claimed earlier in the tick, count
+// toward the spend at the period's average cost per bucket.
+func (r *Runner) anchorPending(ctx context.Context) error {
+	// read the whole queue first: claims move buckets out of it, which
+	// would shift the offsets of later pages
+	pending, err := r.listAll(ctx, "needs_anchoring")
+	if err != nil {
+		return err
+	}
+
+	inflight, err := r.inflight(ctx)
+	if err != nil {
+		return err
+	}
+
+	var batch []claimed
+	days := make(map[string][]claimed) // Epochs: claimed buckets by the day they closed
+	today := r.now().UTC().Format(time.DateOnly)
+	spends := make(map[string]*spend)
+	held, total := 0, 0
+	for _, b := range pending {
+		if !r.lead(ctx) {
+			return nil
+		}
+		day := ""
+		if r.cfg.Epochs {
+			if day = closedDay(b); day >= today {
 				continue
 			}
+		}
+		sp := r.spendOf(ctx, b, spends, inflight)
+		if sp.over() {
+			held++
+			continue
+		}
+		c := r.claim(ctx, b)
+		if c != nil && sp != nil {
+			sp.inflight++
+		}
+		switch {
+		case c == nil:
+		case r.cfg.Epochs:
+			days[day] = append(days[day], *c)
+		case r.cfg.Batch:
+			batch = append(batch, *c)
+		default:
+			total += r.send(ctx, "", []claimed{*c})
+		}
+	}
+	for _, day := range slices.Sorted(maps.Keys(days)) {
+		total += r.send(ctx, day, days[day])
+	}
+	if len(batch) > 0 {
+		total += r.send(ctx, "", batch)
+	}
+	if held > 0 {
+		log.Printf("[runner] %d buckets wait for their project's anchoring budget", held)
+	}
+	if total > 0 {
+		log.Printf("[runner] sent anchors for %d buckets", total)
+	}
+	return nil
+}
+
+// claim moves b to anchoring, starts its job and packs it. It returns nil
+// when b was not claimed, or failed after.
+func (r *Runner) claim(ctx context.Context, b *bucketv1.BucketInfo) *claimed {
+	k := jobKey(b.GetRef())
+	if _, err := r.buckets.SetStatus(ctx, b.GetRef(), "anchoring", "anchor runner"); err != nil {
+		log.Printf("[runner] claim ref=%s: %v", k, err)
+		return nil
+	}
+	job, err := r.jobs.Start(ctx, k)
+	if err != nil {
+		// without a job the attempt would not count; hand it straight back
+		r.fail(ctx, b.GetRef(), job, fmt.Errorf("job store: %w", err))
+		return nil
+	}
+	cid, err := r.prepare(ctx, b)
+	if err != nil {
+		r.fail(ctx, b.GetRef(), job, err)
+		return nil
+	}
+	return &claimed{bucket: b, job: job, cid: cid}
+}
+
+// confirmSent checks the tx of each anchoring_pending bucket: it is anchored
+// once the tx has succeeded under enough blocks, failed if the tx reverted,
+// and queued again if the tx is still not mined after PendingTimeout
+// (dropped, or reorged out and not re-included). A reorg deeper than
+// Confirmations after that is not noticed.
+func (r *Runner) confirmSent(ctx context.Context) error {
+	sent, err := r.listAll(ctx, "anchoring_pending")
+	if err != nil {
+		return err
+	}
+
+	// epoch members share a tx; read its receipt once and split its fee,
+	// each member's share fixed by its place in the list
+	receipts := make(map[string]anchor.TxStatus)
+	members := make(map[string]int)
+	share := make(map[jobs.Key]int, len(sent))
+	for _, b := range sent {
+		share[jobKey(b.GetRef())] = members[b.GetAnchoredTx()]
+		members[b.GetAnchoredTx()]++
+	}
+	total := 0
+	for _, b := range sent {
+		if !r.lead(ctx) {
+			return nil
+		}
+		if r.confirmOne(ctx, b, receipts) {
 			total++
 		}
-		page = resp.GetNextPageToken()
-		if page == "" {
-			break
+	}
+	// the gas is spent once the tx settles, whether it reverted or not; every
+	// bucket it anchored pays its share then, even one whose status update
+	// failed and is retried next tick, so the split stays the same
+	for _, b := range sent {
+		st, ok := receipts[b.GetAnchoredTx()]
+		if ok && st.Mined && (!st.Success || st.Confirmations >= r.cfg.Confirmations) {
+			r.charge(ctx, b, st, share[jobKey(b.GetRef())], members[b.GetAnchoredTx()])
 		}
 	}
 	if total > 0 {
-		log.Printf("[runner] anchored %d buckets for status=%s", total, status)
+		log.Printf("[runner] anchored %d buckets", total)
+	}
+	return nil
+}
+
+// confirmOne settles one anchoring_pending bucket if its tx allows, and
+// reports whether it is now anchored. receipts caches tx statuses read this
+// tick.
+func (r *Runner) confirmOne(ctx context.Context, b *bucketv1.BucketInfo, receipts map[string]anchor.TxStatus) bool {
+	ref, txID := b.GetRef(), b.GetAnchoredTx()
+	k := jobKey(ref)
+	st := anchor.TxStatus{Mined: true, Success: true, Confirmations: r.cfg.Confirmations}
+	if r.confirm != nil {
+		var ok bool
+		if st, ok = receipts[txID]; !ok {
+			var err error
+			if st, err = r.confirm.TxStatus(ctx, txID); err != nil {
+				log.Printf("[runner] receipt ref=%s tx=%s: %v", k, txID, err)
+				return false
+			}
+			receipts[txID] = st
+		}
+	}
+
+	job, err := r.jobs.Get(ctx, k)
+	switch {
+	case errors.Is(err, jobs.ErrNotFound):
+		// sent by a runner whose jobs were not kept: count it and wait from now
+		if job, err = r.jobs.Start(ctx, k); err == nil {
+			err = r.jobs.Sent(ctx, k)
+		}
+		if err != nil {
+			log.Printf("[runner] job ref=%s: %v", k, err)
+			return false
+		}
+	case err != nil:
+		log.Printf("[runner] job ref=%s: %v", k, err)
+		return false
+	}
+
+	switch {
+	case !st.Mined:
+		if r.now().Sub(job.UpdatedAt) < r.cfg.PendingTimeout {
+			return false
+		}
+		reason := fmt.Sprintf("tx %s not mined %s after it was sent", txID, r.cfg.PendingTimeout)
+		if job.Attempts >= r.cfg.Retry.MaxAttempts {
+			r.fail(ctx, ref, job, errors.New(reason))
+			return false
+		}
+		now := r.now()
+		if _, err := r.jobs.Fail(ctx, k, reason, &now); err != nil {
+			log.Printf("[runner] job fail ref=%s: %v", k, err)
+		}
+		if _, err := r.buckets.SetStatus(ctx, ref, "needs_anchoring", reason); err != nil {
+			log.Printf("[runner] requeue ref=%s: %v", k, err)
+		}
+		return false
+	case !st.Success:
+		r.fail(ctx, ref, job, fmt.Errorf("tx %s reverted in block %d", txID, st.BlockNumber))
+		return false
+	case st.Confirmations < r.cfg.Confirmations:
+		return false
+	}
+
+	if _, err := r.buckets.SetAnchored(ctx, ref, txID, st.BlockNumber, st.BlockHash); err != nil {
+		log.Printf("[runner] set anchored ref=%s: %v", k, err)
+		return false
+	}
+	if err := r.jobs.Done(ctx, k); err != nil {
+		log.Printf("[runner] job done ref=%s: %v", k, err)
+	}
+	return true
+}
+
+// fail records a failed attempt and moves the claimed bucket to failed, and
+// on to anchor_failed if that was its last attempt.
+func (r *Runner) fail(ctx context.Context, ref *bucketv1.BucketRef, job jobs.Job, cause error) {
+	k := jobKey(ref)
+	log.Printf("[runner] anchor ref=%s attempt %d: %v", k, job.Attempts, cause)
+	next := r.cfg.Retry.Next(job.Attempts, r.now())
+	if job.Attempts > 0 {
+		if _, err := r.jobs.Fail(ctx, k, cause.Error(), next); err != nil {
+			log.Printf("[runner] job fail ref=%s: %v", k, err)
+		}
+	}
+	if _, err := r.buckets.SetStatus(ctx, ref, "failed", cause.Error()); err != nil {
+		log.Printf("[runner] mark failed ref=%s: %v", k, err)
+		return
+	}
+	if next == nil {
+		r.giveUp(ctx, ref, job.Attempts, cause.Error())
+	}
+}
+
+func (r *Runner) giveUp(ctx context.Context, ref *bucketv1.BucketRef, attempts int, lastErr string) {
+	reason := fmt.Sprintf("gave up after %d attempts: %s", attempts, lastErr)
+	if _, err := r.buckets.SetStatus(ctx, ref, "anchor_failed", reason); err != nil {
+		log.Printf("[runner] give up ref=%s: %v", jobKey(ref), err)
+		return
+	}
+	log.Printf("[runner] ref=%s is anchor_failed (%s); re-drive with trustflow-redrive", jobKey(ref), reason)
+}
+
+// retryFailed puts failed buckets whose backoff has passed back to
+// needs_anchoring, and gives up those out of attempts (a crash can leave a
+// bucket failed without that step).
+func (r *Runner) retryFailed(ctx context.Context) error {
+	failed, err := r.listAll(ctx, "failed")
+	if err != nil {
+		return err
+	}
+
+	now := r.now()
+	for _, b := range failed {
+		k := jobKey(b.GetRef())
+		job, err := r.jobs.Get(ctx, k)
+		switch {
+		case errors.Is(err, jobs.ErrNotFound):
+			// failed outside this runner: retry now, counting from zero
+		case err != nil:
+			log.Printf("[runner] job ref=%s: %v", k, err)
+			continue
+		case job.State == jobs.Dead || job.Attempts >= r.cfg.Retry.MaxAttempts:
+			r.giveUp(ctx, b.GetRef(), job.Attempts, job.LastError)
+			continue
+		case !job.Due(now):
+			continue
+		}
+		reason := fmt.Sprintf("retry after %d failed attempts", job.Attempts)
+		if _, err := r.buckets.SetStatus(ctx, b.GetRef(), "needs_anchoring", reason); err != nil {
+			log.Printf("[runner] retry ref=%s: %v", k, err)
+		}
 	}
 	return nil
 }
 
-func (r *Runner) anchorOne(ctx context.Context, b *bucketv1.BucketInfo) error {
-	// Minimal manifest (anchor the verifiable root + metadata).
-	manifest := map[string]any{
-		"entity_kind":  b.GetRef().GetScope().GetEntityKind(),
-		"entity_key":   b.GetRef().GetScope().GetEntityKey(),
-		"bucket_key":   b.GetRef().GetBucketKey(),
-		"root_hash":    b.GetRootHash(),
-		"leaf_count":   b.GetLeafCount(),
-		"closed_at":    b.GetClosedAt(),
-		"status":       b.GetStatus(),
-		// A future step could include per-leaf list or a CAR.
+// spend is a capped project's anchoring spend as a tick goes: what was
+// charged in the budget period, and how many of its buckets were sent
+// without being charged yet.
+type spend struct {
+	rep      budget.Report
+	inflight int
+}
+
+func (s *spend) over() bool { return s != nil && s.rep.OverWith(s.inflight) }
+
+// spendOf returns b's project's spend, read once per tick into spends, or
+// nil when there are no budgets or b's project cannot be told (it is not
+// held back then). inflight counts each project's buckets in flight when
+// the tick started.
+func (r *Runner) spendOf(ctx context.Context, b *bucketv1.BucketInfo, spends map[string]*spend, inflight map[string]int) *spend {
+	if r.budgets == nil {
+		return nil
+	}
+	project, err := r.projectOf(ctx, b.GetRef().GetScope())
+	if err != nil || project == "" {
+		return nil
+	}
+	if sp, ok := spends[project]; ok {
+		return sp
+	}
+	rep, err := budget.Check(ctx, r.budgets, project, r.now())
+	if err != nil {
+		log.Printf("[runner] budget project=%s: %v", project, err)
+		return nil
+	}
+	sp := &spend{rep: rep, inflight: inflight[project]}
+	spends[project] = sp
+	return sp
+}
+
+// inflight counts the buckets of each project that were claimed or sent
+// but not charged yet (anchoring or anchoring_pending).
+func (r *Runner) inflight(ctx context.Context) (map[string]int, error) {
+	if r.budgets == nil {
+		return nil, nil
+	}
+	out := make(map[string]int)
+	for _, status := range []string{"anchoring", "anchoring_pending"} {
+		bs, err := r.listAll(ctx, status)
+		if err != nil {
+			return nil, err
+		}
+		for _, b := range bs {
+			if project, err := r.projectOf(ctx, b.GetRef().GetScope()); err == nil && project != "" {
+				out[project]++
+			}
+		}
+	}
+	return out, nil
+}
+
+// charge records b's share of its tx's fee against b's project.
+func (r *Runner) charge(ctx context.Context, b *bucketv1.BucketInfo, st anchor.TxStatus, i, n int) {
+	if r.budgets == nil || (st.GasUsed == 0 && st.Fee == nil) {
+		return
+	}
+	project, err := r.projectOf(ctx, b.GetRef().GetScope())
+	if err != nil || project == "" {
+		return
+	}
+	gas, fee := budget.Split(st.GasUsed, st.Fee, n, i)
+	k := jobKey(b.GetRef())
+	err = r.budgets.Charge(ctx, budget.Fee{ProjectID: project, Key: k, TxID: b.GetAnchoredTx(), GasUsed: gas, FeeWei: fee, ChargedAt: r.now()})
+	if err != nil {
+		log.Printf("[runner] charge ref=%s project=%s: %v", k, project, err)
+	}
+}
+
+// projectOf returns the project a scope belongs to, remembering it once
+// known.
+func (r *Runner) projectOf(ctx context.Context, scope *bucketv1.Scope) (string, error) {
+	k := scope.GetEntityKind() + "/" + scope.GetEntityKey()
+	if p, ok := r.projects[k]; ok {
+		return p, nil
+	}
+	p, err := r.buckets.Project(ctx, scope)
+	if err != nil {
+		log.Printf("[runner] project of %s: %v", k, err)
+		return "", err
+	}
+	if p != "" {
+		r.projects[k] = p
+	}
+	return p, nil
+}
+
+// claimed is a bucket the runner has claimed and packed, ready to anchor.
+type claimed struct {
+	bucket *bucketv1.BucketInfo
+	job    jobs.Job
+	cid    string
+}
+
+// prepare vouches for a claimed bucket's root and packs it, returning the
+// CID of its CAR.
+func (r *Runner) prepare(ctx context.Context, b *bucketv1.BucketInfo) (string, error) {
+	// Vouch for the closed root before it goes anywhere.
+	if err := r.signCheckpoint(ctx, b); err != nil {
+		return "", err
 	}
-	raw, _ := json.Marshal(manifest)
 
-	// TODO(real): PUT to IPFS → cid, send chain tx → txid.
-	// Dev mode: deterministic "cid" + "tx" so we can exercise DS.SetBucketAnchored.
-	cid := dataserver.DevCID(raw)
-	tx := dataserver.DevTX("anchored")
+	// Data server writes the bucket CAR (manifest + items) and returns its CID.
+	packed, err := r.buckets.Pack(ctx, b.GetRef())
+	if err != nil {
+		return "", err
+	}
+	return packed.GetCid(), nil
+}
+
+// send anchors cs with one tx: a lone bucket's own root, or else the root of
+// an epoch the data server seals over all of them for period (a UTC day;
+// "" => today). It returns how many were sent. Nothing is sent once this
+// replica no longer leads: the claimed buckets stay anchoring for the new
+// leader to recover.
+func (r *Runner) send(ctx context.Context, period string, cs []claimed) int {
+	if len(cs) > 1 {
+		refs := make([]*bucketv1.BucketRef, len(cs))
+		for i, c := range cs {
+			refs[i] = c.bucket.GetRef()
+		}
+		e, err := r.buckets.SealEpoch(ctx, period, refs)
+		if err != nil {
+			r.failAll(ctx, cs, fmt.Errorf("seal epoch: %w", err))
+			return 0
+		}
+		log.Printf("[runner] sealed epoch %d (%s) over %d buckets", e.GetId(), e.GetPeriod(), e.GetLeafCount())
+		if !r.sendEpoch(ctx, e, cs) {
+			return 0
+		}
+		return len(cs)
+	}
+
+	c := cs[0]
+	ref := c.bucket.GetRef()
+	if !r.lead(ctx) {
+		return 0
+	}
+	rec, err := r.anchorer.Anchor(ctx, anchor.Request{
+		EntityKind: ref.GetScope().GetEntityKind(),
+		EntityKey:  ref.GetScope().GetEntityKey(),
+		BucketKey:  ref.GetBucketKey(),
+		RootHash:   c.bucket.GetRootHash(),
+		LeafCount:  c.bucket.GetLeafCount(),
+		CID:        c.cid,
+	})
+	if err == nil {
+		_, err = r.buckets.SetAnchorPending(ctx, ref, c.cid, rec.TxID, rec.Proof)
+	}
+	if err != nil {
+		r.fail(ctx, ref, c.job, err)
+		return 0
+	}
+	if err := r.jobs.Sent(ctx, jobKey(ref)); err != nil {
+		log.Printf("[runner] job sent ref=%s: %v", jobKey(ref), err)
+	}
+	return 1
+}
+
+// sendEpoch anchors e's root for its members cs, whose jobs are running.
+// They then wait in anchoring_pending with the shared tx; if it cannot be
+// sent, they are all failed (and with them the epoch), and retryFailed
+// queues them for a new epoch, or gives them up.
+func (r *Runner) sendEpoch(ctx context.Context, e *bucketv1.EpochInfo, cs []claimed) bool {
+	if !r.lead(ctx) {
+		return false
+	}
+	rec, err := r.anchorer.Anchor(ctx, anchor.Request{
+		EntityKind: anchor.EpochKind,
+		EntityKey:  e.GetPeriod(),
+		BucketKey:  fmt.Sprint(e.GetId()),
+		RootHash:   e.GetRootHash(),
+		LeafCount:  e.GetLeafCount(),
+		CID:        e.GetCid(),
+	})
+	if err == nil {
+		_, err = r.buckets.SetEpochAnchorPending(ctx, e.GetId(), rec.TxID, rec.Proof)
+	}
+	if err != nil {
+		r.failAll(ctx, cs, err)
+		return false
+	}
+	for _, c := range cs {
+		if err := r.jobs.Sent(ctx, jobKey(c.bucket.GetRef())); err != nil {
+			log.Printf("[runner] job sent ref=%s: %v", jobKey(c.bucket.GetRef()), err)
+		}
+	}
+	return true
+}
+
+// closedDay returns the UTC day b was closed, or "" when that is not known.
+func closedDay(b *bucketv1.BucketInfo) string {
+	t, err := time.Parse(time.RFC3339, b.GetClosedAt())
+	if err != nil {
+		return ""
+	}
+	return t.UTC().Format(time.DateOnly)
+}
+
+func (r *Runner) failAll(ctx context.Context, cs []claimed, cause error) {
+	for _, c := range cs {
+		r.fail(ctx, c.bucket.GetRef(), c.job, cause)
+	}
+}
+
+// signCheckpoint signs (scope, bucket_key, tree_alg, leaf_count, root) with
+// the active ledger key and stores it with the bucket.
+func (r *Runner) signCheckpoint(ctx context.Context, b *bucketv1.BucketInfo) error {
+	if r.keys == nil {
+		return nil
+	}
+	cp := checkpoint.Checkpoint{
+		EntityKind: b.GetRef().GetScope().GetEntityKind(),
+		EntityKey:  b.GetRef().GetScope().GetEntityKey(),
+		BucketKey:  b.GetRef().GetBucketKey(),
+		TreeAlg:    b.GetTreeAlg(),
+		LeafCount:  b.GetLeafCount(),
+		RootHash:   b.GetRootHash(),
+	}
+	kid, sig, err := r.keys.Sign(cp)
+	if err != nil {
+		return err
+	}
+	return r.buckets.AddCheckpoint(ctx, b.GetRef(), &bucketv1.Checkpoint{
+		LeafCount: cp.LeafCount,
+		RootHash:  cp.RootHash,
+		TreeAlg:   cp.TreeAlg,
+		KeyId:     kid,
+		Signature: sig,
+	})
+}
+
+// lead reports whether this replica should anchor now. It is checked right
+// before every anchor is sent, so a replica that lost its lock sends none.
+func (r *Runner) lead(ctx context.Context) bool {
+	if r.leader == nil {
+		return true
+	}
+	ok, err := r.leader.Lead(ctx)
+	if err != nil {
+		log.Printf("[runner] leader election: %v", err)
+	}
+	switch {
+	case ok && !r.leading:
+		log.Printf("[runner] leading")
+	case !ok && r.leading:
+		log.Printf("[runner] no longer leading")
+	}
+	r.leading = ok
+	return ok
+}
+
+// recoverInterrupted settles the jobs left running by a leader that died or
+// lost its lock mid-anchor: a bucket it anchored is done and one whose tx it
+// sent waits for confirmations as usual; one still anchoring counts a failed
+// attempt and is retried as usual, but only once its job is RecoverAfter
+// old. That leader checked its lock right before sending, so a send it let
+// through has reached anchoring_pending by then, and the bucket is not
+// anchored twice.
+func (r *Runner) recoverInterrupted(ctx context.Context) {
+	running, err := r.jobs.List(ctx, jobs.Running)
+	if err != nil {
+		log.Printf("[runner] recover: %v", err)
+		return
+	}
+	now := r.now()
+	for _, j := range running {
+		ref := &bucketv1.BucketRef{Scope: &bucketv1.Scope{EntityKind: j.EntityKind, EntityKey: j.EntityKey}, BucketKey: j.BucketKey}
+		b, err := r.buckets.Get(ctx, ref)
+		if err != nil {
+			log.Printf("[runner] recover ref=%s: %v", j.Key, err)
+			continue
+		}
+		switch b.GetStatus() {
+		case "anchoring":
+			if now.Sub(j.UpdatedAt) < r.cfg.RecoverAfter {
+				continue // may still be sent by the replica that claimed it
+			}
+			r.fail(ctx, ref, j, errors.New("interrupted: the ledger replica anchoring it stopped"))
+		case "anchoring_pending":
+			if err := r.jobs.Sent(ctx, j.Key); err != nil {
+				log.Printf("[runner] recover ref=%s: %v", j.Key, err)
+			}
+		case "anchored":
+			if err := r.jobs.Done(ctx, j.Key); err != nil {
+				log.Printf("[runner] recover ref=%s: %v", j.Key, err)
+			}
+		default:
+			// moved on without us (e.g. re-driven); let the next claim restart it
+			if _, err := r.jobs.Fail(ctx, j.Key, "interrupted", &now); err != nil {
+				log.Printf("[runner] recover ref=%s: %v", j.Key, err)
+			}
+		}
+	}
+}
+
+// listAll reads every page of the buckets in status.
+func (r *Runner) listAll(ctx context.Context, status string) ([]*bucketv1.BucketInfo, error) {
+	var out []*bucketv1.BucketInfo
+	page := ""
+	for {
+		resp, err := r.buckets.ListByStatus(ctx, status, r.cfg.ListPageSize, page)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, resp.GetBuckets()...)
+		page = resp.GetNextPageToken()
+		if page == "" {
+			return out, nil
+		}
+	}
+}
 
-	_, err := r.buckets.SetAnchored(ctx, b.GetRef(), cid, tx)
-	return err
+func jobKey(ref *bucketv1.BucketRef) jobs.Key {
+	return jobs.Key{EntityKind: ref.GetScope().GetEntityKind(), EntityKey: ref.GetScope().GetEntityKey(), BucketKey: ref.GetBucketKey()}
 }
@@ -0,0 +1,605 @@
+package runner
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	bucketv1 "github.com/gusplusbus/trustflow/data_server/gen/bucketv1"
+	"github.com/gusplusbus/trustflow/ledger/internal/anchor"
+	"github.com/gusplusbus/trustflow/ledger/internal/budget"
+	"github.com/gusplusbus/trustflow/ledger/internal/chain/chaintest"
+	"github.com/gusplusbus/trustflow/ledger/internal/dataserver"
+	"github.com/gusplusbus/trustflow/ledger/internal/jobs"
+)
+
+// fakeBuckets holds one bucket and applies status changes without checking
+// them; the data server enforces the state machine.
+type fakeBuckets struct {
+	dataserver.Buckets
+	b *bucketv1.BucketInfo
+}
+
+func (f *fakeBuckets) ListByStatus(_ context.Context, status string, _ int32, _ string) (*bucketv1.ListBucketsByStatusResponse, error) {
+	out := &bucketv1.ListBucketsByStatusResponse{}
+	if f.b.GetStatus() == status {
+		out.Buckets = append(out.Buckets, f.b)
+	}
+	return out, nil
+}
+
+func (f *fakeBuckets) SetStatus(_ context.Context, _ *bucketv1.BucketRef, status, _ string) (*bucketv1.BucketInfo, error) {
+	f.b.Status = status
+	return f.b, nil
+}
+
+func (f *fakeBuckets) Pack(context.Context, *bucketv1.BucketRef) (*bucketv1.PackBucketResponse, error) {
+	return &bucketv1.PackBucketResponse{Cid: "bafy"}, nil
+}
+
+func (f *fakeBuckets) SetAnchorPending(_ context.Context, _ *bucketv1.BucketRef, cid, tx string, proof []byte) (*bucketv1.BucketInfo, error) {
+	f.b.Status, f.b.Cid, f.b.AnchoredTx, f.b.AnchorProof = "anchoring_pending", cid, tx, proof
+	return f.b, nil
+}
+
+func (f *fakeBuckets) SetAnchored(_ context.Context, _ *bucketv1.BucketRef, _ string, block uint64, blockHash string) (*bucketv1.SetBucketAnchoredResponse, error) {
+	f.b.Status, f.b.AnchoredBlock, f.b.AnchoredBlockHash = "anchored", block, blockHash
+	return &bucketv1.SetBucketAnchoredResponse{Bucket: f.b}, nil
+}
+
+type flakyAnchorer struct {
+	err  error
+	reqs []anchor.Request
+}
+
+func (a *flakyAnchorer) Anchor(_ context.Context, req anchor.Request) (anchor.Receipt, error) {
+	if a.err != nil {
+		return anchor.Receipt{}, a.err
+	}
+	a.reqs = append(a.reqs, req)
+	return anchor.Receipt{TxID: "0x1"}, nil
+}
+
+func TestRunnerRetriesThenDeadLetters(t *testing.T) {
+	ctx := context.Background()
+	ref := &bucketv1.BucketRef{Scope: &bucketv1.Scope{EntityKind: "issue", EntityKey: "gh#1"}, BucketKey: "2025-08-22"}
+	k := jobKey(ref)
+	buckets := &fakeBuckets{b: &bucketv1.BucketInfo{Ref: ref, Status: "needs_anchoring"}}
+	store := jobs.NewMemoryStore()
+	anchorer := &flakyAnchorer{err: errors.New("rpc down")}
+	r := New(Config{Retry: jobs.Policy{MaxAttempts: 2, BaseDelay: time.Minute, MaxDelay: time.Hour}}, buckets, store, anchorer, nil)
+	now := time.Date(2025, 8, 22, 10, 0, 0, 0, time.UTC)
+	r.now = func() time.Time { return now }
+
+	r.tick(ctx)
+	if j, _ := store.Get(ctx, k); buckets.b.Status != "failed" || j.State != jobs.Retry || j.Attempts != 1 || j.LastError != "rpc down" {
+		t.Fatalf("after first failure: status %s, job %+v", buckets.b.Status, j)
+	}
+
+	r.tick(ctx) // backoff not over
+	if j, _ := store.Get(ctx, k); buckets.b.Status != "failed" || j.Attempts != 1 {
+		t.Fatalf("retried before backoff: status %s, job %+v", buckets.b.Status, j)
+	}
+
+	now = now.Add(2 * time.Minute)
+	r.tick(ctx)
+	if j, _ := store.Get(ctx, k); buckets.b.Status != "anchor_failed" || j.State != jobs.Dead || j.Attempts != 2 {
+		t.Fatalf("after last attempt: status %s, job %+v", buckets.b.Status, j)
+	}
+
+	now = now.Add(24 * time.Hour)
+	r.tick(ctx) // dead letters stay put
+	if buckets.b.Status != "anchor_failed" {
+		t.Fatalf("dead letter moved to %s", buckets.b.Status)
+	}
+
+	// re-drive
+	anchorer.err = nil
+	if err := store.Redrive(ctx, k); err != nil {
+		t.Fatal(err)
+	}
+	buckets.b.Status = "needs_anchoring"
+	r.tick(ctx)
+	if j, _ := store.Get(ctx, k); buckets.b.Status != "anchored" || j.State != jobs.Done || j.Attempts != 1 {
+		t.Fatalf("after re-drive: status %s, job %+v", buckets.b.Status, j)
+	}
+}
+
+type fakeLeader struct{ ok bool }
+
+func (l *fakeLeader) Lead(context.Context) (bool, error) { return l.ok, nil }
+
+func (f *fakeBuckets) Get(context.Context, *bucketv1.BucketRef) (*bucketv1.BucketInfo, error) {
+	return f.b, nil
+}
+
+func TestRunnerTakesOverInterruptedAnchor(t *testing.T) {
+	ctx := context.Background()
+	ref := &bucketv1.BucketRef{Scope: &bucketv1.Scope{EntityKind: "issue", EntityKey: "gh#1"}, BucketKey: "2025-08-22"}
+	buckets := &fakeBuckets{b: &bucketv1.BucketInfo{Ref: ref, Status: "needs_anchoring"}}
+	store := jobs.NewMemoryStore()
+	l := &fakeLeader{}
+	r := New(Config{}, buckets, store, &flakyAnchorer{}, nil).WithLeader(l)
+
+	r.tick(ctx)
+	if buckets.b.Status != "needs_anchoring" {
+		t.Fatalf("standby touched the bucket: %s", buckets.b.Status)
+	}
+
+	// the old leader claimed it and died
+	buckets.b.Status = "anchoring"
+	if _, err := store.Start(ctx, jobKey(ref)); err != nil {
+		t.Fatal(err)
+	}
+	l.ok = true
+	r.tick(ctx)
+	if j, _ := store.Get(ctx, jobKey(ref)); buckets.b.Status != "anchoring" || j.State != jobs.Running {
+		t.Fatalf("took over a fresh claim: status %s, job %+v", buckets.b.Status, j)
+	}
+
+	r.now = func() time.Time { return time.Now().Add(11 * time.Minute) }
+	r.tick(ctx)
+	j, _ := store.Get(ctx, jobKey(ref))
+	if buckets.b.Status != "failed" || j.State != jobs.Retry || j.Attempts != 1 {
+		t.Fatalf("after take-over: status %s, job %+v", buckets.b.Status, j)
+	}
+}
+
+// losingBuckets makes the replica lose its lock while it packs a claim.
+type losingBuckets struct {
+	*fakeBuckets
+	l *fakeLeader
+}
+
+func (f losingBuckets) Pack(ctx context.Context, ref *bucketv1.BucketRef) (*bucketv1.PackBucketResponse, error) {
+	f.l.ok = false
+	return f.fakeBuckets.Pack(ctx, ref)
+}
+
+func TestRunnerSendsOnlyWhileLeading(t *testing.T) {
+	ctx := context.Background()
+	ref := &bucketv1.BucketRef{Scope: &bucketv1.Scope{EntityKind: "issue", EntityKey: "gh#1"}, BucketKey: "2025-08-22"}
+	buckets := &fakeBuckets{b: &bucketv1.BucketInfo{Ref: ref, Status: "needs_anchoring"}}
+	l := &fakeLeader{ok: true}
+	anchorer := &flakyAnchorer{}
+	r := New(Config{}, losingBuckets{buckets, l}, jobs.NewMemoryStore(), anchorer, nil).WithLeader(l)
+
+	r.tick(ctx)
+	if len(anchorer.reqs) != 0 || buckets.b.Status != "anchoring" {
+		t.Fatalf("sent %d anchors after losing the lock; status %s", len(anchorer.reqs), buckets.b.Status)
+	}
+}
+
+func TestRunnerWaitsForConfirmations(t *testing.T) {
+	sim := chaintest.New(31337)
+	defer sim.Close()
+	ctx := context.Background()
+	evm, err := anchor.New(ctx, anchor.Config{
+		Mode:       anchor.ModeEVM,
+		RPCURL:     sim.URL(),
+		PrivateKey: "ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80",
+		Contract:   "0x5fbdb2315678afecb367f032d93f642f64180aa3",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ref := &bucketv1.BucketRef{Scope: &bucketv1.Scope{EntityKind: "issue", EntityKey: "gh#1"}, BucketKey: "2025-08-22"}
+	root := make([]byte, 32)
+	buckets := &fakeBuckets{b: &bucketv1.BucketInfo{Ref: ref, Status: "needs_anchoring", RootHash: root}}
+	store := jobs.NewMemoryStore()
+	r := New(Config{Confirmations: 3, PendingTimeout: time.Minute}, buckets, store, evm, nil)
+	now := time.Now()
+	r.now = func() time.Time { return now }
+
+	// dropped by a reorg: back in the queue once the timeout passes
+	r.tick(ctx)
+	if buckets.b.Status != "anchoring_pending" {
+		t.Fatalf("after send: status %s", buckets.b.Status)
+	}
+	sim.Drop(buckets.b.AnchoredTx)
+	r.tick(ctx)
+	if buckets.b.Status != "anchoring_pending" {
+		t.Fatalf("requeued before the timeout: status %s", buckets.b.Status)
+	}
+	now = now.Add(2 * time.Minute)
+	r.tick(ctx)
+	if buckets.b.Status != "needs_anchoring" {
+		t.Fatalf("after the timeout: status %s", buckets.b.Status)
+	}
+	r.tick(ctx)
+	if j, _ := store.Get(ctx, jobKey(ref)); buckets.b.Status != "anchoring_pending" || j.Attempts != 2 || len(sim.Txs()) != 2 {
+		t.Fatalf("after drop: status %s, job %+v, %d txs", buckets.b.Status, j, len(sim.Txs()))
+	}
+
+	// buried under enough blocks
+	sim.Mine(1)
+	r.tick(ctx)
+	if buckets.b.Status != "anchoring_pending" {
+		t.Fatalf("anchored with 2 confirmations: status %s", buckets.b.Status)
+	}
+	sim.Mine(1)
+	r.tick(ctx)
+	tx := sim.Txs()[1]
+	if buckets.b.Status != "anchored" || buckets.b.AnchoredBlock != tx.Block || buckets.b.AnchoredBlockHash != chaintest.BlockHash(tx.Block) {
+		t.Fatalf("after 3 confirmations: %+v", buckets.b)
+	}
+	if j, _ := store.Get(ctx, jobKey(ref)); j.State != jobs.Done {
+		t.Fatalf("job %+v, want done", j)
+	}
+
+	// reverted: failed, retried after backoff
+	buckets.b.Status = "needs_anchoring"
+	r.tick(ctx)
+	sim.Revert(buckets.b.AnchoredTx)
+	r.tick(ctx)
+	if j, _ := store.Get(ctx, jobKey(ref)); buckets.b.Status != "failed" || j.State != jobs.Retry {
+		t.Fatalf("after revert: status %s, job %+v", buckets.b.Status, j)
+	}
+}
+
+var batchRoot = bytes.Repeat([]byte{0xba}, 32)
+
+// batchBuckets holds several buckets, and the epochs sealed over them.
+type batchBuckets struct {
+	dataserver.Buckets
+	bs      []*bucketv1.BucketInfo
+	sealed  [][]*bucketv1.BucketRef
+	sealErr error
+	epochs  []*bucketv1.EpochInfo
+
+	anchorErr map[jobs.Key]error // SetAnchored fails for these refs
+}
+
+func (f *batchBuckets) find(ref *bucketv1.BucketRef) *bucketv1.BucketInfo {
+	for _, b := range f.bs {
+		if jobKey(b.GetRef()) == jobKey(ref) {
+			return b
+		}
+	}
+	return nil
+}
+
+func (f *batchBuckets) ListByStatus(_ context.Context, status string, _ int32, _ string) (*bucketv1.ListBucketsByStatusResponse, error) {
+	out := &bucketv1.ListBucketsByStatusResponse{}
+	for _, b := range f.bs {
+		if b.GetStatus() == status {
+			out.Buckets = append(out.Buckets, b)
+		}
+	}
+	return out, nil
+}
+
+// SetStatus fails the live epoch of a member that leaves it unanchored, as
+// the data server does.
+func (f *batchBuckets) SetStatus(_ context.Context, ref *bucketv1.BucketRef, status, _ string) (*bucketv1.BucketInfo, error) {
+	b := f.find(ref)
+	if b.Status == "anchoring" || b.Status == "anchoring_pending" {
+		for _, e := range f.epochs {
+			for _, m := range e.GetMembers() {
+				if jobKey(m.GetRef()) == jobKey(ref) && (e.Status == "needs_anchoring" || e.Status == "anchoring_pending") {
+					e.Status = "failed"
+				}
+			}
+		}
+	}
+	b.Status = status
+	return b, nil
+}
+
+func (f *batchBuckets) SetEpochAnchorPending(_ context.Context, id uint64, tx string, proof []byte) (*bucketv1.EpochInfo, error) {
+	e := f.epochs[id-1]
+	e.Status, e.AnchoredTx, e.AnchorProof = "anchoring_pending", tx, proof
+	for _, m := range e.GetMembers() {
+		b := f.find(m.GetRef())
+		b.Status, b.AnchoredTx, b.AnchorProof = "anchoring_pending", tx, proof
+	}
+	return e, nil
+}
+
+// Project puts gh#<n> in project p<n>.
+func (f *batchBuckets) Project(_ context.Context, scope *bucketv1.Scope) (string, error) {
+	return "p" + strings.TrimPrefix(scope.GetEntityKey(), "gh#"), nil
+}
+
+func (f *batchBuckets) Pack(context.Context, *bucketv1.BucketRef) (*bucketv1.PackBucketResponse, error) {
+	return &bucketv1.PackBucketResponse{Cid: "bafy"}, nil
+}
+
+// SealEpoch rolls claimed buckets into a new epoch.
+func (f *batchBuckets) SealEpoch(_ context.Context, period string, refs []*bucketv1.BucketRef) (*bucketv1.EpochInfo, error) {
+	if f.sealErr != nil {
+		return nil, f.sealErr
+	}
+	if period == "" {
+		period = "2025-08-22"
+	}
+	f.sealed = append(f.sealed, refs)
+	e := &bucketv1.EpochInfo{Id: uint64(len(f.epochs) + 1), Period: period, RootHash: batchRoot, LeafCount: uint32(len(refs)), Status: "needs_anchoring"}
+	for _, ref := range refs {
+		e.Members = append(e.Members, &bucketv1.EpochMember{Ref: ref, RootHash: f.find(ref).GetRootHash()})
+	}
+	f.epochs = append(f.epochs, e)
+	return e, nil
+}
+
+func (f *batchBuckets) SetAnchorPending(_ context.Context, ref *bucketv1.BucketRef, cid, tx string, proof []byte) (*bucketv1.BucketInfo, error) {
+	b := f.find(ref)
+	b.Status, b.Cid, b.AnchoredTx, b.AnchorProof = "anchoring_pending", cid, tx, proof
+	return b, nil
+}
+
+func (f *batchBuckets) SetAnchored(_ context.Context, ref *bucketv1.BucketRef, _ string, block uint64, blockHash string) (*bucketv1.SetBucketAnchoredResponse, error) {
+	if err := f.anchorErr[jobKey(ref)]; err != nil {
+		return nil, err
+	}
+	b := f.find(ref)
+	b.Status, b.AnchoredBlock, b.AnchoredBlockHash = "anchored", block, blockHash
+	return &bucketv1.SetBucketAnchoredResponse{Bucket: b}, nil
+}
+
+func TestRunnerAnchorsBatch(t *testing.T) {
+	ctx := context.Background()
+	buckets := &batchBuckets{sealErr: errors.New("db down")}
+	for _, key := range []string{"2025-08-20", "2025-08-21", "2025-08-22"} {
+		ref := &bucketv1.BucketRef{Scope: &bucketv1.Scope{EntityKind: "issue", EntityKey: "gh#1"}, BucketKey: key}
+		buckets.bs = append(buckets.bs, &bucketv1.BucketInfo{Ref: ref, Status: "needs_anchoring", RootHash: []byte(key)})
+	}
+	store := jobs.NewMemoryStore()
+	anchorer := &flakyAnchorer{}
+	r := New(Config{Batch: true}, buckets, store, anchorer, nil)
+	now := time.Date(2025, 8, 22, 10, 0, 0, 0, time.UTC)
+	r.now = func() time.Time { return now }
+
+	// a batch whose epoch cannot be sealed fails every member
+	r.tick(ctx)
+	for _, b := range buckets.bs {
+		if j, _ := store.Get(ctx, jobKey(b.GetRef())); b.Status != "failed" || j.Attempts != 1 {
+			t.Fatalf("after seal failure: status %s, job %+v", b.Status, j)
+		}
+	}
+	if len(anchorer.reqs) != 0 {
+		t.Fatalf("anchored %d times without an epoch", len(anchorer.reqs))
+	}
+
+	buckets.sealErr = nil
+	now = now.Add(time.Hour)
+	r.tick(ctx) // retried, sent and (dev) confirmed
+	if len(buckets.sealed) != 1 || len(buckets.sealed[0]) != 3 || len(anchorer.reqs) != 1 {
+		t.Fatalf("sealed %v, anchored %d times; want one epoch of 3, one anchor", buckets.sealed, len(anchorer.reqs))
+	}
+	if req := anchorer.reqs[0]; req.EntityKind != anchor.EpochKind || req.BucketKey != "1" || !bytes.Equal(req.RootHash, batchRoot) {
+		t.Fatalf("anchored %+v, want the epoch root", req)
+	}
+	for _, b := range buckets.bs {
+		if j, _ := store.Get(ctx, jobKey(b.GetRef())); b.Status != "anchored" || b.AnchoredTx != "0x1" || j.State != jobs.Done {
+			t.Fatalf("after batch: %+v, job %+v", b, j)
+		}
+	}
+
+	// a lone bucket is anchored under its own id
+	buckets.bs[0].Status = "needs_anchoring"
+	r.tick(ctx)
+	if len(buckets.sealed) != 1 || anchorer.reqs[1].EntityKind != "issue" {
+		t.Fatalf("lone bucket: sealed %d epochs, anchored %+v", len(buckets.sealed), anchorer.reqs[1])
+	}
+}
+
+func TestRunnerConfirmsEpochMembers(t *testing.T) {
+	sim := chaintest.New(31337)
+	defer sim.Close()
+	ctx := context.Background()
+	evm, err := anchor.New(ctx, anchor.Config{
+		Mode:       anchor.ModeEVM,
+		RPCURL:     sim.URL(),
+		PrivateKey: "ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80",
+		Contract:   "0x5fbdb2315678afecb367f032d93f642f64180aa3",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	buckets := &batchBuckets{}
+	for _, key := range []string{"2025-08-20", "2025-08-21"} {
+		ref := &bucketv1.BucketRef{Scope: &bucketv1.Scope{EntityKind: "issue", EntityKey: "gh#1"}, BucketKey: key}
+		buckets.bs = append(buckets.bs, &bucketv1.BucketInfo{Ref: ref, Status: "needs_anchoring", RootHash: []byte(key), ClosedAt: "2025-08-21T18:00:00Z"})
+	}
+	store := jobs.NewMemoryStore()
+	r := New(Config{Epochs: true, Confirmations: 2}, buckets, store, evm, nil)
+
+	r.tick(ctx)
+	e := buckets.epochs[0]
+	if e.Status != "anchoring_pending" || len(sim.Txs()) != 1 {
+		t.Fatalf("after send: epoch %s, %d txs", e.Status, len(sim.Txs()))
+	}
+	for _, b := range buckets.bs {
+		if b.Status != "anchoring_pending" || b.AnchoredTx != e.AnchoredTx {
+			t.Fatalf("member after send: %+v", b)
+		}
+	}
+
+	sim.Mine(1)
+	r.tick(ctx)
+	for _, b := range buckets.bs {
+		if j, _ := store.Get(ctx, jobKey(b.GetRef())); b.Status != "anchored" || j.State != jobs.Done {
+			t.Fatalf("member after 2 confirmations: status %s, job %+v", b.Status, j)
+		}
+	}
+}
+
+func TestRunnerRetriesFailedEpoch(t *testing.T) {
+	ctx := context.Background()
+	buckets := &batchBuckets{}
+	for _, key := range []string{"2025-08-20", "2025-08-21"} {
+		ref := &bucketv1.BucketRef{Scope: &bucketv1.Scope{EntityKind: "issue", EntityKey: "gh#1"}, BucketKey: key}
+		buckets.bs = append(buckets.bs, &bucketv1.BucketInfo{Ref: ref, Status: "needs_anchoring", RootHash: []byte(key), ClosedAt: "2025-08-21T18:00:00Z"})
+	}
+	store := jobs.NewMemoryStore()
+	anchorer := &flakyAnchorer{err: errors.New("rpc down")}
+	members := buckets.bs
+	// today's bucket waits for the day to end
+	today := &bucketv1.BucketInfo{Ref: &bucketv1.BucketRef{Scope: &bucketv1.Scope{EntityKind: "issue", EntityKey: "gh#1"}, BucketKey: "2025-08-22"}, Status: "needs_anchoring", ClosedAt: "2025-08-22T09:00:00Z"}
+	buckets.bs = append(buckets.bs, today)
+	r := New(Config{Epochs: true, Retry: jobs.Policy{MaxAttempts: 3, BaseDelay: time.Minute, MaxDelay: time.Hour}}, buckets, store, anchorer, nil)
+	now := time.Date(2025, 8, 22, 10, 0, 0, 0, time.UTC)
+	r.now = func() time.Time { return now }
+
+	r.tick(ctx)
+	if e := buckets.epochs[0]; e.Status != "failed" || e.Period != "2025-08-21" || len(e.Members) != 2 {
+		t.Fatalf("epoch after failed send: %+v", e)
+	}
+	for _, b := range members {
+		if j, _ := store.Get(ctx, jobKey(b.GetRef())); b.Status != "failed" || j.State != jobs.Retry || j.Attempts != 1 {
+			t.Fatalf("member after failed send: status %s, job %+v", b.Status, j)
+		}
+	}
+
+	r.tick(ctx) // backoff not over
+	if len(buckets.epochs) != 1 || len(anchorer.reqs) != 0 {
+		t.Fatalf("resealed before backoff: %d epochs", len(buckets.epochs))
+	}
+
+	anchorer.err = nil
+	now = now.Add(2 * time.Minute)
+	r.tick(ctx) // queued again, sealed into a new epoch, sent and (dev) confirmed
+	if len(buckets.epochs) != 2 || len(anchorer.reqs) != 1 || anchorer.reqs[0].EntityKey != "2025-08-21" || anchorer.reqs[0].BucketKey != "2" {
+		t.Fatalf("retry: %d epochs, anchored %+v", len(buckets.epochs), anchorer.reqs)
+	}
+	for _, b := range members {
+		if j, _ := store.Get(ctx, jobKey(b.GetRef())); b.Status != "anchored" || j.State != jobs.Done || j.Attempts != 2 {
+			t.Fatalf("member after retry: status %s, job %+v", b.Status, j)
+		}
+	}
+	if today.Status != "needs_anchoring" {
+		t.Fatalf("today's bucket: %s", today.Status)
+	}
+}
+
+func TestRunnerHoldsBackOverBudget(t *testing.T) {
+	sim := chaintest.New(31337)
+	defer sim.Close()
+	ctx := context.Background()
+	evm, err := anchor.New(ctx, anchor.Config{
+		Mode:       anchor.ModeEVM,
+		RPCURL:     sim.URL(),
+		PrivateKey: "ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80",
+		Contract:   "0x5fbdb2315678afecb367f032d93f642f64180aa3",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	buckets := &batchBuckets{}
+	add := func(project, key string) *bucketv1.BucketInfo {
+		ref := &bucketv1.BucketRef{Scope: &bucketv1.Scope{EntityKind: "issue", EntityKey: "gh#" + project}, BucketKey: key}
+		b := &bucketv1.BucketInfo{Ref: ref, Status: "needs_anchoring", RootHash: make([]byte, 32)}
+		buckets.bs = append(buckets.bs, b)
+		return b
+	}
+	budgets := budget.NewMemoryStore()
+	// room for one anchor a day
+	if _, err := budgets.SetBudget(ctx, budget.Budget{ProjectID: "p1", Period: budget.Day, Unit: budget.Gas, Cap: new(big.Int).SetUint64(sim.GasUsed)}); err != nil {
+		t.Fatal(err)
+	}
+	r := New(Config{}, buckets, jobs.NewMemoryStore(), evm, nil).WithBudgets(budgets)
+	now := time.Date(2025, 8, 22, 10, 0, 0, 0, time.UTC)
+	r.now = func() time.Time { return now }
+	spent := func(project string) budget.Spend {
+		rep, err := budget.Check(ctx, budgets, project, now)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return rep.Spend
+	}
+
+	first := add("1", "2025-08-21")
+	r.tick(ctx)
+	if s := spent("p1"); first.Status != "anchored" || s.GasUsed != sim.GasUsed || s.Anchors != 1 {
+		t.Fatalf("first anchor: status %s, spent %+v", first.Status, s)
+	}
+
+	second := add("1", "2025-08-22")
+	r.tick(ctx)
+	if second.Status != "needs_anchoring" || len(sim.Txs()) != 1 {
+		t.Fatalf("over budget: status %s, %d txs", second.Status, len(sim.Txs()))
+	}
+
+	// batched, it still waits; the buckets sent split the fee, even when one
+	// of them is only marked anchored a tick later
+	r.cfg.Batch = true
+	two, three := add("2", "2025-08-22"), add("3", "2025-08-22")
+	buckets.anchorErr = map[jobs.Key]error{jobKey(two.GetRef()): errors.New("db down")}
+	r.tick(ctx)
+	if second.Status != "needs_anchoring" || two.Status != "anchoring_pending" || three.Status != "anchored" || len(sim.Txs()) != 2 {
+		t.Fatalf("batch: statuses %s/%s/%s, %d txs", second.Status, two.Status, three.Status, len(sim.Txs()))
+	}
+	buckets.anchorErr = nil
+	r.tick(ctx)
+	if s2, s3 := spent("p2"), spent("p3"); two.Status != "anchored" || s2.GasUsed+s3.GasUsed != sim.GasUsed || s2.Anchors != 1 || s3.Anchors != 1 {
+		t.Fatalf("batch fee split: status %s, p2 %+v, p3 %+v", two.Status, s2, s3)
+	}
+
+	// the next day it goes out
+	now = now.Add(24 * time.Hour)
+	r.tick(ctx)
+	if s := spent("p1"); second.Status != "anchored" || s.Anchors != 1 {
+		t.Fatalf("next day: status %s, spent %+v", second.Status, s)
+	}
+}
+
+func TestRunnerStopsAtCapMidQueue(t *testing.T) {
+	ctx := context.Background()
+	buckets := &batchBuckets{}
+	add := func(project, key, status string) *bucketv1.BucketInfo {
+		ref := &bucketv1.BucketRef{Scope: &bucketv1.Scope{EntityKind: "issue", EntityKey: "gh#" + project}, BucketKey: key}
+		b := &bucketv1.BucketInfo{Ref: ref, Status: status, RootHash: make([]byte, 32), AnchoredTx: "0xprev"}
+		buckets.bs = append(buckets.bs, b)
+		return b
+	}
+	now := time.Date(2025, 8, 22, 10, 0, 0, 0, time.UTC)
+	budgets := budget.NewMemoryStore()
+	// 100 gas charged for two anchors today (50 each) under a cap of 250:
+	// room for three more, one of which is already in flight
+	if _, err := budgets.SetBudget(ctx, budget.Budget{ProjectID: "p1", Period: budget.Day, Unit: budget.Gas, Cap: big.NewInt(250)}); err != nil {
+		t.Fatal(err)
+	}
+	for _, k := range []string{"a", "b"} {
+		f := budget.Fee{ProjectID: "p1", Key: jobs.Key{EntityKind: "issue", EntityKey: "gh#1", BucketKey: k}, TxID: "0x" + k, GasUsed: 50, ChargedAt: now}
+		if err := budgets.Charge(ctx, f); err != nil {
+			t.Fatal(err)
+		}
+	}
+	add("1", "2025-08-18", "anchoring_pending")
+	queued := []*bucketv1.BucketInfo{
+		add("1", "2025-08-19", "needs_anchoring"),
+		add("1", "2025-08-20", "needs_anchoring"),
+		add("1", "2025-08-21", "needs_anchoring"),
+		add("1", "2025-08-22", "needs_anchoring"),
+	}
+	other := add("2", "2025-08-22", "needs_anchoring") // uncapped
+
+	anchorer := &flakyAnchorer{}
+	r := New(Config{}, buckets, jobs.NewMemoryStore(), anchorer, nil).WithBudgets(budgets)
+	r.now = func() time.Time { return now }
+	r.tick(ctx)
+
+	var sent, held int
+	for _, b := range queued {
+		switch b.Status {
+		case "anchored":
+			sent++
+		case "needs_anchoring":
+			held++
+		}
+	}
+	if sent != 2 || held != 2 || queued[0].Status != "anchored" || queued[3].Status != "needs_anchoring" {
+		t.Fatalf("p1: %d sent, %d held; want the first 2 sent", sent, held)
+	}
+	if other.Status != "anchored" || len(anchorer.reqs) != 3 {
+		t.Fatalf("p2: status %s, %d anchors sent", other.Status, len(anchorer.reqs))
+	}
+}
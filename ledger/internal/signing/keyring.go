@@ -0,0 +1,94 @@
+// Package signing holds the ledger's Ed25519 checkpoint keys: one active key
+// that signs, plus retired public keys that stay published so checkpoints
+// signed before a rotation still verify.
+package signing
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gusplusbus/trustflow/data_server/checkpoint"
+)
+
+type Keyring struct {
+	active   ed25519.PrivateKey
+	activeID string
+	set      checkpoint.KeySet
+}
+
+// NewKeyring builds a keyring from a base64 32-byte seed for the active key
+// and a comma-separated list of base64 retired public keys. An empty seed
+// generates an ephemeral key (dev only: its checkpoints won't verify after a
+// restart).
+func NewKeyring(activeSeed, retired string) (*Keyring, error) {
+	var priv ed25519.PrivateKey
+	if strings.TrimSpace(activeSeed) == "" {
+		_, k, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+		priv = k
+	} else {
+		seed, err := base64.StdEncoding.DecodeString(strings.TrimSpace(activeSeed))
+		if err != nil || len(seed) != ed25519.SeedSize {
+			return nil, errors.New("signing key must be a base64 32-byte Ed25519 seed")
+		}
+		priv = ed25519.NewKeyFromSeed(seed)
+	}
+
+	pub := priv.Public().(ed25519.PublicKey)
+	k := &Keyring{active: priv, activeID: checkpoint.KeyID(pub)}
+	k.set.Keys = append(k.set.Keys, checkpoint.Key{
+		KeyID: k.activeID, Alg: "Ed25519", PublicKey: pub, Status: checkpoint.StatusActive,
+	})
+	for _, s := range strings.Split(retired, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		b, err := base64.StdEncoding.DecodeString(s)
+		if err != nil || len(b) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("retired key %q: want base64 32-byte Ed25519 public key", s)
+		}
+		id := checkpoint.KeyID(b)
+		if _, dup := k.set.Find(id); dup {
+			continue
+		}
+		k.set.Keys = append(k.set.Keys, checkpoint.Key{
+			KeyID: id, Alg: "Ed25519", PublicKey: b, Status: checkpoint.StatusRetired,
+		})
+	}
+	return k, nil
+}
+
+// ActiveID is the key id new checkpoints are signed with.
+func (k *Keyring) ActiveID() string { return k.activeID }
+
+// Sign signs c with the active key.
+func (k *Keyring) Sign(c checkpoint.Checkpoint) (keyID string, sig []byte, err error) {
+	sig, err = checkpoint.Sign(k.active, c)
+	return k.activeID, sig, err
+}
+
+// KeySet is the published key document.
+func (k *Keyring) KeySet() checkpoint.KeySet { return k.set }
+
+// Handler serves the key set at checkpoint.WellKnownPath.
+func (k *Keyring) Handler() http.Handler {
+	body, _ := json.Marshal(k.set)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "public, max-age=300")
+		_, _ = w.Write(body)
+	})
+}
@@ -0,0 +1,53 @@
+package signing
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gusplusbus/trustflow/data_server/checkpoint"
+)
+
+func TestKeyringRotation(t *testing.T) {
+	oldSeed := make([]byte, ed25519.SeedSize)
+	oldSeed[0] = 1
+	newSeed := make([]byte, ed25519.SeedSize)
+	newSeed[0] = 2
+
+	old, err := NewKeyring(base64.StdEncoding.EncodeToString(oldSeed), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := checkpoint.Checkpoint{EntityKind: "issue", EntityKey: "gh#1", BucketKey: "2025-08-22", TreeAlg: 2, LeafCount: 1, RootHash: []byte{9}}
+	kid, sig, err := old.Sign(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// rotate: new active key, old public key retired
+	oldPub := base64.StdEncoding.EncodeToString(old.KeySet().Keys[0].PublicKey)
+	cur, err := NewKeyring(base64.StdEncoding.EncodeToString(newSeed), oldPub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cur.ActiveID() == kid {
+		t.Fatal("rotated keyring still signs with the old key")
+	}
+
+	// fetch the well-known document like a verifier would
+	rec := httptest.NewRecorder()
+	cur.Handler().ServeHTTP(rec, httptest.NewRequest("GET", checkpoint.WellKnownPath, nil))
+	var set checkpoint.KeySet
+	if err := json.Unmarshal(rec.Body.Bytes(), &set); err != nil {
+		t.Fatalf("decode key set: %v", err)
+	}
+	k, ok := set.Find(kid)
+	if !ok || k.Status != checkpoint.StatusRetired {
+		t.Fatalf("old key = %+v, %v; want retired entry", k, ok)
+	}
+	if !checkpoint.Verify(k.PublicKey, c, sig) {
+		t.Fatal("checkpoint signed before rotation no longer verifies")
+	}
+}
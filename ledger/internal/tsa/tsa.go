@@ -0,0 +1,130 @@
+// Package tsa is an RFC 3161 Time Stamping Authority client: it gets a
+// SHA-256 digest timestamped and verifies the returned token offline (see
+// rfc3161.Verify).
+package tsa
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gusplusbus/trustflow/data_server/rfc3161"
+)
+
+var oidSHA256 = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 1}
+
+type messageImprint struct {
+	HashAlgorithm pkix.AlgorithmIdentifier
+	HashedMessage []byte
+}
+
+type timeStampReq struct {
+	Version        int
+	MessageImprint messageImprint
+	ReqPolicy      asn1.ObjectIdentifier `asn1:"optional"`
+	Nonce          *big.Int              `asn1:"optional"`
+	CertReq        bool                  `asn1:"optional,default:false"`
+}
+
+type timeStampResp struct {
+	Status         pkiStatusInfo
+	TimeStampToken asn1.RawValue `asn1:"optional"`
+}
+
+type pkiStatusInfo struct {
+	Status       int
+	StatusString []string       `asn1:"optional"`
+	FailInfo     asn1.BitString `asn1:"optional"`
+}
+
+// Client requests timestamps from one TSA.
+type Client struct {
+	url   string
+	roots *x509.CertPool // nil => system roots
+	http  *http.Client
+}
+
+func NewClient(url string, roots *x509.CertPool, timeout time.Duration) *Client {
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	return &Client{url: url, roots: roots, http: &http.Client{Timeout: timeout}}
+}
+
+// Timestamp has the TSA sign digest (a SHA-256 hash) and returns the token,
+// already verified against the client's roots.
+func (c *Client) Timestamp(ctx context.Context, digest []byte) ([]byte, *rfc3161.Info, error) {
+	if len(digest) != 32 {
+		return nil, nil, errors.New("tsa: digest must be 32 bytes (SHA-256)")
+	}
+	nonce, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 64))
+	if err != nil {
+		return nil, nil, err
+	}
+	body, err := asn1.Marshal(timeStampReq{
+		Version: 1,
+		MessageImprint: messageImprint{
+			HashAlgorithm: pkix.AlgorithmIdentifier{Algorithm: oidSHA256, Parameters: asn1.NullRawValue},
+			HashedMessage: digest,
+		},
+		Nonce:   nonce,
+		CertReq: true,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Content-Type", "application/timestamp-query")
+	req.Header.Set("Accept", "application/timestamp-reply")
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, nil, fmt.Errorf("tsa: %w", err)
+	}
+	defer resp.Body.Close()
+	raw, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, nil, fmt.Errorf("tsa: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, nil, fmt.Errorf("tsa: http %d: %s", resp.StatusCode, strings.TrimSpace(string(raw[:min(len(raw), 200)])))
+	}
+
+	var tr timeStampResp
+	if _, err := asn1.Unmarshal(raw, &tr); err != nil {
+		return nil, nil, fmt.Errorf("tsa: response: %w", err)
+	}
+	// 0 granted, 1 grantedWithMods; anything else is a rejection.
+	if s := tr.Status.Status; s != 0 && s != 1 {
+		return nil, nil, fmt.Errorf("tsa: rejected (status %d): %s", s, strings.Join(tr.Status.StatusString, "; "))
+	}
+	token := tr.TimeStampToken.FullBytes
+	if len(token) == 0 {
+		return nil, nil, errors.New("tsa: granted without a token")
+	}
+
+	info, err := rfc3161.Verify(token, c.roots)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !bytes.Equal(info.Digest, digest) {
+		return nil, nil, errors.New("tsa: token timestamps another digest")
+	}
+	if info.Nonce == nil || info.Nonce.Cmp(nonce) != 0 {
+		return nil, nil, errors.New("tsa: token does not echo the request nonce")
+	}
+	return token, info, nil
+}
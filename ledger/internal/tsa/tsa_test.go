@@ -0,0 +1,60 @@
+package tsa_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/gusplusbus/trustflow/data_server/rfc3161"
+	"github.com/gusplusbus/trustflow/data_server/rfc3161/tsatest"
+	"github.com/gusplusbus/trustflow/ledger/internal/tsa"
+)
+
+func TestTimestampAndVerify(t *testing.T) {
+	stub := tsatest.New()
+	defer stub.Close()
+	ctx := context.Background()
+	c := tsa.NewClient(stub.URL(), stub.Roots(), 0)
+
+	digest := sha256.Sum256([]byte("root"))
+	token, info, err := c.Timestamp(ctx, digest[:])
+	if err != nil {
+		t.Fatalf("Timestamp() error = %v", err)
+	}
+	if !bytes.Equal(info.Digest, digest[:]) || info.Serial.Sign() <= 0 || info.GenTime.IsZero() {
+		t.Fatalf("Timestamp() info = %+v", info)
+	}
+
+	// Offline: only the token and the CA are needed.
+	got, err := rfc3161.Verify(token, stub.Roots())
+	if err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+	if !bytes.Equal(got.Digest, digest[:]) || got.Serial.Cmp(info.Serial) != 0 {
+		t.Fatalf("Verify() = %+v, want %+v", got, info)
+	}
+
+	// Another CA does not vouch for it.
+	other := tsatest.New()
+	defer other.Close()
+	if _, err := rfc3161.Verify(token, other.Roots()); err == nil {
+		t.Error("Verify() under another CA succeeded")
+	}
+
+	// Any change to the signed content breaks it.
+	i := bytes.Index(token, digest[:])
+	if i < 0 {
+		t.Fatal("digest not found in token")
+	}
+	tampered := bytes.Clone(token)
+	tampered[i] ^= 0xff
+	if _, err := rfc3161.Verify(tampered, stub.Roots()); err == nil {
+		t.Error("Verify() of a tampered token succeeded")
+	}
+
+	stub.Reject("maintenance")
+	if _, _, err := c.Timestamp(ctx, digest[:]); err == nil {
+		t.Error("Timestamp() succeeded against a rejecting TSA")
+	}
+}
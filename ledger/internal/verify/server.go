@@ -0,0 +1,81 @@
+package verify
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	anchorv1 "github.com/gusplusbus/trustflow/data_server/gen/anchorv1"
+	bucketv1 "github.com/gusplusbus/trustflow/data_server/gen/bucketv1"
+)
+
+// HTTPPath serves Handler.
+const HTTPPath = "/anchors/verify"
+
+// Server serves AnchorService.
+type Server struct {
+	anchorv1.UnimplementedAnchorServiceServer
+	v *Verifier
+}
+
+func NewServer(v *Verifier) *Server { return &Server{v: v} }
+
+func (s *Server) VerifyAnchor(ctx context.Context, req *anchorv1.VerifyAnchorRequest) (*anchorv1.VerifyAnchorResponse, error) {
+	ref, err := bucketRef(req.GetEntityKind(), req.GetEntityKey(), req.GetBucketKey())
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.v.Verify(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	return &anchorv1.VerifyAnchorResponse{
+		Result:        res.Result,
+		Detail:        res.Detail,
+		AnchoredTx:    res.AnchoredTx,
+		BlockNumber:   res.BlockNumber,
+		BlockHash:     res.BlockHash,
+		ExpectedRoot:  res.ExpectedRoot,
+		CommittedRoot: res.CommittedRoot,
+		EpochId:       res.EpochID,
+		Timestamp:     res.Timestamp,
+	}, nil
+}
+
+// Handler serves GET ?entity_kind=&entity_key=&bucket_key= with a Result.
+func (v *Verifier) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		q := r.URL.Query()
+		ref, err := bucketRef(q.Get("entity_kind"), q.Get("entity_key"), q.Get("bucket_key"))
+		if err != nil {
+			http.Error(w, status.Convert(err).Message(), http.StatusBadRequest)
+			return
+		}
+		res, err := v.Verify(r.Context(), ref)
+		if err != nil {
+			code := http.StatusBadGateway
+			if status.Code(err) == codes.NotFound {
+				code = http.StatusNotFound
+			}
+			http.Error(w, status.Convert(err).Message(), code)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "no-store")
+		_ = json.NewEncoder(w).Encode(res)
+	})
+}
+
+func bucketRef(kind, key, bkey string) (*bucketv1.BucketRef, error) {
+	if kind == "" || key == "" || bkey == "" {
+		return nil, status.Error(codes.InvalidArgument, "entity_kind, entity_key and bucket_key required")
+	}
+	return &bucketv1.BucketRef{Scope: &bucketv1.Scope{EntityKind: kind, EntityKey: key}, BucketKey: bkey}, nil
+}
@@ -0,0 +1,129 @@
+// Package verify checks a bucket's anchor: it reads back the root the anchor
+// tx committed (or verifies the stored timestamp token, for tsa anchors) and
+// compares it with the root the data server holds for the bucket (or for the
+// epoch the bucket was rolled into, once the bucket's inclusion proof checks
+// out against that epoch root).
+package verify
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	bucketv1 "github.com/gusplusbus/trustflow/data_server/gen/bucketv1"
+	"github.com/gusplusbus/trustflow/data_server/merkle"
+	"github.com/gusplusbus/trustflow/ledger/internal/anchor"
+	"github.com/gusplusbus/trustflow/ledger/internal/dataserver"
+)
+
+// Results.
+const (
+	Verified = "verified" // the tx committed the expected root under the expected id
+	Mismatch = "mismatch" // the tx committed something else
+	Missing  = "missing"  // no anchor tx, or none the chain knows as mined and successful
+)
+
+// A tsa anchor commits only a root, so Verified there means the token is
+// valid and timestamps the expected root.
+
+type Result struct {
+	Result        string `json:"result"`
+	Detail        string `json:"detail,omitempty"`
+	AnchoredTx    string `json:"anchored_tx,omitempty"`
+	BlockNumber   uint64 `json:"block_number,omitempty"`
+	BlockHash     string `json:"block_hash,omitempty"`
+	ExpectedRoot  Hex    `json:"expected_root,omitempty"`
+	CommittedRoot Hex    `json:"committed_root,omitempty"`
+	EpochID       uint64 `json:"epoch_id,omitempty"`
+	Timestamp     string `json:"timestamp,omitempty"` // RFC3339; tsa anchors
+}
+
+// Hex is JSON-encoded as lowercase hex.
+type Hex []byte
+
+func (h Hex) MarshalText() ([]byte, error) { return []byte(hex.EncodeToString(h)), nil }
+
+type Verifier struct {
+	buckets dataserver.Buckets
+	chain   anchor.Reader // nil => no anchor to read back (dev anchors)
+}
+
+func New(buckets dataserver.Buckets, chain anchor.Reader) *Verifier {
+	return &Verifier{buckets: buckets, chain: chain}
+}
+
+// Verify checks the anchor of ref. An error means the check could not be
+// made (unknown bucket, data server or chain unreachable).
+func (v *Verifier) Verify(ctx context.Context, ref *bucketv1.BucketRef) (Result, error) {
+	b, ep, err := v.buckets.GetWithEpoch(ctx, ref)
+	if err != nil {
+		return Result{}, err
+	}
+	res := Result{AnchoredTx: b.GetAnchoredTx()}
+	if res.AnchoredTx == "" {
+		res.Result, res.Detail = Missing, fmt.Sprintf("bucket is %s and has no anchor tx", b.GetStatus())
+		return res, nil
+	}
+
+	// An epoch member shares its epoch's tx, which committed the epoch root.
+	wantID := anchor.BucketID(ref.GetScope().GetEntityKind(), ref.GetScope().GetEntityKey(), ref.GetBucketKey())
+	res.ExpectedRoot = b.GetRootHash()
+	proof := b.GetAnchorProof()
+	if e := ep.GetEpoch(); e != nil && e.GetAnchoredTx() == res.AnchoredTx {
+		wantID = anchor.BucketID(anchor.EpochKind, e.GetPeriod(), fmt.Sprint(e.GetId()))
+		res.ExpectedRoot, res.EpochID = e.GetRootHash(), e.GetId()
+		proof = e.GetAnchorProof()
+		if !inEpoch(ref, b, ep) {
+			res.Result, res.Detail = Mismatch, fmt.Sprintf("bucket root is not in epoch %d", e.GetId())
+			return res, nil
+		}
+	}
+
+	if v.chain == nil {
+		res.Result, res.Detail = Missing, "no anchoring backend to read from; dev anchors cannot be checked"
+		return res, nil
+	}
+	c, err := v.chain.Committed(ctx, res.AnchoredTx, proof)
+	switch {
+	case errors.Is(err, anchor.ErrNotAnchorCall), errors.Is(err, anchor.ErrInvalidProof):
+		res.Result, res.Detail = Mismatch, err.Error()
+		return res, nil
+	case err != nil:
+		return Result{}, err
+	case c == nil:
+		res.Result, res.Detail = Missing, "tx not found on chain"
+		return res, nil
+	}
+	res.BlockNumber, res.BlockHash = c.BlockNumber, c.BlockHash
+	if !c.Time.IsZero() {
+		res.Timestamp = c.Time.UTC().Format(time.RFC3339)
+	}
+	switch {
+	case !c.Success:
+		res.Result, res.Detail = Missing, "tx reverted"
+	case c.BucketID != nil && !bytes.Equal(c.BucketID, wantID):
+		res.Result, res.Detail = Mismatch, "tx anchors another bucket id"
+		res.CommittedRoot = c.Root
+	case !bytes.Equal(c.Root, res.ExpectedRoot):
+		res.Result, res.Detail = Mismatch, "committed root differs from the data server's"
+		res.CommittedRoot = c.Root
+	default:
+		res.Result, res.CommittedRoot = Verified, c.Root
+	}
+	return res, nil
+}
+
+// inEpoch reports whether ep's path takes the epoch leaf of b's current root
+// to its epoch's root; the leaf is rebuilt from b, not taken from ep.
+func inEpoch(ref *bucketv1.BucketRef, b *bucketv1.BucketInfo, ep *bucketv1.EpochProof) bool {
+	leaf := merkle.EpochLeaf(ref.GetScope().GetEntityKind(), ref.GetScope().GetEntityKey(), ref.GetBucketKey(),
+		merkle.TreeAlg(b.GetTreeAlg()), b.GetLeafCount(), b.GetRootHash())
+	path := make([]merkle.ProofStep, len(ep.GetPath()))
+	for i, st := range ep.GetPath() {
+		path[i] = merkle.ProofStep{Sibling: st.GetSibling(), SiblingIsLeft: st.GetSiblingIsLeft()}
+	}
+	return merkle.VerifyProof(merkle.EpochTreeAlg, leaf, path, ep.GetEpoch().GetRootHash())
+}
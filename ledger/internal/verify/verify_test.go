@@ -0,0 +1,127 @@
+package verify
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"os"
+	"path/filepath"
+	"testing"
+
+	bucketv1 "github.com/gusplusbus/trustflow/data_server/gen/bucketv1"
+	"github.com/gusplusbus/trustflow/data_server/merkle"
+	"github.com/gusplusbus/trustflow/data_server/rfc3161/tsatest"
+	"github.com/gusplusbus/trustflow/ledger/internal/anchor"
+	"github.com/gusplusbus/trustflow/ledger/internal/chain/chaintest"
+	"github.com/gusplusbus/trustflow/ledger/internal/dataserver"
+)
+
+type fakeBuckets struct {
+	dataserver.Buckets
+	b  *bucketv1.BucketInfo
+	ep *bucketv1.EpochProof
+}
+
+func (f *fakeBuckets) GetWithEpoch(context.Context, *bucketv1.BucketRef) (*bucketv1.BucketInfo, *bucketv1.EpochProof, error) {
+	return f.b, f.ep, nil
+}
+
+func TestVerify(t *testing.T) {
+	sim := chaintest.New(31337)
+	defer sim.Close()
+	ctx := context.Background()
+	a, err := anchor.New(ctx, anchor.Config{
+		Mode:       anchor.ModeEVM,
+		RPCURL:     sim.URL(),
+		PrivateKey: "ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80",
+		Contract:   "0x5fbdb2315678afecb367f032d93f642f64180aa3",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	reader := a.(anchor.Reader)
+
+	ref := &bucketv1.BucketRef{Scope: &bucketv1.Scope{EntityKind: "issue", EntityKey: "gh#1"}, BucketKey: "2025-08-22"}
+	root := sha256.Sum256([]byte("bucket"))
+	otherRoot := sha256.Sum256([]byte("other"))
+	// an epoch over this bucket and another
+	leaves := [][]byte{
+		merkle.EpochLeaf("issue", "gh#2", "2025-08-22", 2, 5, otherRoot[:]),
+		merkle.EpochLeaf("issue", "gh#1", "2025-08-22", 2, 3, root[:]),
+	}
+	_, path, eroot := merkle.BuildProof(merkle.EpochTreeAlg, leaves, 1)
+	send := func(kind, key, bkey string, root []byte) string {
+		rec, err := a.Anchor(ctx, anchor.Request{EntityKind: kind, EntityKey: key, BucketKey: bkey, RootHash: root})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return rec.TxID
+	}
+	bucketTx := send("issue", "gh#1", "2025-08-22", root[:])
+	epochTx := send(anchor.EpochKind, "2025-08-22", "7", eroot)
+	otherTx := send("issue", "gh#2", "2025-08-22", root[:])
+	droppedTx := send("issue", "gh#1", "2025-08-22", root[:])
+	sim.Drop(droppedTx)
+
+	stub := tsatest.New()
+	defer stub.Close()
+	caFile := filepath.Join(t.TempDir(), "tsa-ca.pem")
+	if err := os.WriteFile(caFile, stub.CAPEM(), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	ta, err := anchor.New(ctx, anchor.Config{Mode: anchor.ModeTSA, TSAURL: stub.URL(), TSACACert: caFile})
+	if err != nil {
+		t.Fatal(err)
+	}
+	stamp := func(root []byte) anchor.Receipt {
+		rec, err := ta.Anchor(ctx, anchor.Request{EntityKind: "issue", EntityKey: "gh#1", BucketKey: "2025-08-22", RootHash: root})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return rec
+	}
+	tsaRec, tsaOther := stamp(root[:]), stamp(eroot)
+	tsaReader := ta.(anchor.Reader)
+
+	epoch := &bucketv1.EpochProof{Epoch: &bucketv1.EpochInfo{Id: 7, Period: "2025-08-22", RootHash: eroot, AnchoredTx: epochTx}, LeafIndex: 1}
+	for _, st := range path {
+		epoch.Path = append(epoch.Path, &bucketv1.InclusionProofResponse_Step{Sibling: st.Sibling, SiblingIsLeft: st.SiblingIsLeft})
+	}
+	// the epoch's own tx, but a path that does not lead to its root
+	badPath := &bucketv1.EpochProof{Epoch: epoch.Epoch, Path: []*bucketv1.InclusionProofResponse_Step{{Sibling: root[:]}}}
+	member := func(root []byte) *bucketv1.BucketInfo {
+		return &bucketv1.BucketInfo{RootHash: root, TreeAlg: 2, LeafCount: 3, AnchoredTx: epochTx}
+	}
+	for _, tc := range []struct {
+		name   string
+		b      *bucketv1.BucketInfo
+		ep     *bucketv1.EpochProof
+		reader anchor.Reader
+		want   string
+	}{
+		{"bucket", &bucketv1.BucketInfo{RootHash: root[:], AnchoredTx: bucketTx}, nil, reader, Verified},
+		{"epoch", member(root[:]), epoch, reader, Verified},
+		{"not in epoch", member(otherRoot[:]), epoch, reader, Mismatch},
+		{"bad epoch path", member(root[:]), badPath, reader, Mismatch},
+		{"root changed", &bucketv1.BucketInfo{RootHash: otherRoot[:], AnchoredTx: bucketTx}, nil, reader, Mismatch},
+		{"other bucket's tx", &bucketv1.BucketInfo{RootHash: root[:], AnchoredTx: otherTx}, nil, reader, Mismatch},
+		{"not anchored", &bucketv1.BucketInfo{Status: "needs_anchoring", RootHash: root[:]}, nil, reader, Missing},
+		{"dropped", &bucketv1.BucketInfo{RootHash: root[:], AnchoredTx: droppedTx}, nil, reader, Missing},
+		{"dev", &bucketv1.BucketInfo{RootHash: root[:], AnchoredTx: "anchored-1"}, nil, nil, Missing},
+		{"tsa", &bucketv1.BucketInfo{RootHash: root[:], AnchoredTx: tsaRec.TxID, AnchorProof: tsaRec.Proof}, nil, tsaReader, Verified},
+		{"tsa root changed", &bucketv1.BucketInfo{RootHash: otherRoot[:], AnchoredTx: tsaRec.TxID, AnchorProof: tsaRec.Proof}, nil, tsaReader, Mismatch},
+		{"tsa other token", &bucketv1.BucketInfo{RootHash: root[:], AnchoredTx: tsaRec.TxID, AnchorProof: tsaOther.Proof}, nil, tsaReader, Mismatch},
+		{"tsa no token", &bucketv1.BucketInfo{RootHash: root[:], AnchoredTx: tsaRec.TxID}, nil, tsaReader, Missing},
+	} {
+		res, err := New(&fakeBuckets{b: tc.b, ep: tc.ep}, tc.reader).Verify(ctx, ref)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		if res.Result != tc.want {
+			t.Errorf("%s: result %s (%s), want %s", tc.name, res.Result, res.Detail, tc.want)
+		}
+		if tc.want == Verified && ((res.BlockNumber == 0 && res.Timestamp == "") || !bytes.Equal(res.CommittedRoot, res.ExpectedRoot)) {
+			t.Errorf("%s: %+v", tc.name, res)
+		}
+	}
+}